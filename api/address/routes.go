@@ -1,38 +1,59 @@
 package address
 
 import (
+	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getAccountPath  = "/:address"
-	getBalancePath  = "/:address/balance"
-	getUsernamePath = "/:address/username"
-	getKeysPath     = "/:address/keys"
-	getKeyPath      = "/:address/key/:key"
-	getESDTTokens   = "/:address/esdt"
-	getESDTBalance  = "/:address/esdt/:tokenIdentifier"
+	getAccountPath      = "/:address"
+	getBalancePath      = "/:address/balance"
+	getUsernamePath     = "/:address/username"
+	getKeysPath         = "/:address/keys"
+	getKeyPath          = "/:address/key/:key"
+	getESDTTokens       = "/:address/esdt"
+	getESDTBalance      = "/:address/esdt/:tokenIdentifier"
+	getTransactionsPath = "/:address/transactions"
+
+	blockNonceQueryParam = "blockNonce"
+	blockHashQueryParam  = "blockHash"
+	startKeyQueryParam   = "startKey"
+	limitQueryParam      = "limit"
+	streamQueryParam     = "stream"
+	offsetQueryParam     = "offset"
+
+	defaultTransactionsLimit = 100
+	maxTransactionsLimit     = 1000
+
+	ndjsonContentType = "application/x-ndjson"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error)
 	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, error)
+	GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
 	GetCode(account state.UserAccountHandler) []byte
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error)
+	GetKeyValuePairsPage(ctx context.Context, address string, startKey string, limit int, options api.AccountQueryOptions) (map[string]string, string, error)
+	StreamKeyValuePairs(ctx context.Context, address string, startKey string, options api.AccountQueryOptions, handler func(key string, value string) error) error
+	GetTransactionsByAddress(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error)
 	IsInterfaceNil() bool
 }
 
@@ -46,6 +67,11 @@ type accountResponse struct {
 	RootHash []byte `json:"rootHash"`
 }
 
+type keyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type esdtTokenData struct {
 	TokenIdentifier string `json:"tokenIdentifier"`
 	Balance         string `json:"balance"`
@@ -61,6 +87,7 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getKeysPath, GetKeyValuePairs)
 	router.RegisterHandler(http.MethodGet, getESDTBalance, GetESDTBalance)
 	router.RegisterHandler(http.MethodGet, getESDTTokens, GetESDTTokens)
+	router.RegisterHandler(http.MethodGet, getTransactionsPath, GetTransactions)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -102,7 +129,20 @@ func GetAccount(c *gin.Context) {
 	}
 
 	addr := c.Param("address")
-	acc, err := facade.GetAccount(addr)
+	options, err := extractAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCouldNotGetAccount.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	acc, err := facade.GetAccount(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -146,7 +186,20 @@ func GetBalance(c *gin.Context) {
 		return
 	}
 
-	balance, err := facade.GetBalance(addr)
+	options, err := extractAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBalance.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	balance, err := facade.GetBalance(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -243,7 +296,20 @@ func GetValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := facade.GetValueForKey(addr, key)
+	options, err := extractAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	value, err := facade.GetValueForKey(addr, key, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -266,7 +332,9 @@ func GetValueForKey(c *gin.Context) {
 	)
 }
 
-// GetKeyValuePairs returns all the key-value pairs for the given address
+// GetKeyValuePairs returns all the key-value pairs for the given address. When a start key or a limit is provided, a
+// single page of pairs is returned, alongside the start key of the next page. When stream is set, the pairs are
+// written as newline delimited JSON objects, as they are read from the data trie
 func GetKeyValuePairs(c *gin.Context) {
 	facade, ok := getFacade(c)
 	if !ok {
@@ -286,7 +354,59 @@ func GetKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	value, err := facade.GetKeyValuePairs(addr)
+	options, err := extractAccountQueryOptions(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	startKey := c.Request.URL.Query().Get(startKeyQueryParam)
+	limitStr := c.Request.URL.Query().Get(limitQueryParam)
+	limit, err := parseLimit(limitStr)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	stream, err := parseBoolQueryParam(c, streamQueryParam)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	if stream {
+		streamKeyValuePairs(c, facade, addr, startKey, options)
+		return
+	}
+
+	isPaginated := startKey != "" || limitStr != ""
+	if isPaginated {
+		getKeyValuePairsPage(c, facade, addr, startKey, limit, options)
+		return
+	}
+
+	value, err := facade.GetKeyValuePairs(addr, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -309,6 +429,191 @@ func GetKeyValuePairs(c *gin.Context) {
 	)
 }
 
+func getKeyValuePairsPage(
+	c *gin.Context,
+	facade FacadeHandler,
+	addr string,
+	startKey string,
+	limit int,
+	options api.AccountQueryOptions,
+) {
+	pairs, nextKey, err := facade.GetKeyValuePairsPage(c.Request.Context(), addr, startKey, limit, options)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"pairs": pairs, "nextKey": nextKey},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// streamKeyValuePairs writes each pair on its own line. The response status is sent along with the first pair, so
+// that an error occurring before any pair was written is still reported as a regular error response
+func streamKeyValuePairs(c *gin.Context, facade FacadeHandler, addr string, startKey string, options api.AccountQueryOptions) {
+	encoder := json.NewEncoder(c.Writer)
+	err := facade.StreamKeyValuePairs(c.Request.Context(), addr, startKey, options, func(key string, value string) error {
+		if !c.Writer.Written() {
+			c.Header("Content-Type", ndjsonContentType)
+			c.Status(http.StatusOK)
+		}
+
+		errEncode := encoder.Encode(keyValuePair{Key: key, Value: value})
+		if errEncode != nil {
+			return errEncode
+		}
+
+		c.Writer.Flush()
+		return nil
+	})
+	if err == nil {
+		if !c.Writer.Written() {
+			c.Header("Content-Type", ndjsonContentType)
+			c.Status(http.StatusOK)
+			c.Writer.WriteHeaderNow()
+		}
+		return
+	}
+
+	errMessage := fmt.Sprintf("%s: %s", errors.ErrGetKeyValuePairs.Error(), err.Error())
+	if c.Writer.Written() {
+		_ = encoder.Encode(gin.H{"error": errMessage})
+		return
+	}
+
+	c.JSON(
+		http.StatusInternalServerError,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: errMessage,
+			Code:  shared.ReturnCodeInternalError,
+		},
+	)
+}
+
+// GetTransactions returns a page of the transactions involving the given address, newest first, alongside the total
+// number of transactions known for that address. The page is selected by the offset and limit query parameters
+func GetTransactions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	offset, limit, err := parseTransactionsPage(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	transactions, total, err := facade.GetTransactionsByAddress(addr, offset, limit)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionsByAddress.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": transactions, "total": total},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func parseTransactionsPage(c *gin.Context) (uint64, uint64, error) {
+	offset := uint64(0)
+	offsetStr := c.Request.URL.Query().Get(offsetQueryParam)
+	if offsetStr != "" {
+		var err error
+		offset, err = strconv.ParseUint(offsetStr, 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, offsetQueryParam, err.Error())
+		}
+	}
+
+	limitStr := c.Request.URL.Query().Get(limitQueryParam)
+	if limitStr == "" {
+		return offset, defaultTransactionsLimit, nil
+	}
+
+	limit, err := strconv.ParseUint(limitStr, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, limitQueryParam, err.Error())
+	}
+	if limit == 0 || limit > maxTransactionsLimit {
+		return 0, 0, fmt.Errorf("%w for %s: should be between 1 and %d", errors.ErrInvalidQueryParameter, limitQueryParam, maxTransactionsLimit)
+	}
+
+	return offset, limit, nil
+}
+
+func parseLimit(limitStr string) (int, error) {
+	if limitStr == "" {
+		return 0, nil
+	}
+
+	limit, err := strconv.ParseUint(limitStr, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, limitQueryParam, err.Error())
+	}
+
+	return int(limit), nil
+}
+
+func parseBoolQueryParam(c *gin.Context, name string) (bool, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return false, nil
+	}
+
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		return false, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, name, err.Error())
+	}
+
+	return value, nil
+}
+
 // GetESDTBalance returns the balance for the given address and esdt token
 func GetESDTBalance(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -414,6 +719,39 @@ func GetESDTTokens(c *gin.Context) {
 	)
 }
 
+// extractAccountQueryOptions reads the optional block nonce or block hash query parameters which allow fetching
+// the account state as of a past block
+func extractAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
+	options := api.AccountQueryOptions{}
+
+	blockNonceStr := c.Request.URL.Query().Get(blockNonceQueryParam)
+	blockHashStr := c.Request.URL.Query().Get(blockHashQueryParam)
+	if blockNonceStr != "" && blockHashStr != "" {
+		return options, errors.ErrBlockNonceAndHashProvided
+	}
+
+	if blockNonceStr != "" {
+		blockNonce, err := strconv.ParseUint(blockNonceStr, 10, 64)
+		if err != nil {
+			return options, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, blockNonceQueryParam, err.Error())
+		}
+
+		options.OnBlockNonce = true
+		options.BlockNonce = blockNonce
+	}
+
+	if blockHashStr != "" {
+		blockHash, err := hex.DecodeString(blockHashStr)
+		if err != nil {
+			return options, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, blockHashQueryParam, err.Error())
+		}
+
+		options.BlockHash = blockHash
+	}
+
+	return options, nil
+}
+
 func accountResponseFromBaseAccount(address string, code []byte, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -1,6 +1,7 @@
 package address_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -18,7 +19,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -79,12 +82,34 @@ type keyValuePairsResponseData struct {
 	Pairs map[string]string `json:"pairs"`
 }
 
+type keyValuePairsPageResponseData struct {
+	Pairs   map[string]string `json:"pairs"`
+	NextKey string            `json:"nextKey"`
+}
+
+type keyValuePairsPageResponse struct {
+	Data  keyValuePairsPageResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string
+}
+
 type keyValuePairsResponse struct {
 	Data  keyValuePairsResponseData `json:"data"`
 	Error string                    `json:"error"`
 	Code  string
 }
 
+type transactionsResponseData struct {
+	Transactions []*transaction.ApiTransactionByAddress `json:"transactions"`
+	Total        uint64                                 `json:"total"`
+}
+
+type transactionsResponse struct {
+	Data  transactionsResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
@@ -138,7 +163,7 @@ func TestGetBalance_WithCorrectAddressShouldNotReturnError(t *testing.T) {
 	amount := big.NewInt(10)
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return amount, nil
 		},
 	}
@@ -164,7 +189,7 @@ func TestGetBalance_WithWrongAddressShouldError(t *testing.T) {
 	t.Parallel()
 	otherAddress := "otherAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), nil
 		},
 	}
@@ -186,7 +211,7 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	addr := "addr"
 	balanceError := errors.New("error")
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return nil, balanceError
 		},
 	}
@@ -203,10 +228,85 @@ func TestGetBalance_NodeGetBalanceReturnsError(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("%s: %s", apiErrors.ErrGetBalance.Error(), balanceError.Error()), response.Error)
 }
 
+func TestGetBalance_WithBlockNonceShouldPassOptions(t *testing.T) {
+	t.Parallel()
+
+	var receivedOptions api.AccountQueryOptions
+	facade := mock.Facade{
+		BalanceHandler: func(s string, options api.AccountQueryOptions) (i *big.Int, e error) {
+			receivedOptions = options
+			return big.NewInt(37), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockNonce=123", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "37", getValueForKey(response.Data, "balance"))
+	assert.Equal(t, api.AccountQueryOptions{OnBlockNonce: true, BlockNonce: 123}, receivedOptions)
+}
+
+func TestGetBalance_WithBlockHashShouldPassOptions(t *testing.T) {
+	t.Parallel()
+
+	var receivedOptions api.AccountQueryOptions
+	facade := mock.Facade{
+		BalanceHandler: func(s string, options api.AccountQueryOptions) (i *big.Int, e error) {
+			receivedOptions = options
+			return big.NewInt(37), nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/addr/balance?blockHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, api.AccountQueryOptions{BlockHash: []byte{0xaa, 0xbb}}, receivedOptions)
+}
+
+func TestGetBalance_WithInvalidBlockQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	queries := map[string]error{
+		"blockNonce=abc":            apiErrors.ErrInvalidQueryParameter,
+		"blockHash=zz":              apiErrors.ErrInvalidQueryParameter,
+		"blockNonce=1&blockHash=aa": apiErrors.ErrBlockNonceAndHashProvided,
+	}
+	for query, expectedErr := range queries {
+		req, _ := http.NewRequest("GET", "/address/addr/balance?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBalance.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	}
+}
+
 func TestGetBalance_WithEmptyAddressShoudReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(0), errors.New("address was empty")
 		},
 	}
@@ -261,7 +361,7 @@ func TestGetValueForKey_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ api.AccountQueryOptions) (string, error) {
 			return "", expectedErr
 		},
 	}
@@ -284,7 +384,7 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	testAddress := "address"
 	testValue := "value"
 	facade := mock.Facade{
-		GetValueForKeyCalled: func(_ string, _ string) (string, error) {
+		GetValueForKeyCalled: func(_ string, _ string, _ api.AccountQueryOptions) (string, error) {
 			return testValue, nil
 		},
 	}
@@ -393,7 +493,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 	t.Parallel()
 	returnedError := "i am an error"
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ api.AccountQueryOptions) (state.UserAccountHandler, error) {
 			return nil, errors.New(returnedError)
 		},
 	}
@@ -414,7 +514,7 @@ func TestGetAccount_FailWhenFacadeGetAccountFails(t *testing.T) {
 func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	t.Parallel()
 	facade := mock.Facade{
-		GetAccountHandler: func(address string) (state.UserAccountHandler, error) {
+		GetAccountHandler: func(address string, _ api.AccountQueryOptions) (state.UserAccountHandler, error) {
 			acc, _ := state.NewUserAccount([]byte("1234"))
 			_ = acc.AddToBalance(big.NewInt(100))
 			acc.IncreaseNonce(1)
@@ -646,7 +746,7 @@ func TestGetKeyValuePairs_NodeFailsShouldError(t *testing.T) {
 	testAddress := "address"
 	expectedErr := errors.New("expected error")
 	facade := mock.Facade{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ api.AccountQueryOptions) (map[string]string, error) {
 			return nil, expectedErr
 		},
 	}
@@ -672,7 +772,7 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	}
 	testAddress := "address"
 	facade := mock.Facade{
-		GetKeyValuePairsCalled: func(_ string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(_ string, _ api.AccountQueryOptions) (map[string]string, error) {
 			return pairs, nil
 		},
 	}
@@ -689,6 +789,272 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	assert.Equal(t, pairs, response.Data.Pairs)
 }
 
+func TestGetKeyValuePairs_WithBlockNonceShouldPassOptions(t *testing.T) {
+	t.Parallel()
+
+	var receivedOptions api.AccountQueryOptions
+	facade := mock.Facade{
+		GetKeyValuePairsCalled: func(_ string, options api.AccountQueryOptions) (map[string]string, error) {
+			receivedOptions = options
+			return map[string]string{"k1": "v1"}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?blockNonce=0", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, map[string]string{"k1": "v1"}, response.Data.Pairs)
+	assert.True(t, receivedOptions.IsHistorical())
+	assert.Equal(t, uint64(0), receivedOptions.BlockNonce)
+}
+
+func TestGetKeyValuePairs_InvalidLimitShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?limit=-1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestGetKeyValuePairs_WithLimitShouldReturnPage(t *testing.T) {
+	t.Parallel()
+
+	pairs := map[string]string{
+		"k1": "v1",
+		"k2": "v2",
+	}
+	facade := mock.Facade{
+		GetKeyValuePairsPageCalled: func(_ context.Context, _ string, startKey string, limit int, _ api.AccountQueryOptions) (map[string]string, string, error) {
+			assert.Equal(t, "aa", startKey)
+			assert.Equal(t, 2, limit)
+			return pairs, "k3", nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?startKey=aa&limit=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsPageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, pairs, response.Data.Pairs)
+	assert.Equal(t, "k3", response.Data.NextKey)
+}
+
+func TestGetKeyValuePairs_PageFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetKeyValuePairsPageCalled: func(_ context.Context, _ string, _ string, _ int, _ api.AccountQueryOptions) (map[string]string, string, error) {
+			return nil, "", expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?limit=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsPageResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetKeyValuePairs_StreamShouldWriteNDJSON(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		StreamKeyValuePairsCalled: func(_ context.Context, _ string, startKey string, _ api.AccountQueryOptions, handler func(key string, value string) error) error {
+			assert.Equal(t, "aa", startKey)
+			_ = handler("k1", "v1")
+			_ = handler("k2", "v2")
+			return nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?stream=true&startKey=aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+	assert.Equal(t, "{\"key\":\"k1\",\"value\":\"v1\"}\n{\"key\":\"k2\",\"value\":\"v2\"}\n", resp.Body.String())
+}
+
+func TestGetKeyValuePairs_StreamFailsBeforeWritingShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		StreamKeyValuePairsCalled: func(_ context.Context, _ string, _ string, _ api.AccountQueryOptions, _ func(key string, value string) error) error {
+			return expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?stream=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetKeyValuePairs_StreamFailsAfterWritingShouldAppendError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		StreamKeyValuePairsCalled: func(_ context.Context, _ string, _ string, _ api.AccountQueryOptions, handler func(key string, value string) error) error {
+			_ = handler("k1", "v1")
+			return expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/keys?stream=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	lines := strings.Split(strings.TrimSpace(resp.Body.String()), "\n")
+	assert.Equal(t, 2, len(lines))
+	assert.True(t, strings.Contains(lines[1], expectedErr.Error()))
+}
+
+func TestGetTransactions_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetTransactions_InvalidQueryParametersShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(_ string, _ uint64, _ uint64) ([]*transaction.ApiTransactionByAddress, uint64, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, 0, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	for _, query := range []string{"offset=-1", "offset=a", "limit=0", "limit=1001", "limit=b"} {
+		req, _ := http.NewRequest("GET", "/address/address/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()), query)
+	}
+}
+
+func TestGetTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(_ string, _ uint64, _ uint64) ([]*transaction.ApiTransactionByAddress, uint64, error) {
+			return nil, 0, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsByAddress.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	transactions := []*transaction.ApiTransactionByAddress{
+		{Hash: "bb", Epoch: 2, Role: "receiver"},
+		{Hash: "aa", Epoch: 1, Role: "sender"},
+	}
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error) {
+			assert.Equal(t, "address", address)
+			assert.Equal(t, uint64(10), offset)
+			assert.Equal(t, uint64(2), limit)
+			return transactions, 37, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions?offset=10&limit=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, transactions, response.Data.Transactions)
+	assert.Equal(t, uint64(37), response.Data.Total)
+}
+
+func TestGetTransactions_DefaultPageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsByAddressCalled: func(_ string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error) {
+			assert.Equal(t, uint64(0), offset)
+			assert.Equal(t, uint64(100), limit)
+			return make([]*transaction.ApiTransactionByAddress, 0), 0, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
 func getRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -701,6 +1067,7 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
@@ -8,11 +8,16 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/address"
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/graphql"
 	"github.com/ElrondNetwork/elrond-go/api/hardfork"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/network"
 	"github.com/ElrondNetwork/elrond-go/api/node"
+	"github.com/ElrondNetwork/elrond-go/api/proof"
+	"github.com/ElrondNetwork/elrond-go/api/state"
 	"github.com/ElrondNetwork/elrond-go/api/transaction"
 	valStats "github.com/ElrondNetwork/elrond-go/api/validator"
 	"github.com/ElrondNetwork/elrond-go/api/vmValues"
@@ -149,6 +154,42 @@ func RegisterRoutes(ws *gin.Engine, routesConfig config.ApiRoutesConfig, elrondF
 		block.Routes(wrappedBlockRouter)
 	}
 
+	blocksRoutes := ws.Group("/")
+	wrappedBlocksRouter, err := wrapper.NewRouterWrapper("blocks", blocksRoutes, routesConfig)
+	if err == nil {
+		block.BlocksRoutes(wrappedBlocksRouter)
+	}
+
+	hyperblockRoutes := ws.Group("/hyperblock")
+	wrappedHyperblockRouter, err := wrapper.NewRouterWrapper("hyperblock", hyperblockRoutes, routesConfig)
+	if err == nil {
+		hyperblock.Routes(wrappedHyperblockRouter)
+	}
+
+	proofRoutes := ws.Group("/proof")
+	wrappedProofRouter, err := wrapper.NewRouterWrapper("proof", proofRoutes, routesConfig)
+	if err == nil {
+		proof.Routes(wrappedProofRouter)
+	}
+
+	eventsRoutes := ws.Group("/events")
+	wrappedEventsRouter, err := wrapper.NewRouterWrapper("events", eventsRoutes, routesConfig)
+	if err == nil {
+		events.Routes(wrappedEventsRouter)
+	}
+
+	stateRoutes := ws.Group("/state")
+	wrappedStateRouter, err := wrapper.NewRouterWrapper("state", stateRoutes, routesConfig)
+	if err == nil {
+		state.Routes(wrappedStateRouter)
+	}
+
+	graphqlRoutes := ws.Group("/graphql")
+	wrappedGraphQLRouter, err := wrapper.NewRouterWrapper("graphql", graphqlRoutes, routesConfig)
+	if err == nil {
+		graphql.Routes(wrappedGraphQLRouter)
+	}
+
 	apiHandler, ok := elrondFacade.(MainApiHandler)
 	if ok && apiHandler.PprofEnabled() {
 		pprof.Register(ws)
@@ -17,6 +17,11 @@ import (
 const (
 	getBlockByNoncePath = "/by-nonce/:nonce"
 	getBlockByHashPath  = "/by-hash/:hash"
+	getBlocksPath       = "/blocks"
+
+	// maxBlocksInRange is the page size of a blocks range query. The blocks of a larger range are returned one page
+	// at a time, alongside the nonce from which the next page should be requested
+	maxBlocksInRange = 100
 )
 
 var log = logger.GetOrCreate("api/block")
@@ -25,6 +30,7 @@ var log = logger.GetOrCreate("api/block")
 type BlockService interface {
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
 }
 
 // Routes defines block related routes
@@ -33,6 +39,11 @@ func Routes(routes *wrapper.RouterWrapper) {
 	routes.RegisterHandler(http.MethodGet, getBlockByHashPath, getBlockByHash)
 }
 
+// BlocksRoutes defines the blocks range query route. It is registered on the root group, as /blocks
+func BlocksRoutes(routes *wrapper.RouterWrapper) {
+	routes.RegisterHandler(http.MethodGet, getBlocksPath, getBlocksByNonceRange)
+}
+
 func getBlockByNonce(c *gin.Context) {
 	ef, ok := getFacade(c)
 	if !ok {
@@ -112,6 +123,77 @@ func getBlockByHash(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
 }
 
+// getBlocksByNonceRange returns the blocks having the nonces between the from and to query parameters, both included.
+// At most maxBlocksInRange blocks are returned at once. When the range holds more blocks, the response also contains
+// the nextFrom nonce, to be used as the from query parameter of the following request
+func getBlocksByNonceRange(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	from, to, err := getQueryParamsNonceRange(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	withTxs, err := getQueryParamWithTxs(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	pageTo := to
+	if to-from >= maxBlocksInRange {
+		pageTo = from + maxBlocksInRange - 1
+	}
+
+	start := time.Now()
+	blocks, err := ef.GetBlocksByNonceRange(from, pageTo, withTxs)
+	log.Debug(fmt.Sprintf("GetBlocksByNonceRange took %s", time.Since(start)))
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetBlock.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	responseData := gin.H{"blocks": blocks}
+	isPageFull := uint64(len(blocks)) == pageTo-from+1
+	if pageTo < to && isPageFull {
+		responseData["nextFrom"] = pageTo + 1
+	}
+
+	shared.RespondWith(c, http.StatusOK, responseData, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamsNonceRange(c *gin.Context) (uint64, uint64, error) {
+	from, err := strconv.ParseUint(c.Request.URL.Query().Get("from"), 10, 64)
+	if err != nil {
+		return 0, 0, errors.ErrInvalidBlockRange
+	}
+
+	to, err := strconv.ParseUint(c.Request.URL.Query().Get("to"), 10, 64)
+	if err != nil {
+		return 0, 0, errors.ErrInvalidBlockRange
+	}
+
+	if from > to {
+		return 0, 0, errors.ErrInvalidBlockRange
+	}
+
+	return from, to, nil
+}
+
 func getQueryParamWithTxs(c *gin.Context) (bool, error) {
 	withTxsStr := c.Request.URL.Query().Get("withTxs")
 	if withTxsStr == "" {
@@ -21,6 +21,7 @@ import (
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type blockResponseData struct {
@@ -253,6 +254,144 @@ func TestGetBlockByHash_ShouldWork(t *testing.T) {
 	assert.Equal(t, expectedBlock, response.Data.Block)
 }
 
+type blocksResponseData struct {
+	Blocks   []*api.Block `json:"blocks"`
+	NextFrom *uint64      `json:"nextFrom"`
+}
+
+type blocksResponse struct {
+	Data  blocksResponseData `json:"data"`
+	Error string             `json:"error"`
+	Code  string             `json:"code"`
+}
+
+func TestGetBlocksByNonceRange_InvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(_ uint64, _ uint64, _ bool) ([]*api.Block, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	invalidQueries := []string{
+		"",
+		"?from=1",
+		"?to=1",
+		"?from=a&to=2",
+		"?from=3&to=2",
+	}
+	for _, query := range invalidQueries {
+		req, _ := http.NewRequest("GET", "/blocks"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := blocksResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockRange.Error()), query)
+	}
+}
+
+func TestGetBlocksByNonceRange_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(_ uint64, _ uint64, _ bool) ([]*api.Block, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/blocks?from=1&to=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetBlocksByNonceRange_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedBlocks := []*api.Block{{Nonce: 1}, {Nonce: 100}}
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+			assert.Equal(t, uint64(1), from)
+			assert.Equal(t, uint64(100), to)
+			assert.True(t, withTxs)
+
+			return expectedBlocks, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/blocks?from=1&to=100&withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedBlocks, response.Data.Blocks)
+	assert.Nil(t, response.Data.NextFrom)
+}
+
+func TestGetBlocksByNonceRange_LargeRangeShouldReturnTheFirstPage(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(from uint64, to uint64, _ bool) ([]*api.Block, error) {
+			assert.Equal(t, uint64(5), from)
+			assert.Equal(t, uint64(104), to)
+
+			blocks := make([]*api.Block, 0)
+			for nonce := from; nonce <= to; nonce++ {
+				blocks = append(blocks, &api.Block{Nonce: nonce})
+			}
+			return blocks, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/blocks?from=5&to=1000", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 100, len(response.Data.Blocks))
+	require.NotNil(t, response.Data.NextFrom)
+	assert.Equal(t, uint64(105), *response.Data.NextFrom)
+}
+
+func TestGetBlocksByNonceRange_PageEndingAfterTheHeadShouldNotHaveNextPage(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetBlocksByNonceRangeCalled: func(from uint64, _ uint64, _ bool) ([]*api.Block, error) {
+			return []*api.Block{{Nonce: from}, {Nonce: from + 1}}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/blocks?from=5&to=1000", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := blocksResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, 2, len(response.Data.Blocks))
+	assert.Nil(t, response.Data.NextFrom)
+}
+
 func startNodeServer(handler block.BlockService) *gin.Engine {
 	ws := gin.New()
 	ws.Use(cors.Default())
@@ -262,6 +401,14 @@ func startNodeServer(handler block.BlockService) *gin.Engine {
 	}
 	blockRoute, _ := wrapper.NewRouterWrapper("block", blockRoutes, getRoutesConfig())
 	block.Routes(blockRoute)
+
+	blocksRoutes := ws.Group("/")
+	if handler != nil {
+		blocksRoutes.Use(middleware.WithFacade(handler))
+	}
+	blocksRoute, _ := wrapper.NewRouterWrapper("blocks", blocksRoutes, getRoutesConfig())
+	block.BlocksRoutes(blocksRoute)
+
 	return ws
 }
 
@@ -274,6 +421,11 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/by-hash/:hash", Open: true},
 				},
 			},
+			"blocks": {
+				Routes: []config.RouteConfig{
+					{Name: "/blocks", Open: true},
+				},
+			},
 		},
 	}
 }
@@ -52,18 +52,54 @@ var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 // ErrInvalidBlockNonce signals an invalid block nonce was provided
 var ErrInvalidBlockNonce = errors.New("invalid block nonce")
 
+// ErrGetTransactionsByAddress signals an error in getting the transactions of an address
+var ErrGetTransactionsByAddress = errors.New("get transactions by address error")
+
 // ErrInvalidQueryParameter signals and invalid query parameter was provided
 var ErrInvalidQueryParameter = errors.New("invalid query parameter")
 
+// ErrBlockNonceAndHashProvided signals that both the block nonce and the block hash were provided for a query
+var ErrBlockNonceAndHashProvided = errors.New("only one of block nonce and block hash can be provided")
+
 // ErrValidationEmptyBlockHash signals an empty block hash was provided
 var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 
+// ErrValidationEmptyRootHash signals that an empty root hash was provided
+var ErrValidationEmptyRootHash = errors.New("root hash is empty")
+
+// ErrValidationEmptyAddress signals that an empty address was provided
+var ErrValidationEmptyAddress = errors.New("address is empty")
+
+// ErrValidationEmptyKey signals that an empty key was provided
+var ErrValidationEmptyKey = errors.New("key is empty")
+
+// ErrValidationEmptyFromRootHash signals that an empty from root hash was provided
+var ErrValidationEmptyFromRootHash = errors.New("from root hash is empty")
+
+// ErrValidationEmptyToRootHash signals that an empty to root hash was provided
+var ErrValidationEmptyToRootHash = errors.New("to root hash is empty")
+
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrGetTransactions signals an error happening when trying to fetch a batch of transactions
+var ErrGetTransactions = errors.New("getting transactions failed")
+
+// ErrValidationEmptyTxHashes signals that no tx hash was provided in a batch request
+var ErrValidationEmptyTxHashes = errors.New("no tx hash provided")
+
+// ErrTooManyTxHashes signals that too many tx hashes were provided in a batch request
+var ErrTooManyTxHashes = errors.New("too many tx hashes")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrInvalidBlockRange signals that an invalid blocks range was provided
+var ErrInvalidBlockRange = errors.New("invalid block range")
+
+// ErrGetHyperblock signals an error happening when trying to fetch a hyperblock
+var ErrGetHyperblock = errors.New("getting hyperblock failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -72,3 +108,27 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrGetProof signals an error happening when trying to compute a Merkle proof
+var ErrGetProof = errors.New("getting proof failed")
+
+// ErrVerifyProof signals an error happening when trying to verify a Merkle proof
+var ErrVerifyProof = errors.New("verifying proof failed")
+
+// ErrCreateEventsSubscriber signals an error happening when trying to create an events subscriber
+var ErrCreateEventsSubscriber = errors.New("creating events subscriber failed")
+
+// ErrInvalidGraphQLQuery signals that an invalid GraphQL query was received
+var ErrInvalidGraphQLQuery = errors.New("invalid GraphQL query")
+
+// ErrGraphQLQueryTooDeep signals that the received GraphQL query exceeds the maximum accepted depth
+var ErrGraphQLQueryTooDeep = errors.New("GraphQL query is too deep")
+
+// ErrGraphQLQueryTooCostly signals that the received GraphQL query exceeds the maximum accepted cost
+var ErrGraphQLQueryTooCostly = errors.New("GraphQL query is too costly")
+
+// ErrGetStateDiff signals an error happening when trying to compute the state diff between two root hashes
+var ErrGetStateDiff = errors.New("getting state diff failed")
+
+// ErrGetTrieAnalysis signals an error happening when trying to analyze the trie found at a root hash
+var ErrGetTrieAnalysis = errors.New("getting trie analysis failed")
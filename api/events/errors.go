@@ -0,0 +1,12 @@
+package events
+
+import "errors"
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrNilEventsSubscriber signals that a nil events subscriber has been provided
+var ErrNilEventsSubscriber = errors.New("nil events subscriber")
+
+// ErrUnknownAction signals that a request with an unknown action has been received
+var ErrUnknownAction = errors.New("unknown action")
@@ -0,0 +1,141 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// ActionSubscribe is the action of a request that adds a subscription
+	ActionSubscribe = "subscribe"
+	// ActionUnsubscribe is the action of a request that removes a subscription
+	ActionUnsubscribe = "unsubscribe"
+)
+
+// Request represents a message sent by a client in order to manage its subscriptions
+type Request struct {
+	Action         string                 `json:"action"`
+	Subscription   api.EventsSubscription `json:"subscription"`
+	SubscriptionID uint64                 `json:"subscriptionId"`
+}
+
+// Response represents the message sent back to a client for each of its requests
+type Response struct {
+	Action         string `json:"action"`
+	SubscriptionID uint64 `json:"subscriptionId"`
+	Error          string `json:"error,omitempty"`
+}
+
+type eventsSender struct {
+	conn       wsConn
+	subscriber api.EventsSubscriber
+	mutWrite   sync.Mutex
+}
+
+// NewEventsSender returns a component that handles the subscription requests received on the provided connection
+// and writes back the events pushed to the provided subscriber
+func NewEventsSender(conn wsConn, subscriber api.EventsSubscriber) (*eventsSender, error) {
+	if conn == nil {
+		return nil, ErrNilWsConn
+	}
+	if check.IfNil(subscriber) {
+		return nil, ErrNilEventsSubscriber
+	}
+
+	return &eventsSender{
+		conn:       conn,
+		subscriber: subscriber,
+	}, nil
+}
+
+// StartSendingBlocking writes the events to the connection until either the connection or the subscriber is closed.
+// If the subscriber was dropped by the node, the reason is sent to the client in a close message
+func (es *eventsSender) StartSendingBlocking() {
+	defer func() {
+		_ = es.subscriber.Close()
+		_ = es.conn.Close()
+	}()
+
+	go es.handleRequests()
+
+	for event := range es.subscriber.Events() {
+		err := es.writeJSON(event)
+		if err != nil {
+			log.Debug("eventsSender: cannot write event", "error", err.Error())
+			return
+		}
+	}
+
+	reason := es.subscriber.Err()
+	if reason == nil {
+		return
+	}
+
+	es.mutWrite.Lock()
+	_ = es.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, reason.Error()))
+	es.mutWrite.Unlock()
+}
+
+// handleRequests reads the requests of the client until the connection is closed, closing the subscriber afterwards
+// so that the sending loop ends as well
+func (es *eventsSender) handleRequests() {
+	defer func() {
+		_ = es.subscriber.Close()
+	}()
+
+	for {
+		mt, message, err := es.conn.ReadMessage()
+		if err != nil || mt == websocket.CloseMessage {
+			return
+		}
+
+		err = es.writeJSON(es.handleRequest(message))
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (es *eventsSender) handleRequest(message []byte) *Response {
+	request := &Request{}
+	err := json.Unmarshal(message, request)
+	if err != nil {
+		return &Response{Error: fmt.Sprintf("invalid request: %s", err.Error())}
+	}
+
+	response := &Response{
+		Action:         request.Action,
+		SubscriptionID: request.SubscriptionID,
+	}
+
+	switch request.Action {
+	case ActionSubscribe:
+		response.SubscriptionID, err = es.subscriber.Subscribe(request.Subscription)
+	case ActionUnsubscribe:
+		err = es.subscriber.Unsubscribe(request.SubscriptionID)
+	default:
+		err = fmt.Errorf("%w: %s", ErrUnknownAction, request.Action)
+	}
+	if err != nil {
+		response.Error = err.Error()
+	}
+
+	return response
+}
+
+func (es *eventsSender) writeJSON(message interface{}) error {
+	buff, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	es.mutWrite.Lock()
+	defer es.mutWrite.Unlock()
+
+	return es.conn.WriteMessage(websocket.TextMessage, buff)
+}
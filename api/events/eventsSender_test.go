@@ -0,0 +1,89 @@
+package events_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventsSender_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	subscriber, _ := createEventsHub().CreateSubscriber()
+
+	sender, err := events.NewEventsSender(nil, subscriber)
+	assert.Nil(t, sender)
+	assert.Equal(t, events.ErrNilWsConn, err)
+
+	sender, err = events.NewEventsSender(&mock.WsConnStub{}, nil)
+	assert.Nil(t, sender)
+	assert.Equal(t, events.ErrNilEventsSubscriber, err)
+}
+
+func TestEventsSender_StartSendingBlockingShouldSendCloseReasonForDroppedSubscriber(t *testing.T) {
+	t.Parallel()
+
+	hub := createEventsHub()
+	subscriber, _ := hub.CreateSubscriber()
+	_, _ = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+	_, _ = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+	// the events queue holds 10 events, so the 6th block overflows it
+	for nonce := uint64(1); nonce <= 6; nonce++ {
+		hub.NotifyCommittedBlock([]byte("hash"), &block.Header{Nonce: nonce}, &block.Body{}, nil, 0)
+	}
+	// the committed blocks are processed on the hub's go routine
+	waitUntilDropped(subscriber)
+
+	mutMessages := sync.Mutex{}
+	messageTypes := make([]int, 0)
+	var closeMessage []byte
+	connClosed := false
+	conn := &mock.WsConnStub{}
+	conn.SetReadMessageHandler(func() (messageType int, p []byte, err error) {
+		return 0, nil, errors.New("connection closed")
+	})
+	conn.SetWriteMessageHandler(func(messageType int, data []byte) error {
+		mutMessages.Lock()
+		defer mutMessages.Unlock()
+
+		messageTypes = append(messageTypes, messageType)
+		if messageType == websocket.CloseMessage {
+			closeMessage = data
+		}
+		return nil
+	})
+	conn.SetCloseHandler(func() error {
+		connClosed = true
+		return nil
+	})
+
+	sender, err := events.NewEventsSender(conn, subscriber)
+	require.Nil(t, err)
+	sender.StartSendingBlocking()
+
+	mutMessages.Lock()
+	defer mutMessages.Unlock()
+
+	require.Equal(t, 11, len(messageTypes))
+	assert.Equal(t, websocket.CloseMessage, messageTypes[10])
+	expectedCloseMessage := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, subscriptions.ErrSubscriberTooSlow.Error())
+	assert.Equal(t, expectedCloseMessage, closeMessage)
+	assert.True(t, connClosed)
+}
+
+func waitUntilDropped(subscriber api.EventsSubscriber) {
+	deadline := time.Now().Add(time.Second * 5)
+	for subscriber.Err() == nil && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond * 10)
+	}
+}
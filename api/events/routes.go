@@ -0,0 +1,105 @@
+package events
+
+import (
+	"fmt"
+	"net/http"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	subscribePath = "/subscribe"
+	// the endpoint throttler caps the number of simultaneously opened web socket connections
+	subscribeEndpoint = "/events/subscribe"
+)
+
+var log = logger.GetOrCreate("api/events")
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	CreateEventsSubscriber() (api.EventsSubscriber, error)
+}
+
+// Routes defines the events subscriptions related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(
+		http.MethodGet,
+		subscribePath,
+		middleware.CreateEndpointThrottler(subscribeEndpoint),
+		subscribe,
+	)
+}
+
+// subscribe upgrades the connection to a web socket on which the client manages its subscriptions and receives
+// the matching events
+func subscribe(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	subscriber, err := ef.CreateEventsSubscriber()
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrCreateEventsSubscriber.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader already responded with the appropriate http error
+		_ = subscriber.Close()
+		log.Debug("events subscribe: cannot upgrade connection", "error", err.Error())
+		return
+	}
+
+	sender, err := NewEventsSender(conn, subscriber)
+	if err != nil {
+		_ = subscriber.Close()
+		_ = conn.Close()
+		log.Debug("events subscribe: cannot create events sender", "error", err.Error())
+		return
+	}
+
+	sender.StartSendingBlocking()
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
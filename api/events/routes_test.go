@@ -0,0 +1,239 @@
+package events_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/events"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type txLogsGetterStub struct {
+}
+
+func (tlgs *txLogsGetterStub) GetLog(_ []byte) (data.LogHandler, error) {
+	return nil, errors.New("no log")
+}
+
+func (tlgs *txLogsGetterStub) IsInterfaceNil() bool {
+	return tlgs == nil
+}
+
+func createEventsHub() subscriptions.EventsHub {
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+	hub, _ := subscriptions.NewEventsHub(subscriptions.ArgsEventsHub{
+		PubkeyConverter:               converter,
+		TxLogsGetter:                  &txLogsGetterStub{},
+		MaxSubscriptionsPerConnection: 2,
+		EventsQueueSize:               10,
+	})
+
+	return hub
+}
+
+func TestSubscribe_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/events/subscribe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestSubscribe_CreateSubscriberErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		CreateEventsSubscriberCalled: func() (api.EventsSubscriber, error) {
+			return nil, subscriptions.ErrEventsSubscriptionsDisabled
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/events/subscribe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrCreateEventsSubscriber.Error()))
+	assert.True(t, strings.Contains(response.Error, subscriptions.ErrEventsSubscriptionsDisabled.Error()))
+}
+
+func TestSubscribe_ThrottledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+			return &mock.ThrottlerStub{
+				CanProcessCalled: func() bool {
+					return false
+				},
+			}, endpoint == "/events/subscribe"
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/events/subscribe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
+
+func TestSubscribe_NotWebSocketRequestShouldCloseSubscriber(t *testing.T) {
+	t.Parallel()
+
+	hub := createEventsHub()
+	var subscriber api.EventsSubscriber
+	facade := &mock.Facade{
+		CreateEventsSubscriberCalled: func() (api.EventsSubscriber, error) {
+			subscriber, _ = hub.CreateSubscriber()
+			return subscriber, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/events/subscribe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	require.NotNil(t, subscriber)
+	_, isOpen := <-subscriber.Events()
+	assert.False(t, isOpen)
+}
+
+func TestSubscribe_ShouldPushEvents(t *testing.T) {
+	t.Parallel()
+
+	hub := createEventsHub()
+	facade := &mock.Facade{
+		CreateEventsSubscriberCalled: func() (api.EventsSubscriber, error) {
+			return hub.CreateSubscriber()
+		},
+	}
+	server := httptest.NewServer(startNodeServer(facade))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/events/subscribe"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Nil(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+
+	response := &events.Response{}
+	writeRequest(t, conn, &events.Request{Action: "unknown"})
+	readMessage(t, conn, response)
+	assert.True(t, strings.Contains(response.Error, events.ErrUnknownAction.Error()))
+
+	writeRequest(t, conn, &events.Request{
+		Action:       events.ActionSubscribe,
+		Subscription: api.EventsSubscription{Topic: api.EventsTopicBlocks},
+	})
+	response = &events.Response{}
+	readMessage(t, conn, response)
+	require.Equal(t, "", response.Error)
+	assert.Equal(t, events.ActionSubscribe, response.Action)
+	assert.Equal(t, uint64(1), response.SubscriptionID)
+
+	hub.NotifyCommittedBlock([]byte("hash"), &block.Header{Nonce: 42}, &block.Body{}, nil, 0)
+
+	event := &api.Event{}
+	readMessage(t, conn, event)
+	assert.Equal(t, uint64(1), event.SubscriptionID)
+	assert.Equal(t, api.EventsTopicBlocks, event.Topic)
+	require.NotNil(t, event.Block)
+	assert.Equal(t, uint64(42), event.Block.Nonce)
+
+	writeRequest(t, conn, &events.Request{Action: events.ActionUnsubscribe, SubscriptionID: 1})
+	response = &events.Response{}
+	readMessage(t, conn, response)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, events.ActionUnsubscribe, response.Action)
+}
+
+func writeRequest(t *testing.T, conn *websocket.Conn, request *events.Request) {
+	buff, err := json.Marshal(request)
+	require.Nil(t, err)
+
+	err = conn.WriteMessage(websocket.TextMessage, buff)
+	require.Nil(t, err)
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn, destination interface{}) {
+	_, message, err := conn.ReadMessage()
+	require.Nil(t, err)
+
+	err = json.Unmarshal(message, destination)
+	require.Nil(t, err)
+}
+
+func startNodeServer(handler events.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	eventsRoutes := ws.Group("/events")
+	if handler != nil {
+		eventsRoutes.Use(middleware.WithFacade(handler))
+	}
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", eventsRoutes, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginEventsRoute := ws.Group("/events")
+	eventsRoute, _ := wrapper.NewRouterWrapper("events", ginEventsRoute, getRoutesConfig())
+	events.Routes(eventsRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/subscribe", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp *bytes.Buffer, destination interface{}) {
+	_ = json.NewDecoder(rsp).Decode(destination)
+}
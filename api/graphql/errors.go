@@ -0,0 +1,9 @@
+package graphql
+
+import "errors"
+
+// ErrNilFacadeHandler signals that the facade handler is missing from the resolvers context
+var ErrNilFacadeHandler = errors.New("nil facade handler")
+
+// ErrInvalidFirstArgument signals that a negative number of items was requested for a list field
+var ErrInvalidFirstArgument = errors.New("invalid first argument")
@@ -0,0 +1,114 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	queryPath     = "/query"
+	queryEndpoint = "/graphql/query"
+)
+
+var log = logger.GetOrCreate("api/graphql")
+
+// FacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type FacadeHandler interface {
+	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
+	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
+	GetESDTBalance(address string, key string) (string, string, error)
+}
+
+// QueryRequest represents the body of a GraphQL query request
+type QueryRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Routes defines the GraphQL related routes
+func Routes(router *wrapper.RouterWrapper) {
+	schema, err := newSchema()
+	if err != nil {
+		log.Error("cannot create the GraphQL schema", "error", err.Error())
+		return
+	}
+
+	router.RegisterHandler(
+		http.MethodPost,
+		queryPath,
+		middleware.CreateEndpointThrottler(queryEndpoint),
+		middleware.CreateGraphQLQueryLimiter(),
+		func(c *gin.Context) {
+			executeQuery(c, schema)
+		},
+	)
+}
+
+// executeQuery runs the read-only query against the node and responds with the standard GraphQL result, so that
+// the usual GraphQL clients can consume it
+func executeQuery(c *gin.Context, schema graphql.Schema) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	request := &QueryRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrInvalidGraphQLQuery.Error(), err.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	result := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  request.Query,
+		VariableValues: request.Variables,
+		OperationName:  request.OperationName,
+		Context:        context.WithValue(c.Request.Context(), facadeContextKey, ef),
+	})
+	log.Debug(fmt.Sprintf("GraphQL query took %s", time.Since(start)))
+
+	c.JSON(http.StatusOK, result)
+}
+
+func getFacade(c *gin.Context) (FacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(FacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
@@ -0,0 +1,280 @@
+package graphql_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/graphql"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queryResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func TestQuery_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	resp := makeQueryRequest(ws, &graphql.QueryRequest{Query: "{ account(address: \"erd1\") { nonce } }"})
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestQuery_TooDeepQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetGraphQLQueryLimitsCalled: func() (uint32, uint32) {
+			return 1, 0
+		},
+	}
+	ws := startNodeServer(facade)
+	resp := makeQueryRequest(ws, &graphql.QueryRequest{Query: "{ account(address: \"erd1\") { nonce } }"})
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGraphQLQueryTooDeep.Error()))
+}
+
+func TestQuery_MutationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	resp := makeQueryRequest(ws, &graphql.QueryRequest{Query: "mutation { account(address: \"erd1\") { nonce } }"})
+
+	response := queryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 1, len(response.Errors))
+	assert.Nil(t, response.Data)
+}
+
+func TestQuery_BlockWithTransactionsAndAccountsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			assert.Equal(t, uint64(5000000000), nonce)
+			assert.True(t, withTxs)
+
+			return &api.Block{
+				Nonce: nonce,
+				Hash:  "blockHash",
+				MiniBlocks: []*api.MiniBlock{
+					{
+						Hash: "mbHash",
+						Transactions: []*transaction.ApiTransactionResult{
+							{Hash: "txHash", Sender: "alice", Data: []byte("data"), Status: transaction.TxStatusSuccess},
+							{Hash: "txHash2", Sender: "bob"},
+						},
+					},
+				},
+			}, nil
+		},
+		GetAccountHandler: func(address string, _ api.AccountQueryOptions) (state.UserAccountHandler, error) {
+			account, _ := state.NewUserAccount([]byte(address))
+			_ = account.AddToBalance(big.NewInt(100))
+			account.IncreaseNonce(7)
+
+			return account, nil
+		},
+		GetESDTBalanceCalled: func(address string, key string) (string, string, error) {
+			assert.Equal(t, "alice", address)
+			assert.Equal(t, "TKN-123456", key)
+
+			return "42", "frozen", nil
+		},
+	}
+	ws := startNodeServer(facade)
+	query := `
+query block($nonce: Uint64!, $numTxs: Int!) {
+	blockByNonce(nonce: $nonce, withTxs: true) {
+		nonce
+		hash
+		miniBlocks(first: 10) {
+			hash
+			transactions(first: $numTxs) {
+				hash
+				data
+				status
+				senderAccount {
+					nonce
+					balance
+					esdtBalance(tokenIdentifier: "TKN-123456") {
+						balance
+						properties
+					}
+				}
+			}
+		}
+	}
+}`
+	resp := makeQueryRequest(ws, &graphql.QueryRequest{
+		Query:     query,
+		Variables: map[string]interface{}{"nonce": "5000000000", "numTxs": 1},
+	})
+
+	response := queryResponse{}
+	loadResponse(resp.Body, &response)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 0, len(response.Errors))
+
+	expectedData := map[string]interface{}{
+		"blockByNonce": map[string]interface{}{
+			"nonce": float64(5000000000),
+			"hash":  "blockHash",
+			"miniBlocks": []interface{}{
+				map[string]interface{}{
+					"hash": "mbHash",
+					"transactions": []interface{}{
+						map[string]interface{}{
+							"hash":   "txHash",
+							"data":   "data",
+							"status": string(transaction.TxStatusSuccess),
+							"senderAccount": map[string]interface{}{
+								"nonce":   float64(7),
+								"balance": "100",
+								"esdtBalance": map[string]interface{}{
+									"balance":    "42",
+									"properties": "frozen",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expectedData, response.Data)
+}
+
+func TestQuery_ListWithoutFirstArgumentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	resp := makeQueryRequest(ws, &graphql.QueryRequest{Query: "{ blockByNonce(nonce: 1) { miniBlocks { hash } } }"})
+
+	response := queryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 1, len(response.Errors))
+	assert.Nil(t, response.Data)
+}
+
+func TestQuery_ListWithNegativeFirstArgumentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return &api.Block{MiniBlocks: []*api.MiniBlock{{Hash: "mbHash"}}}, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	resp := makeQueryRequest(ws, &graphql.QueryRequest{Query: "{ blockByNonce(nonce: 1) { miniBlocks(first: -1) { hash } } }"})
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidGraphQLQuery.Error()))
+}
+
+func TestQuery_ResolverErrorShouldBeReturned(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("transaction not found")
+	facade := &mock.Facade{
+		GetTransactionHandler: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	resp := makeQueryRequest(ws, &graphql.QueryRequest{Query: "{ transaction(hash: \"aa\") { hash } }"})
+
+	response := queryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 1, len(response.Errors))
+	assert.Equal(t, expectedErr.Error(), response.Errors[0].Message)
+}
+
+func makeQueryRequest(ws *gin.Engine, request *graphql.QueryRequest) *httptest.ResponseRecorder {
+	buff, _ := json.Marshal(request)
+	req, _ := http.NewRequest(http.MethodPost, "/graphql/query", bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func startNodeServer(handler graphql.FacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	graphqlRoutes := ws.Group("/graphql")
+	if handler != nil {
+		graphqlRoutes.Use(middleware.WithFacade(handler))
+	}
+	graphqlRoute, _ := wrapper.NewRouterWrapper("graphql", graphqlRoutes, getRoutesConfig())
+	graphql.Routes(graphqlRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginGraphQLRoute := ws.Group("/graphql")
+	graphqlRoute, _ := wrapper.NewRouterWrapper("graphql", ginGraphQLRoute, getRoutesConfig())
+	graphql.Routes(graphqlRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"graphql": {
+				Routes: []config.RouteConfig{
+					{Name: "/query", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp *bytes.Buffer, destination interface{}) {
+	_ = json.NewDecoder(rsp).Decode(destination)
+}
@@ -0,0 +1,395 @@
+package graphql
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+type contextKey string
+
+const facadeContextKey = contextKey("facade")
+
+// firstArgument is the mandatory argument of the list fields, bounding the number of returned items so that the
+// query cost can be computed before the query is executed
+const firstArgument = "first"
+
+// accountNode is the value resolved for the Account type, holding the address next to the account as the
+// ESDT balances are fetched by address
+type accountNode struct {
+	Address  string
+	Nonce    uint64
+	Balance  string
+	Username string
+	CodeHash string
+	RootHash string
+}
+
+// esdtBalanceNode is the value resolved for the ESDTBalance type
+type esdtBalanceNode struct {
+	TokenIdentifier string
+	Balance         string
+	Properties      string
+}
+
+// uint64Scalar is needed as the built-in Int type of GraphQL is limited to 32 bits, not enough for nonces or gas values
+var uint64Scalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Uint64",
+	Description: "The `Uint64` scalar type represents an unsigned 64-bit integer, accepted both as number and as string",
+	Serialize:   coerceUint64,
+	ParseValue:  coerceUint64,
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch value := valueAST.(type) {
+		case *ast.IntValue:
+			return coerceUint64(value.Value)
+		case *ast.StringValue:
+			return coerceUint64(value.Value)
+		}
+		return nil
+	},
+})
+
+func coerceUint64(value interface{}) interface{} {
+	switch v := value.(type) {
+	case uint64:
+		return v
+	case uint32:
+		return uint64(v)
+	case int:
+		if v < 0 {
+			return nil
+		}
+		return uint64(v)
+	case time.Duration:
+		if v < 0 {
+			return nil
+		}
+		return uint64(v)
+	case float64:
+		if v < 0 || v != float64(uint64(v)) {
+			return nil
+		}
+		return uint64(v)
+	case string:
+		result, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil
+		}
+		return result
+	}
+
+	return nil
+}
+
+// newListField creates a list field returning only the first items, as requested by the mandatory first argument
+func newListField(itemType graphql.Type) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewList(itemType),
+		Args: graphql.FieldConfigArgument{
+			firstArgument: &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+		},
+		Resolve: resolveFirstItems,
+	}
+}
+
+func newSchema() (graphql.Schema, error) {
+	esdtBalanceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ESDTBalance",
+		Fields: graphql.Fields{
+			"tokenIdentifier": &graphql.Field{Type: graphql.String},
+			"balance":         &graphql.Field{Type: graphql.String},
+			"properties":      &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	accountType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Account",
+		Fields: graphql.Fields{
+			"address":  &graphql.Field{Type: graphql.String},
+			"nonce":    &graphql.Field{Type: uint64Scalar},
+			"balance":  &graphql.Field{Type: graphql.String},
+			"username": &graphql.Field{Type: graphql.String},
+			"codeHash": &graphql.Field{Type: graphql.String},
+			"rootHash": &graphql.Field{Type: graphql.String},
+			"esdtBalance": &graphql.Field{
+				Type: esdtBalanceType,
+				Args: graphql.FieldConfigArgument{
+					"tokenIdentifier": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: resolveESDTBalance,
+			},
+		},
+	})
+
+	transactionHopType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TransactionHop",
+		Fields: graphql.Fields{
+			"hash":                              &graphql.Field{Type: graphql.String},
+			"epoch":                             &graphql.Field{Type: uint64Scalar},
+			"sourceShard":                       &graphql.Field{Type: uint64Scalar},
+			"destinationShard":                  &graphql.Field{Type: uint64Scalar},
+			"miniblockHash":                     &graphql.Field{Type: graphql.String},
+			"blockNonce":                        &graphql.Field{Type: uint64Scalar},
+			"notarizedAtDestinationInMetaNonce": &graphql.Field{Type: uint64Scalar},
+			"status":                            &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	transactionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.Fields{
+			"type":             &graphql.Field{Type: graphql.String},
+			"hash":             &graphql.Field{Type: graphql.String},
+			"nonce":            &graphql.Field{Type: uint64Scalar},
+			"round":            &graphql.Field{Type: uint64Scalar},
+			"epoch":            &graphql.Field{Type: uint64Scalar},
+			"value":            &graphql.Field{Type: graphql.String},
+			"sender":           &graphql.Field{Type: graphql.String},
+			"receiver":         &graphql.Field{Type: graphql.String},
+			"gasPrice":         &graphql.Field{Type: uint64Scalar},
+			"gasLimit":         &graphql.Field{Type: uint64Scalar},
+			"data":             &graphql.Field{Type: graphql.String, Resolve: resolveTransactionData},
+			"signature":        &graphql.Field{Type: graphql.String},
+			"sourceShard":      &graphql.Field{Type: uint64Scalar},
+			"destinationShard": &graphql.Field{Type: uint64Scalar},
+			"blockNonce":       &graphql.Field{Type: uint64Scalar},
+			"blockHash":        &graphql.Field{Type: graphql.String},
+			"miniblockType":    &graphql.Field{Type: graphql.String},
+			"miniblockHash":    &graphql.Field{Type: graphql.String},
+			"status":           &graphql.Field{Type: graphql.String},
+			"hops":             newListField(transactionHopType),
+			"senderAccount":    &graphql.Field{Type: accountType, Resolve: resolveSenderAccount},
+			"receiverAccount":  &graphql.Field{Type: accountType, Resolve: resolveReceiverAccount},
+		},
+	})
+
+	miniBlockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "MiniBlock",
+		Fields: graphql.Fields{
+			"hash":             &graphql.Field{Type: graphql.String},
+			"type":             &graphql.Field{Type: graphql.String},
+			"sourceShard":      &graphql.Field{Type: uint64Scalar},
+			"destinationShard": &graphql.Field{Type: uint64Scalar},
+			"transactions":     newListField(transactionType),
+		},
+	})
+
+	blockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.Fields{
+			"nonce":           &graphql.Field{Type: uint64Scalar},
+			"round":           &graphql.Field{Type: uint64Scalar},
+			"hash":            &graphql.Field{Type: graphql.String},
+			"prevBlockHash":   &graphql.Field{Type: graphql.String},
+			"epoch":           &graphql.Field{Type: uint64Scalar},
+			"shard":           &graphql.Field{Type: uint64Scalar},
+			"numTxs":          &graphql.Field{Type: uint64Scalar},
+			"timestamp":       &graphql.Field{Type: uint64Scalar},
+			"accumulatedFees": &graphql.Field{Type: graphql.String},
+			"developerFees":   &graphql.Field{Type: graphql.String},
+			"status":          &graphql.Field{Type: graphql.String},
+			"miniBlocks":      newListField(miniBlockType),
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"blockByNonce": &graphql.Field{
+				Type: blockType,
+				Args: graphql.FieldConfigArgument{
+					"nonce":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(uint64Scalar)},
+					"withTxs": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: resolveBlockByNonce,
+			},
+			"blockByHash": &graphql.Field{
+				Type: blockType,
+				Args: graphql.FieldConfigArgument{
+					"hash":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"withTxs": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: resolveBlockByHash,
+			},
+			"transaction": &graphql.Field{
+				Type: transactionType,
+				Args: graphql.FieldConfigArgument{
+					"hash":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"withResults": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: resolveTransaction,
+			},
+			"account": &graphql.Field{
+				Type: accountType,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: resolveAccount,
+			},
+		},
+	})
+
+	// no mutation type is defined, so that the endpoint stays read-only
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+}
+
+func getFacadeFromParams(p graphql.ResolveParams) (FacadeHandler, error) {
+	facade, ok := p.Context.Value(facadeContextKey).(FacadeHandler)
+	if !ok {
+		return nil, ErrNilFacadeHandler
+	}
+
+	return facade, nil
+}
+
+func resolveBlockByNonce(p graphql.ResolveParams) (interface{}, error) {
+	facade, err := getFacadeFromParams(p)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, _ := p.Args["nonce"].(uint64)
+	withTxs, _ := p.Args["withTxs"].(bool)
+
+	return facade.GetBlockByNonce(nonce, withTxs)
+}
+
+func resolveBlockByHash(p graphql.ResolveParams) (interface{}, error) {
+	facade, err := getFacadeFromParams(p)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, _ := p.Args["hash"].(string)
+	withTxs, _ := p.Args["withTxs"].(bool)
+
+	return facade.GetBlockByHash(hash, withTxs)
+}
+
+func resolveTransaction(p graphql.ResolveParams) (interface{}, error) {
+	facade, err := getFacadeFromParams(p)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, _ := p.Args["hash"].(string)
+	withResults, _ := p.Args["withResults"].(bool)
+
+	return facade.GetTransaction(hash, withResults)
+}
+
+func resolveAccount(p graphql.ResolveParams) (interface{}, error) {
+	address, _ := p.Args["address"].(string)
+
+	return getAccountNode(p, address)
+}
+
+func resolveSenderAccount(p graphql.ResolveParams) (interface{}, error) {
+	tx, ok := p.Source.(*transaction.ApiTransactionResult)
+	if !ok || len(tx.Sender) == 0 {
+		return nil, nil
+	}
+
+	return getAccountNode(p, tx.Sender)
+}
+
+func resolveReceiverAccount(p graphql.ResolveParams) (interface{}, error) {
+	tx, ok := p.Source.(*transaction.ApiTransactionResult)
+	if !ok || len(tx.Receiver) == 0 {
+		return nil, nil
+	}
+
+	return getAccountNode(p, tx.Receiver)
+}
+
+func getAccountNode(p graphql.ResolveParams, address string) (interface{}, error) {
+	facade, err := getFacadeFromParams(p)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := facade.GetAccount(address, api.AccountQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return newAccountNode(address, account), nil
+}
+
+func newAccountNode(address string, account state.UserAccountHandler) *accountNode {
+	balance := "0"
+	if account.GetBalance() != nil {
+		balance = account.GetBalance().String()
+	}
+
+	return &accountNode{
+		Address:  address,
+		Nonce:    account.GetNonce(),
+		Balance:  balance,
+		Username: string(account.GetUserName()),
+		CodeHash: hex.EncodeToString(account.GetCodeHash()),
+		RootHash: hex.EncodeToString(account.GetRootHash()),
+	}
+}
+
+func resolveESDTBalance(p graphql.ResolveParams) (interface{}, error) {
+	account, ok := p.Source.(*accountNode)
+	if !ok {
+		return nil, nil
+	}
+
+	facade, err := getFacadeFromParams(p)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIdentifier, _ := p.Args["tokenIdentifier"].(string)
+	balance, properties, err := facade.GetESDTBalance(account.Address, tokenIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &esdtBalanceNode{
+		TokenIdentifier: tokenIdentifier,
+		Balance:         balance,
+		Properties:      properties,
+	}, nil
+}
+
+func resolveTransactionData(p graphql.ResolveParams) (interface{}, error) {
+	tx, ok := p.Source.(*transaction.ApiTransactionResult)
+	if !ok {
+		return nil, nil
+	}
+
+	return string(tx.Data), nil
+}
+
+func resolveFirstItems(p graphql.ResolveParams) (interface{}, error) {
+	first, _ := p.Args[firstArgument].(int)
+	if first < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidFirstArgument, first)
+	}
+
+	items, err := graphql.DefaultResolveFn(p)
+	if err != nil {
+		return nil, err
+	}
+
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice || value.Len() <= first {
+		return items, nil
+	}
+
+	return value.Slice(0, first).Interface(), nil
+}
@@ -0,0 +1,195 @@
+package hyperblock_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/hyperblock"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type hyperblockResponseData struct {
+	Hyperblock api.Hyperblock `json:"hyperblock"`
+}
+
+type hyperblockResponse struct {
+	Data  hyperblockResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+func TestGetHyperblockByNonce_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrNilAppContext.Error(), response.Error)
+}
+
+func TestGetHyperblockByNonce_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+}
+
+func TestGetHyperblockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidBlockNonce.Error()))
+}
+
+func TestGetHyperblockByNonce_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := &mock.Facade{
+		GetHyperblockByNonceCalled: func(_ uint64) (*api.Hyperblock, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetHyperblock.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetHyperblockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := api.Hyperblock{
+		Nonce:        5,
+		ShardBlocks:  []*api.NotarizedBlock{{Hash: "aa", Nonce: 10, Shard: 1}},
+		Transactions: []*transaction.ApiTransactionResult{{Hash: "bb", SourceShard: 1, DestinationShard: 0}},
+	}
+	facade := &mock.Facade{
+		GetHyperblockByNonceCalled: func(nonce uint64) (*api.Hyperblock, error) {
+			assert.Equal(t, uint64(5), nonce)
+			return &expectedHyperblock, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-nonce/5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedHyperblock, response.Data.Hyperblock)
+}
+
+func TestGetHyperblockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := api.Hyperblock{
+		Nonce:        5,
+		Hash:         "hash",
+		ShardBlocks:  []*api.NotarizedBlock{},
+		Transactions: []*transaction.ApiTransactionResult{},
+	}
+	facade := &mock.Facade{
+		GetHyperblockByHashCalled: func(hash string) (*api.Hyperblock, error) {
+			assert.Equal(t, "hash", hash)
+			return &expectedHyperblock, nil
+		},
+	}
+	ws := startNodeServer(facade)
+	req, _ := http.NewRequest("GET", "/hyperblock/by-hash/hash", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedHyperblock, response.Data.Hyperblock)
+}
+
+func startNodeServer(handler hyperblock.HyperblockService) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	hyperblockRoutes := ws.Group("/hyperblock")
+	if handler != nil {
+		hyperblockRoutes.Use(middleware.WithFacade(handler))
+	}
+	hyperblockRoute, _ := wrapper.NewRouterWrapper("hyperblock", hyperblockRoutes, getRoutesConfig())
+	hyperblock.Routes(hyperblockRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginHyperblockRoute := ws.Group("/hyperblock")
+	hyperblockRoute, _ := wrapper.NewRouterWrapper("hyperblock", ginHyperblockRoute, getRoutesConfig())
+	hyperblock.Routes(hyperblockRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"hyperblock": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	_ = json.NewDecoder(rsp).Decode(destination)
+}
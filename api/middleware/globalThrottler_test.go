@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -62,7 +63,7 @@ func TestGlobalThrottler_LimitUnderShouldProcessRequest(t *testing.T) {
 
 	addr := "testAddress"
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			return big.NewInt(10), nil
 		},
 	}
@@ -83,7 +84,7 @@ func TestGlobalThrottler_LimitOverShouldError(t *testing.T) {
 	numCalls := uint32(0)
 	responseDelay := time.Second
 	facade := mock.Facade{
-		BalanceHandler: func(s string) (i *big.Int, e error) {
+		BalanceHandler: func(s string, _ api.AccountQueryOptions) (i *big.Int, e error) {
 			time.Sleep(responseDelay)
 			atomic.AddUint32(&numCalls, 1)
 
@@ -0,0 +1,320 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// maxGraphQLRequestBodySize bounds the size of the request body read before the query is parsed
+const maxGraphQLRequestBodySize = 1 << 20
+
+// graphQLListSizeArgument is the argument bounding the number of items returned by a list field
+const graphQLListSizeArgument = "first"
+
+type graphQLQueryLimitsGetter interface {
+	GetGraphQLQueryLimits() (maxDepth uint32, maxCost uint32)
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// CreateGraphQLQueryLimiter will create a middleware-type of handler that rejects the GraphQL queries whose depth or
+// cost exceed the limits configured on the node. The depth is the maximum nesting level of the selected fields while
+// the cost is the total number of fields that the query can return, after expanding the fragments. The fields selected
+// under a list field are counted once for each of the items requested by its first argument
+func CreateGraphQLQueryLimiter() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		lgObj, ok := c.Get("facade")
+		if !ok {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrNilAppContext.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		lg, ok := lgObj.(graphQLQueryLimitsGetter)
+		if !ok {
+			c.AbortWithStatusJSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: errors.ErrInvalidAppContext.Error(),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+
+		request, err := readGraphQLRequest(c)
+		if err != nil {
+			abortWithRequestError(c, fmt.Sprintf("%s: %s", errors.ErrInvalidGraphQLQuery.Error(), err.Error()))
+			return
+		}
+
+		depth, cost, err := computeGraphQLQueryComplexity(request.Query, request.Variables)
+		if err != nil {
+			abortWithRequestError(c, fmt.Sprintf("%s: %s", errors.ErrInvalidGraphQLQuery.Error(), err.Error()))
+			return
+		}
+
+		maxDepth, maxCost := lg.GetGraphQLQueryLimits()
+		if maxDepth > 0 && depth > maxDepth {
+			abortWithRequestError(c, fmt.Sprintf("%s: depth %d, maximum %d", errors.ErrGraphQLQueryTooDeep.Error(), depth, maxDepth))
+			return
+		}
+		if maxCost > 0 && cost > maxCost {
+			abortWithRequestError(c, fmt.Sprintf("%s: cost %d, maximum %d", errors.ErrGraphQLQueryTooCostly.Error(), cost, maxCost))
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// readGraphQLRequest extracts the query and its variables from the request body, restoring the body for the next
+// handlers. Bodies larger than maxGraphQLRequestBodySize are rejected
+func readGraphQLRequest(c *gin.Context) (*graphQLRequest, error) {
+	request := &graphQLRequest{}
+	if c.Request.Body == nil {
+		return request, nil
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxGraphQLRequestBodySize))
+	if err != nil {
+		return nil, err
+	}
+	c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	err = json.Unmarshal(body, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func computeGraphQLQueryComplexity(query string, variables map[string]interface{}) (uint32, uint32, error) {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	fragments := make(map[string]*ast.FragmentDefinition)
+	operations := make([]*ast.OperationDefinition, 0)
+	for _, definition := range document.Definitions {
+		switch def := definition.(type) {
+		case *ast.FragmentDefinition:
+			fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			operations = append(operations, def)
+		}
+	}
+
+	maxDepth := uint32(0)
+	totalCost := uint64(0)
+	for _, operation := range operations {
+		calculator := &graphQLComplexityCalculator{
+			fragments:        fragments,
+			visitedFragments: make(map[string]bool),
+			computedSpreads:  make(map[fragmentSpreadKey]fragmentSpreadComplexity),
+			variables:        getOperationVariables(operation, variables),
+		}
+
+		depth, cost, errCompute := calculator.compute(operation.SelectionSet, 0)
+		if errCompute != nil {
+			return 0, 0, errCompute
+		}
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+		totalCost = capGraphQLCost(totalCost + cost)
+	}
+
+	return maxDepth, uint32(totalCost), nil
+}
+
+// getOperationVariables returns the provided variables, completed with the default values declared by the operation
+func getOperationVariables(operation *ast.OperationDefinition, variables map[string]interface{}) map[string]interface{} {
+	operationVariables := make(map[string]interface{})
+	for _, definition := range operation.VariableDefinitions {
+		if definition.DefaultValue != nil {
+			operationVariables[definition.Variable.Name.Value] = definition.DefaultValue.GetValue()
+		}
+	}
+	for name, value := range variables {
+		operationVariables[name] = value
+	}
+
+	return operationVariables
+}
+
+type fragmentSpreadKey struct {
+	name  string
+	depth uint32
+}
+
+type fragmentSpreadComplexity struct {
+	reachedDepth uint32
+	cost         uint64
+}
+
+type graphQLComplexityCalculator struct {
+	fragments        map[string]*ast.FragmentDefinition
+	visitedFragments map[string]bool
+	computedSpreads  map[fragmentSpreadKey]fragmentSpreadComplexity
+	variables        map[string]interface{}
+}
+
+// compute returns the depth reached under the selection set and the cost of its fields. Fragments spread
+// recursively into themselves are counted only once, as such queries are rejected anyway by the validation step
+func (gcc *graphQLComplexityCalculator) compute(selectionSet *ast.SelectionSet, depth uint32) (uint32, uint64, error) {
+	if selectionSet == nil {
+		return depth, 0, nil
+	}
+
+	maxDepth := depth
+	cost := uint64(0)
+	for _, selection := range selectionSet.Selections {
+		var reachedDepth uint32
+		var selectionCost uint64
+		var err error
+		switch sel := selection.(type) {
+		case *ast.Field:
+			reachedDepth, selectionCost, err = gcc.computeField(sel, depth)
+		case *ast.InlineFragment:
+			reachedDepth, selectionCost, err = gcc.compute(sel.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			reachedDepth, selectionCost, err = gcc.computeFragmentSpread(sel.Name.Value, depth)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if reachedDepth > maxDepth {
+			maxDepth = reachedDepth
+		}
+		cost = capGraphQLCost(cost + selectionCost)
+	}
+
+	return maxDepth, cost, nil
+}
+
+// computeFragmentSpread computes a fragment only once for each depth it is used at, otherwise fragments
+// spread several times into each other would be expanded an exponential number of times
+func (gcc *graphQLComplexityCalculator) computeFragmentSpread(name string, depth uint32) (uint32, uint64, error) {
+	fragment, ok := gcc.fragments[name]
+	if !ok || gcc.visitedFragments[name] {
+		return depth, 0, nil
+	}
+
+	key := fragmentSpreadKey{name: name, depth: depth}
+	computed, ok := gcc.computedSpreads[key]
+	if ok {
+		return computed.reachedDepth, computed.cost, nil
+	}
+
+	gcc.visitedFragments[name] = true
+	reachedDepth, cost, err := gcc.compute(fragment.SelectionSet, depth)
+	delete(gcc.visitedFragments, name)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	gcc.computedSpreads[key] = fragmentSpreadComplexity{
+		reachedDepth: reachedDepth,
+		cost:         cost,
+	}
+
+	return reachedDepth, cost, nil
+}
+
+// computeField counts the field itself plus its selected fields, once for each of the requested list items
+func (gcc *graphQLComplexityCalculator) computeField(field *ast.Field, depth uint32) (uint32, uint64, error) {
+	reachedDepth, childrenCost, err := gcc.compute(field.SelectionSet, depth+1)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	numItems, err := gcc.getListSize(field)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return reachedDepth, capGraphQLCost(1 + numItems*childrenCost), nil
+}
+
+// getListSize returns the value of the list size argument of the field, or 1 if the field does not have one
+func (gcc *graphQLComplexityCalculator) getListSize(field *ast.Field) (uint64, error) {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != graphQLListSizeArgument {
+			continue
+		}
+
+		var value interface{} = argument.Value.GetValue()
+		variable, isVariable := argument.Value.(*ast.Variable)
+		if isVariable {
+			value = gcc.variables[variable.Name.Value]
+		}
+
+		listSize, ok := parseGraphQLListSize(value)
+		if !ok {
+			return 0, fmt.Errorf("invalid %s argument of field %s: %v", graphQLListSizeArgument, field.Name.Value, value)
+		}
+
+		return listSize, nil
+	}
+
+	return 1, nil
+}
+
+// parseGraphQLListSize accepts both the literal values from the query and the JSON decoded variables
+func parseGraphQLListSize(value interface{}) (uint64, bool) {
+	switch v := value.(type) {
+	case string:
+		listSize, err := strconv.ParseUint(v, 10, 32)
+		return listSize, err == nil
+	case float64:
+		isValid := v >= 0 && v <= math.MaxUint32 && v == math.Trunc(v)
+		return uint64(v), isValid
+	}
+
+	return 0, false
+}
+
+// capGraphQLCost bounds the cost to the uint32 range, so that the computation can not overflow
+func capGraphQLCost(cost uint64) uint64 {
+	if cost > math.MaxUint32 {
+		return math.MaxUint32
+	}
+
+	return cost
+}
+
+func abortWithRequestError(c *gin.Context, message string) {
+	c.AbortWithStatusJSON(
+		http.StatusBadRequest,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: message,
+			Code:  shared.ReturnCodeRequestError,
+		},
+	)
+}
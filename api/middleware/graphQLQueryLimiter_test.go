@@ -0,0 +1,255 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nestedGraphQLQuery = `
+query {
+	blockByNonce(nonce: 1) {
+		...blockFields
+		miniBlocks {
+			transactions {
+				hash
+			}
+		}
+	}
+}
+
+fragment blockFields on Block {
+	hash
+	nonce
+}`
+
+func startNodeServerGraphQLQueryLimiter(handler interface{}, receivedBody *string) *gin.Engine {
+	ws := gin.New()
+	if handler != nil {
+		ws.Use(middleware.WithFacade(handler))
+	}
+	ws.POST("/graphql/query", middleware.CreateGraphQLQueryLimiter(), func(c *gin.Context) {
+		body, _ := ioutil.ReadAll(c.Request.Body)
+		*receivedBody = string(body)
+		c.JSON(http.StatusOK, nil)
+	})
+
+	return ws
+}
+
+const listsGraphQLQuery = `
+query block($numTxs: Int = 5) {
+	blockByNonce(nonce: 1) {
+		miniBlocks(first: 10) {
+			hash
+			transactions(first: $numTxs) {
+				hash
+				nonce
+			}
+		}
+	}
+}`
+
+func makeGraphQLRequest(ws *gin.Engine, query string) (*httptest.ResponseRecorder, shared.GenericAPIResponse) {
+	return makeGraphQLRequestWithVariables(ws, query, nil)
+}
+
+func makeGraphQLRequestWithVariables(
+	ws *gin.Engine,
+	query string,
+	variables map[string]interface{},
+) (*httptest.ResponseRecorder, shared.GenericAPIResponse) {
+	buff, _ := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	req, _ := http.NewRequest(http.MethodPost, "/graphql/query", bytes.NewBuffer(buff))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	_ = json.NewDecoder(resp.Body).Decode(&response)
+
+	return resp, response
+}
+
+func createGraphQLLimitsFacade(maxDepth uint32, maxCost uint32) *mock.Facade {
+	return &mock.Facade{
+		GetGraphQLQueryLimitsCalled: func() (uint32, uint32) {
+			return maxDepth, maxCost
+		},
+	}
+}
+
+func TestCreateGraphQLQueryLimiter_NilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(nil, &body)
+	resp, response := makeGraphQLRequest(ws, "{ account(address: \"erd1\") { nonce } }")
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrNilAppContext.Error(), response.Error)
+	assert.Equal(t, "", body)
+}
+
+func TestCreateGraphQLQueryLimiter_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(&struct{}{}, &body)
+	resp, response := makeGraphQLRequest(ws, "{ account(address: \"erd1\") { nonce } }")
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, apiErrors.ErrInvalidAppContext.Error(), response.Error)
+	assert.Equal(t, "", body)
+}
+
+func TestCreateGraphQLQueryLimiter_InvalidQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(0, 0), &body)
+	resp, response := makeGraphQLRequest(ws, "{ account(address: ")
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidGraphQLQuery.Error()))
+	assert.Equal(t, "", body)
+}
+
+func TestCreateGraphQLQueryLimiter_TooDeepQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(3, 0), &body)
+	resp, response := makeGraphQLRequest(ws, nestedGraphQLQuery)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGraphQLQueryTooDeep.Error()))
+	assert.True(t, strings.Contains(response.Error, "depth 4"))
+	assert.Equal(t, "", body)
+}
+
+func TestCreateGraphQLQueryLimiter_TooCostlyQueryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(0, 5), &body)
+	resp, response := makeGraphQLRequest(ws, nestedGraphQLQuery)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGraphQLQueryTooCostly.Error()))
+	assert.True(t, strings.Contains(response.Error, "cost 6"))
+	assert.Equal(t, "", body)
+}
+
+func TestCreateGraphQLQueryLimiter_RecursiveFragmentsShouldNotLoop(t *testing.T) {
+	t.Parallel()
+
+	query := `
+{ account(address: "erd1") { ...first } }
+fragment first on Account { nonce ...second }
+fragment second on Account { balance ...first }`
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(0, 3), &body)
+	resp, _ := makeGraphQLRequest(ws, query)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestCreateGraphQLQueryLimiter_RepeatedFragmentSpreadsShouldNotBeExpandedExponentially(t *testing.T) {
+	t.Parallel()
+
+	numFragments := 64
+	query := "{ account(address: \"erd1\") { ...f0 } }\n"
+	for i := 0; i < numFragments-1; i++ {
+		query += fmt.Sprintf("fragment f%d on Account { nonce ...f%d ...f%d }\n", i, i+1, i+1)
+	}
+	query += fmt.Sprintf("fragment f%d on Account { nonce }", numFragments-1)
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(0, 1000), &body)
+	resp, response := makeGraphQLRequest(ws, query)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGraphQLQueryTooCostly.Error()))
+	assert.True(t, strings.Contains(response.Error, fmt.Sprintf("cost %d", uint32(math.MaxUint32))))
+	assert.Equal(t, "", body)
+}
+
+func TestCreateGraphQLQueryLimiter_WithinLimitsShouldForwardTheBody(t *testing.T) {
+	t.Parallel()
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(4, 6), &body)
+	resp, _ := makeGraphQLRequest(ws, nestedGraphQLQuery)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	request := make(map[string]string)
+	err := json.Unmarshal([]byte(body), &request)
+	require.Nil(t, err)
+	assert.Equal(t, nestedGraphQLQuery, request["query"])
+}
+
+func TestCreateGraphQLQueryLimiter_ListFieldsShouldMultiplyTheCost(t *testing.T) {
+	t.Parallel()
+
+	// with the default 5 transactions: 1 + (1 + 10 * (1 + (1 + 5 * 2))) = 122
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(0, 122), &body)
+	resp, _ := makeGraphQLRequest(ws, listsGraphQLQuery)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	// with 20 transactions: 1 + (1 + 10 * (1 + (1 + 20 * 2))) = 422
+	body = ""
+	resp, response := makeGraphQLRequestWithVariables(ws, listsGraphQLQuery, map[string]interface{}{"numTxs": 20})
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGraphQLQueryTooCostly.Error()))
+	assert.True(t, strings.Contains(response.Error, "cost 422"))
+	assert.Equal(t, "", body)
+}
+
+func TestCreateGraphQLQueryLimiter_InvalidListSizeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(0, 0), &body)
+
+	resp, response := makeGraphQLRequestWithVariables(ws, listsGraphQLQuery, map[string]interface{}{"numTxs": -1})
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidGraphQLQuery.Error()))
+
+	resp, response = makeGraphQLRequestWithVariables(ws, listsGraphQLQuery, map[string]interface{}{"numTxs": "many"})
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidGraphQLQuery.Error()))
+
+	resp, response = makeGraphQLRequest(ws, "{ blockByNonce(nonce: 1) { miniBlocks(first: $missing) { hash } } }")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidGraphQLQuery.Error()))
+	assert.Equal(t, "", body)
+}
+
+func TestCreateGraphQLQueryLimiter_TooLargeBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	body := ""
+	ws := startNodeServerGraphQLQueryLimiter(createGraphQLLimitsFacade(0, 0), &body)
+	query := "{ account(address: \"erd1\") { nonce } }" + strings.Repeat(" ", 2<<20)
+	resp, response := makeGraphQLRequest(ws, query)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidGraphQLQuery.Error()))
+	assert.Equal(t, "", body)
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 
@@ -22,8 +23,8 @@ type Facade struct {
 	ShouldErrorStop            bool
 	TpsBenchmarkHandler        func() *statistics.TpsBenchmark
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
-	BalanceHandler             func(string) (*big.Int, error)
-	GetAccountHandler          func(address string) (state.UserAccountHandler, error)
+	BalanceHandler             func(string, api.AccountQueryOptions) (*big.Int, error)
+	GetAccountHandler          func(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
 	GetCodeCalled              func(state.AccountHandler) []byte
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
@@ -38,11 +39,16 @@ type Facade struct {
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                    func(address string, key string) (string, error)
+	GetValueForKeyCalled                    func(address string, key string, options api.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
+	GetGraphQLQueryLimitsCalled             func() (uint32, uint32)
 	GetUsernameCalled                       func(address string) (string, error)
-	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
+	GetKeyValuePairsCalled                  func(address string, options api.AccountQueryOptions) (map[string]string, error)
+	GetKeyValuePairsPageCalled              func(ctx context.Context, address string, startKey string, limit int, options api.AccountQueryOptions) (map[string]string, string, error)
+	StreamKeyValuePairsCalled               func(ctx context.Context, address string, startKey string, options api.AccountQueryOptions, handler func(key string, value string) error) error
+	GetTransactionsByAddressCalled          func(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error)
+	CreateEventsSubscriberCalled            func() (api.EventsSubscriber, error)
 	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	GetNumCheckpointsFromAccountStateCalled func() uint32
 	GetNumCheckpointsFromPeerStateCalled    func() uint32
@@ -50,9 +56,18 @@ type Facade struct {
 	GetAllESDTTokensCalled                  func(address string) ([]string, error)
 	GetBlockByHashCalled                    func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                   func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetTransactionsCalled                   func(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error)
+	GetBlocksByNonceRangeCalled             func(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
+	GetHyperblockByNonceCalled              func(nonce uint64) (*api.Hyperblock, error)
+	GetHyperblockByHashCalled               func(hash string) (*api.Hyperblock, error)
 	GetTotalStakedValueHandler              func() (*api.StakeValues, error)
 	GetDirectStakedListHandler              func() ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                func() ([]*api.Delegator, error)
+	GetProofCalled                          func(rootHash string, address string) ([][]byte, error)
+	GetProofDataTrieCalled                  func(rootHash string, address string, key string) ([][]byte, [][]byte, error)
+	VerifyProofCalled                       func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetStateDiffCalled                      func(fromRootHash string, toRootHash string) (*api.StateDiff, error)
+	GetTrieAnalysisCalled                   func(rootHash string, numTopAccounts uint32) (*api.TrieAnalysis, error)
 }
 
 // GetUsername -
@@ -73,6 +88,15 @@ func (f *Facade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	return nil, false
 }
 
+// GetGraphQLQueryLimits -
+func (f *Facade) GetGraphQLQueryLimits() (uint32, uint32) {
+	if f.GetGraphQLQueryLimitsCalled != nil {
+		return f.GetGraphQLQueryLimitsCalled()
+	}
+
+	return 0, 0
+}
+
 // RestApiInterface -
 func (f *Facade) RestApiInterface() string {
 	return "localhost:8080"
@@ -102,23 +126,71 @@ func (f *Facade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 }
 
 // GetBalance is the mock implementation of a handler's GetBalance method
-func (f *Facade) GetBalance(address string) (*big.Int, error) {
-	return f.BalanceHandler(address)
+func (f *Facade) GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	return f.BalanceHandler(address, options)
 }
 
 // GetValueForKey is the mock implementation of a handler's GetValueForKey method
-func (f *Facade) GetValueForKey(address string, key string) (string, error) {
+func (f *Facade) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, error) {
 	if f.GetValueForKeyCalled != nil {
-		return f.GetValueForKeyCalled(address, key)
+		return f.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
 }
 
 // GetKeyValuePairs -
-func (f *Facade) GetKeyValuePairs(address string) (map[string]string, error) {
+func (f *Facade) GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error) {
 	if f.GetKeyValuePairsCalled != nil {
-		return f.GetKeyValuePairsCalled(address)
+		return f.GetKeyValuePairsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetKeyValuePairsPage -
+func (f *Facade) GetKeyValuePairsPage(
+	ctx context.Context,
+	address string,
+	startKey string,
+	limit int,
+	options api.AccountQueryOptions,
+) (map[string]string, string, error) {
+	if f.GetKeyValuePairsPageCalled != nil {
+		return f.GetKeyValuePairsPageCalled(ctx, address, startKey, limit, options)
+	}
+
+	return nil, "", nil
+}
+
+// StreamKeyValuePairs -
+func (f *Facade) StreamKeyValuePairs(
+	ctx context.Context,
+	address string,
+	startKey string,
+	options api.AccountQueryOptions,
+	handler func(key string, value string) error,
+) error {
+	if f.StreamKeyValuePairsCalled != nil {
+		return f.StreamKeyValuePairsCalled(ctx, address, startKey, options, handler)
+	}
+
+	return nil
+}
+
+// GetTransactionsByAddress -
+func (f *Facade) GetTransactionsByAddress(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, offset, limit)
+	}
+
+	return nil, 0, nil
+}
+
+// CreateEventsSubscriber -
+func (f *Facade) CreateEventsSubscriber() (api.EventsSubscriber, error) {
+	if f.CreateEventsSubscriberCalled != nil {
+		return f.CreateEventsSubscriberCalled()
 	}
 
 	return nil, nil
@@ -143,8 +215,8 @@ func (f *Facade) GetAllESDTTokens(address string) ([]string, error) {
 }
 
 // GetAccount is the mock implementation of a handler's GetAccount method
-func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return f.GetAccountHandler(address)
+func (f *Facade) GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return f.GetAccountHandler(address, options)
 }
 
 // GetCode -
@@ -180,6 +252,15 @@ func (f *Facade) GetTransaction(hash string, withResults bool) (*transaction.Api
 	return f.GetTransactionHandler(hash, withResults)
 }
 
+// GetTransactions -
+func (f *Facade) GetTransactions(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error) {
+	if f.GetTransactionsCalled != nil {
+		return f.GetTransactionsCalled(hashes, withResults)
+	}
+
+	return nil, nil
+}
+
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
 func (f *Facade) SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error) {
 	return f.SimulateTransactionExecutionHandler(tx)
@@ -288,6 +369,58 @@ func (f *Facade) GetBlockByHash(hash string, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByHashCalled(hash, withTxs)
 }
 
+// GetBlocksByNonceRange -
+func (f *Facade) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+	if f.GetBlocksByNonceRangeCalled != nil {
+		return f.GetBlocksByNonceRangeCalled(from, to, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (f *Facade) GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error) {
+	if f.GetHyperblockByNonceCalled != nil {
+		return f.GetHyperblockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByHash -
+func (f *Facade) GetHyperblockByHash(hash string) (*api.Hyperblock, error) {
+	if f.GetHyperblockByHashCalled != nil {
+		return f.GetHyperblockByHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetProof -
+func (f *Facade) GetProof(rootHash string, address string) ([][]byte, error) {
+	return f.GetProofCalled(rootHash, address)
+}
+
+// GetProofDataTrie -
+func (f *Facade) GetProofDataTrie(rootHash string, address string, key string) ([][]byte, [][]byte, error) {
+	return f.GetProofDataTrieCalled(rootHash, address, key)
+}
+
+// VerifyProof -
+func (f *Facade) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
+	return f.VerifyProofCalled(rootHash, address, proof)
+}
+
+// GetStateDiff -
+func (f *Facade) GetStateDiff(fromRootHash string, toRootHash string) (*api.StateDiff, error) {
+	return f.GetStateDiffCalled(fromRootHash, toRootHash)
+}
+
+// GetTrieAnalysis -
+func (f *Facade) GetTrieAnalysis(rootHash string, numTopAccounts uint32) (*api.TrieAnalysis, error) {
+	return f.GetTrieAnalysisCalled(rootHash, numTopAccounts)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (f *Facade) IsInterfaceNil() bool {
 	return f == nil
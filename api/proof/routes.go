@@ -0,0 +1,205 @@
+package proof
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getProofPath         = "/root-hash/:roothash/address/:address"
+	getProofDataTriePath = "/root-hash/:roothash/address/:address/key/:key"
+	verifyProofPath      = "/verify"
+)
+
+// ProofFacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type ProofFacadeHandler interface {
+	GetProof(rootHash string, address string) ([][]byte, error)
+	GetProofDataTrie(rootHash string, address string, key string) ([][]byte, [][]byte, error)
+	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+}
+
+// VerifyProofRequest represents the parameters needed to verify a Merkle proof
+type VerifyProofRequest struct {
+	RootHash string   `json:"roothash"`
+	Address  string   `json:"address"`
+	Proof    []string `json:"proof"`
+}
+
+// Routes defines Merkle proof related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getProofPath, getProof)
+	router.RegisterHandler(http.MethodGet, getProofDataTriePath, getProofDataTrie)
+	router.RegisterHandler(http.MethodPost, verifyProofPath, verifyProof)
+}
+
+func getProof(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+		)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	proof, err := ef.GetProof(rootHash, address)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proof": bytesToHex(proof)}, "", shared.ReturnCodeSuccess)
+}
+
+func getProofDataTrie(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+		)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	key := c.Param("key")
+	if key == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyKey.Error()),
+		)
+		return
+	}
+
+	mainProof, dataTrieProof, err := ef.GetProofDataTrie(rootHash, address, key)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(
+		c,
+		http.StatusOK,
+		gin.H{
+			"mainProof":     bytesToHex(mainProof),
+			"dataTrieProof": bytesToHex(dataTrieProof),
+		},
+		"",
+		shared.ReturnCodeSuccess,
+	)
+}
+
+func verifyProof(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var verifyProofParams = VerifyProofRequest{}
+	err := c.ShouldBindJSON(&verifyProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	proof := make([][]byte, 0, len(verifyProofParams.Proof))
+	for _, hexProofNode := range verifyProofParams.Proof {
+		proofNode, errDecode := hex.DecodeString(hexProofNode)
+		if errDecode != nil {
+			shared.RespondWithValidationError(
+				c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errDecode.Error()),
+			)
+			return
+		}
+
+		proof = append(proof, proofNode)
+	}
+
+	isValid, err := ef.VerifyProof(verifyProofParams.RootHash, verifyProofParams.Address, proof)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrVerifyProof.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"ok": isValid}, "", shared.ReturnCodeSuccess)
+}
+
+func bytesToHex(proof [][]byte) []string {
+	hexProof := make([]string, 0, len(proof))
+	for _, proofNode := range proof {
+		hexProof = append(hexProof, hex.EncodeToString(proofNode))
+	}
+
+	return hexProof
+}
+
+func getFacade(c *gin.Context) (ProofFacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(ProofFacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
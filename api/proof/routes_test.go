@@ -0,0 +1,354 @@
+package proof_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/proof"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type proofResponseData struct {
+	Proof []string `json:"proof"`
+}
+
+type proofResponse struct {
+	Data  proofResponseData `json:"data"`
+	Error string            `json:"error"`
+	Code  string            `json:"code"`
+}
+
+type proofDataTrieResponseData struct {
+	MainProof     []string `json:"mainProof"`
+	DataTrieProof []string `json:"dataTrieProof"`
+}
+
+type proofDataTrieResponse struct {
+	Data  proofDataTrieResponseData `json:"data"`
+	Error string                    `json:"error"`
+	Code  string                    `json:"code"`
+}
+
+type verifyProofResponseData struct {
+	Ok bool `json:"ok"`
+}
+
+type verifyProofResponse struct {
+	Data  verifyProofResponseData `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+func TestGetProof_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/aabb/address/erd1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetProof_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/aabb/address/erd1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetProof_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetProofCalled: func(_ string, _ string) ([][]byte, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/aabb/address/erd1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rootHash := "aabb"
+	address := "erd1"
+	proofNodes := [][]byte{[]byte("node1"), []byte("node2")}
+	facade := &mock.Facade{
+		GetProofCalled: func(providedRootHash string, providedAddress string) ([][]byte, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			assert.Equal(t, address, providedAddress)
+			return proofNodes, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/proof/root-hash/%s/address/%s", rootHash, address), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []string{hex.EncodeToString(proofNodes[0]), hex.EncodeToString(proofNodes[1])}, response.Data.Proof)
+}
+
+func TestGetProofDataTrie_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetProofDataTrieCalled: func(_ string, _ string, _ string) ([][]byte, [][]byte, error) {
+			return nil, nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/aabb/address/erd1/key/aa", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofDataTrieResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetProofDataTrie_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	key := "aa"
+	mainProof := [][]byte{[]byte("main1"), []byte("main2")}
+	dataTrieProof := [][]byte{[]byte("data1")}
+	facade := &mock.Facade{
+		GetProofDataTrieCalled: func(_ string, _ string, providedKey string) ([][]byte, [][]byte, error) {
+			assert.Equal(t, key, providedKey)
+			return mainProof, dataTrieProof, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/aabb/address/erd1/key/"+key, nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := proofDataTrieResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []string{hex.EncodeToString(mainProof[0]), hex.EncodeToString(mainProof[1])}, response.Data.MainProof)
+	assert.Equal(t, []string{hex.EncodeToString(dataTrieProof[0])}, response.Data.DataTrieProof)
+}
+
+func TestVerifyProof_BadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		VerifyProofCalled: func(_ string, _ string, _ [][]byte) (bool, error) {
+			assert.Fail(t, "should have not been called")
+			return false, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("POST", "/proof/verify", bytes.NewBuffer([]byte("invalid request")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := verifyProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestVerifyProof_InvalidHexProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		VerifyProofCalled: func(_ string, _ string, _ [][]byte) (bool, error) {
+			assert.Fail(t, "should have not been called")
+			return false, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	request := &proof.VerifyProofRequest{
+		RootHash: "aabb",
+		Address:  "erd1",
+		Proof:    []string{"not hex"},
+	}
+	requestBytes, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/proof/verify", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := verifyProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestVerifyProof_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		VerifyProofCalled: func(_ string, _ string, _ [][]byte) (bool, error) {
+			return false, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	request := &proof.VerifyProofRequest{
+		RootHash: "aabb",
+		Address:  "erd1",
+		Proof:    []string{"aa"},
+	}
+	requestBytes, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/proof/verify", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := verifyProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrVerifyProof.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestVerifyProof_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proofNodes := [][]byte{[]byte("node1"), []byte("node2")}
+	facade := &mock.Facade{
+		VerifyProofCalled: func(rootHash string, address string, providedProof [][]byte) (bool, error) {
+			assert.Equal(t, "aabb", rootHash)
+			assert.Equal(t, "erd1", address)
+			assert.Equal(t, proofNodes, providedProof)
+			return true, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	request := &proof.VerifyProofRequest{
+		RootHash: "aabb",
+		Address:  "erd1",
+		Proof:    []string{hex.EncodeToString(proofNodes[0]), hex.EncodeToString(proofNodes[1])},
+	}
+	requestBytes, _ := json.Marshal(request)
+	req, _ := http.NewRequest("POST", "/proof/verify", bytes.NewBuffer(requestBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := verifyProofResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, response.Data.Ok)
+}
+
+func startNodeServer(handler proof.ProofFacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	proofRoutes := ws.Group("/proof")
+	if handler != nil {
+		proofRoutes.Use(middleware.WithFacade(handler))
+	}
+	proofRoute, _ := wrapper.NewRouterWrapper("proof", proofRoutes, getRoutesConfig())
+	proof.Routes(proofRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginProofRoute := ws.Group("/proof")
+	proofRoute, _ := wrapper.NewRouterWrapper("proof", ginProofRoute, getRoutesConfig())
+	proof.Routes(proofRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"proof": {
+				Routes: []config.RouteConfig{
+					{Name: "/root-hash/:roothash/address/:address", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
+					{Name: "/verify", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,147 @@
+package state
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getStateDiffPath    = "/diff"
+	getTrieAnalysisPath = "/analysis"
+	rootHashQueryParam  = "rootHash"
+	topQueryParam       = "top"
+	defaultTopAccounts  = 20
+	maxTopAccounts      = 1000
+)
+
+// StateFacadeHandler interface defines methods that can be used from `elrondFacade` context variable
+type StateFacadeHandler interface {
+	GetStateDiff(fromRootHash string, toRootHash string) (*api.StateDiff, error)
+	GetTrieAnalysis(rootHash string, numTopAccounts uint32) (*api.TrieAnalysis, error)
+}
+
+// Routes defines state related routes
+func Routes(router *wrapper.RouterWrapper) {
+	router.RegisterHandler(http.MethodGet, getStateDiffPath, getStateDiff)
+	router.RegisterHandler(http.MethodGet, getTrieAnalysisPath, getTrieAnalysis)
+}
+
+func getStateDiff(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	fromRootHash := c.Query("from")
+	if fromRootHash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyFromRootHash.Error()),
+		)
+		return
+	}
+
+	toRootHash := c.Query("to")
+	if toRootHash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToRootHash.Error()),
+		)
+		return
+	}
+
+	stateDiff, err := ef.GetStateDiff(fromRootHash, toRootHash)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetStateDiff.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"diff": stateDiff}, "", shared.ReturnCodeSuccess)
+}
+
+func getTrieAnalysis(c *gin.Context) {
+	ef, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	rootHash := c.Request.URL.Query().Get(rootHashQueryParam)
+	if rootHash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+		)
+		return
+	}
+
+	numTopAccounts, err := parseNumTopAccounts(c.Request.URL.Query().Get(topQueryParam))
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	analysis, err := ef.GetTrieAnalysis(rootHash, numTopAccounts)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetTrieAnalysis.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"analysis": analysis}, "", shared.ReturnCodeSuccess)
+}
+
+func parseNumTopAccounts(topStr string) (uint32, error) {
+	if topStr == "" {
+		return defaultTopAccounts, nil
+	}
+
+	top, err := strconv.ParseUint(topStr, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w for %s: %s", errors.ErrInvalidQueryParameter, topQueryParam, err.Error())
+	}
+	if top > maxTopAccounts {
+		return 0, fmt.Errorf("%w for %s: should be at most %d", errors.ErrInvalidQueryParameter, topQueryParam, maxTopAccounts)
+	}
+
+	return uint32(top), nil
+}
+
+func getFacade(c *gin.Context) (StateFacadeHandler, bool) {
+	facadeObj, ok := c.Get("facade")
+	if !ok {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrNilAppContext.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return nil, false
+	}
+
+	facade, ok := facadeObj.(StateFacadeHandler)
+	if !ok {
+		shared.RespondWithInvalidAppContext(c)
+		return nil, false
+	}
+
+	return facade, true
+}
@@ -0,0 +1,207 @@
+package state_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/api/state"
+	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type stateDiffResponseData struct {
+	Diff api.StateDiff `json:"diff"`
+}
+
+type stateDiffResponse struct {
+	Data  stateDiffResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestGetStateDiff_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetStateDiff_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWrongFacade()
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidAppContext.Error()))
+}
+
+func TestGetStateDiff_MissingRootHashesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetStateDiffCalled: func(_ string, _ string) (*api.StateDiff, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/state/diff?to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyFromRootHash.Error()))
+
+	req, _ = http.NewRequest("GET", "/state/diff?from=aa", nil)
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response = stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyToRootHash.Error()))
+}
+
+func TestGetStateDiff_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetStateDiffCalled: func(_ string, _ string) (*api.StateDiff, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetStateDiff_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	stateDiff := api.StateDiff{
+		FromRootHash: "aa",
+		ToRootHash:   "bb",
+		Accounts: []*api.AccountDiff{
+			{
+				LeafDiff: api.LeafDiff{Change: "modified", Key: "01", OldValue: "02", NewValue: "03"},
+				DataTrieChanges: []*api.LeafDiff{
+					{Change: "added", Key: "04", NewValue: "05"},
+				},
+			},
+		},
+	}
+	facade := &mock.Facade{
+		GetStateDiffCalled: func(fromRootHash string, toRootHash string) (*api.StateDiff, error) {
+			assert.Equal(t, "aa", fromRootHash)
+			assert.Equal(t, "bb", toRootHash)
+			return &stateDiff, nil
+		},
+	}
+
+	ws := startNodeServer(facade)
+
+	req, _ := http.NewRequest("GET", "/state/diff?from=aa&to=bb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := stateDiffResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, stateDiff, response.Data.Diff)
+}
+
+func startNodeServer(handler state.StateFacadeHandler) *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	stateRoutes := ws.Group("/state")
+	if handler != nil {
+		stateRoutes.Use(middleware.WithFacade(handler))
+	}
+	stateRoute, _ := wrapper.NewRouterWrapper("state", stateRoutes, getRoutesConfig())
+	state.Routes(stateRoute)
+	return ws
+}
+
+func startNodeServerWrongFacade() *gin.Engine {
+	ws := gin.New()
+	ws.Use(cors.Default())
+	ws.Use(func(c *gin.Context) {
+		c.Set("facade", mock.WrongFacade{})
+	})
+	ginStateRoute := ws.Group("/state")
+	stateRoute, _ := wrapper.NewRouterWrapper("state", ginStateRoute, getRoutesConfig())
+	state.Routes(stateRoute)
+	return ws
+}
+
+func getRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"state": {
+				Routes: []config.RouteConfig{
+					{Name: "/diff", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func loadResponse(rsp io.Reader, destination interface{}) {
+	jsonParser := json.NewDecoder(rsp)
+	err := jsonParser.Decode(destination)
+	logError(err)
+}
+
+func logError(err error) {
+	if err != nil {
+		fmt.Println(err)
+	}
+}
@@ -21,11 +21,18 @@ const (
 	simulateTransactionEndpoint      = "/transaction/simulate"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	getTransactionsBatchEndpoint     = "/transaction/batch"
+	getTransactionsStatusEndpoint    = "/transaction/batch-status"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
+	getTransactionsBatchPath         = "/batch"
+	getTransactionsStatusPath        = "/batch-status"
+
+	// maxTxHashesInBatch limits the number of transactions fetched by a single batch request
+	maxTxHashesInBatch = 1000
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
@@ -40,6 +47,7 @@ type FacadeHandler interface {
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactions(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -78,6 +86,11 @@ type SendTxRequest struct {
 	Options          uint32 `json:"options,omitempty"`
 }
 
+// TxHashesRequest represents the structure that maps the hashes of the transactions requested in a batch
+type TxHashesRequest struct {
+	Hashes []string `json:"hashes"`
+}
+
 //TxResponse represents the structure on which the response will be validated against
 type TxResponse struct {
 	SendTxRequest
@@ -115,6 +128,18 @@ func Routes(router *wrapper.RouterWrapper) {
 		middleware.CreateEndpointThrottler(getTransactionEndpoint),
 		GetTransaction,
 	)
+	router.RegisterHandler(
+		http.MethodPost,
+		getTransactionsBatchPath,
+		middleware.CreateEndpointThrottler(getTransactionsBatchEndpoint),
+		GetTransactionsBatch,
+	)
+	router.RegisterHandler(
+		http.MethodPost,
+		getTransactionsStatusPath,
+		middleware.CreateEndpointThrottler(getTransactionsStatusEndpoint),
+		GetTransactionsStatus,
+	)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -469,6 +494,82 @@ func GetTransaction(c *gin.Context) {
 	)
 }
 
+// GetTransactionsBatch returns the details of the transactions with the given hashes. The hashes that cannot be
+// found are listed separately, so that a partial result is returned
+func GetTransactionsBatch(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	hashes, ok := getTxHashesFromRequest(c)
+	if !ok {
+		return
+	}
+
+	withResults, err := getQueryParamWithResults(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return
+	}
+
+	batch, err := facade.GetTransactions(hashes, withResults)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, fmt.Sprintf("%s: %s", errors.ErrGetTransactions.Error(), err.Error()), shared.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transactions": batch.Transactions, "notFound": batch.NotFound}, "", shared.ReturnCodeSuccess)
+}
+
+// GetTransactionsStatus returns the status of the transactions with the given hashes. The hops followed to finalize
+// the transactions are limited for the whole batch, so a transaction left with truncated hops is reported as
+// successful, but not finalized. The hashes that cannot be found are listed separately, so that a partial result
+// is returned
+func GetTransactionsStatus(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	hashes, ok := getTxHashesFromRequest(c)
+	if !ok {
+		return
+	}
+
+	batch, err := facade.GetTransactions(hashes, false)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, fmt.Sprintf("%s: %s", errors.ErrGetTransactions.Error(), err.Error()), shared.ReturnCodeInternalError)
+		return
+	}
+
+	statuses := make(map[string]transaction.TxStatus, len(batch.Transactions))
+	for hash, tx := range batch.Transactions {
+		statuses[hash] = tx.Status
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"statuses": statuses, "notFound": batch.NotFound}, "", shared.ReturnCodeSuccess)
+}
+
+func getTxHashesFromRequest(c *gin.Context) ([]string, bool) {
+	request := TxHashesRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()))
+		return nil, false
+	}
+	if len(request.Hashes) == 0 {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHashes.Error()))
+		return nil, false
+	}
+	if len(request.Hashes) > maxTxHashesInBatch {
+		shared.RespondWithValidationError(c, fmt.Sprintf("%s: %s, provided %d, maximum %d", errors.ErrValidation.Error(), errors.ErrTooManyTxHashes.Error(), len(request.Hashes), maxTxHashesInBatch))
+		return nil, false
+	}
+
+	return request.Hashes, true
+}
+
 // ComputeTransactionGasLimit returns how many gas units a transaction wil consume
 func ComputeTransactionGasLimit(c *gin.Context) {
 	facade, ok := getFacade(c)
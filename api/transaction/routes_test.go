@@ -64,6 +64,28 @@ type sendSingleTxResponse struct {
 	Code  string                   `json:"code"`
 }
 
+type transactionsBatchResponseData struct {
+	Transactions map[string]*tr.ApiTransactionResult `json:"transactions"`
+	NotFound     []string                            `json:"notFound"`
+}
+
+type transactionsBatchResponse struct {
+	Data  transactionsBatchResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string                        `json:"code"`
+}
+
+type transactionsStatusResponseData struct {
+	Statuses map[string]tr.TxStatus `json:"statuses"`
+	NotFound []string               `json:"notFound"`
+}
+
+type transactionsStatusResponse struct {
+	Data  transactionsStatusResponseData `json:"data"`
+	Error string                         `json:"error"`
+	Code  string                         `json:"code"`
+}
+
 type transactionCostResponseData struct {
 	Cost uint64 `json:"txGasUnits"`
 }
@@ -840,6 +862,143 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestGetTransactionsBatch_WrongPayloadShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	req, _ := http.NewRequest("POST", "/transaction/batch", bytes.NewBuffer([]byte("not a json")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsBatchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestGetTransactionsBatch_EmptyHashesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	jsonBytes, _ := json.Marshal(&transaction.TxHashesRequest{})
+	req, _ := http.NewRequest("POST", "/transaction/batch", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsBatchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyTxHashes.Error()))
+}
+
+func TestGetTransactionsBatch_TooManyHashesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServer(&mock.Facade{})
+
+	hashes := make([]string, 1001)
+	for i := range hashes {
+		hashes[i] = fmt.Sprintf("%064x", i)
+	}
+	jsonBytes, _ := json.Marshal(&transaction.TxHashesRequest{Hashes: hashes})
+	req, _ := http.NewRequest("POST", "/transaction/batch", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsBatchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrTooManyTxHashes.Error()))
+}
+
+func TestGetTransactionsBatch_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetTransactionsCalled: func(hashes []string, withResults bool) (*tr.ApiTransactionsBatch, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(&transaction.TxHashesRequest{Hashes: []string{"aa"}})
+	req, _ := http.NewRequest("POST", "/transaction/batch", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsBatchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactions.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionsBatch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	withResultsReceived := false
+	facade := mock.Facade{
+		GetTransactionsCalled: func(hashes []string, withResults bool) (*tr.ApiTransactionsBatch, error) {
+			withResultsReceived = withResults
+			return &tr.ApiTransactionsBatch{
+				Transactions: map[string]*tr.ApiTransactionResult{
+					hashes[0]: {Nonce: 37, Status: tr.TxStatusSuccess},
+				},
+				NotFound: hashes[1:],
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(&transaction.TxHashesRequest{Hashes: []string{"aa", "bb"}})
+	req, _ := http.NewRequest("POST", "/transaction/batch?withResults=true", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsBatchResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, withResultsReceived)
+	assert.Equal(t, 1, len(response.Data.Transactions))
+	assert.Equal(t, uint64(37), response.Data.Transactions["aa"].Nonce)
+	assert.Equal(t, []string{"bb"}, response.Data.NotFound)
+}
+
+func TestGetTransactionsStatus_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetTransactionsCalled: func(hashes []string, withResults bool) (*tr.ApiTransactionsBatch, error) {
+			return &tr.ApiTransactionsBatch{
+				Transactions: map[string]*tr.ApiTransactionResult{
+					"aa": {Nonce: 37, Status: tr.TxStatusFinalized},
+					"bb": {Nonce: 38, Status: tr.TxStatusPending},
+				},
+				NotFound: []string{"cc"},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	jsonBytes, _ := json.Marshal(&transaction.TxHashesRequest{Hashes: []string{"aa", "bb", "cc"}})
+	req, _ := http.NewRequest("POST", "/transaction/batch-status", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsStatusResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	expectedStatuses := map[string]tr.TxStatus{
+		"aa": tr.TxStatusFinalized,
+		"bb": tr.TxStatusPending,
+	}
+	assert.Equal(t, expectedStatuses, response.Data.Statuses)
+	assert.Equal(t, []string{"cc"}, response.Data.NotFound)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -888,6 +1047,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/cost", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/batch", Open: true},
+					{Name: "/batch-status", Open: true},
 					{Name: "/simulate", Open: true},
 				},
 			},
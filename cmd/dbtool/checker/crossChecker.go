@@ -0,0 +1,374 @@
+package checker
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/databases"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("dbtool/checker")
+
+var metachainShardID = core.GetShardIDString(core.MetachainShardId)
+
+// Issue describes an inconsistency found between the storage units
+type Issue struct {
+	ShardID     string
+	Epoch       uint32
+	HeaderNonce uint64
+	HeaderHash  []byte
+	Description string
+}
+
+// String returns the human readable form of the issue
+func (i *Issue) String() string {
+	return fmt.Sprintf("shard %s, epoch %d, header nonce %d, hash %s: %s",
+		i.ShardID, i.Epoch, i.HeaderNonce, hex.EncodeToString(i.HeaderHash), i.Description)
+}
+
+type headerInfo struct {
+	shardID  string
+	epoch    uint32
+	nonce    uint64
+	hash     []byte
+	rootHash []byte
+}
+
+func (hi *headerInfo) newIssue(description string) *Issue {
+	return &Issue{
+		ShardID:     hi.shardID,
+		Epoch:       hi.epoch,
+		HeaderNonce: hi.nonce,
+		HeaderHash:  hi.hash,
+		Description: description,
+	}
+}
+
+// ArgsCrossChecker holds the arguments needed for creating a new cross checker
+type ArgsCrossChecker struct {
+	GeneralConfig      config.Config
+	PathManager        storage.PathManagerHandler
+	Marshalizer        marshal.Marshalizer
+	DatabaseOpener     DatabaseOpener
+	CheckAllRootHashes bool
+}
+
+type crossChecker struct {
+	generalConfig      config.Config
+	pathManager        storage.PathManagerHandler
+	marshalizer        marshal.Marshalizer
+	databaseOpener     DatabaseOpener
+	checkAllRootHashes bool
+	openedDatabases    map[string]databases.ReadOnlyDB
+	triePaths          map[string][]string
+}
+
+// NewCrossChecker returns a component that checks that each stored header has its miniblocks and transactions
+// stored in the matching units and that its state root hash can be resolved from the trie storage
+func NewCrossChecker(args ArgsCrossChecker) (*crossChecker, error) {
+	if check.IfNil(args.PathManager) {
+		return nil, ErrNilPathManager
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.DatabaseOpener) {
+		return nil, ErrNilDatabaseOpener
+	}
+
+	return &crossChecker{
+		generalConfig:      args.GeneralConfig,
+		pathManager:        args.PathManager,
+		marshalizer:        args.Marshalizer,
+		databaseOpener:     args.DatabaseOpener,
+		checkAllRootHashes: args.CheckAllRootHashes,
+	}, nil
+}
+
+// Check cross checks the headers found in the provided units. Unless all the root hashes have to be checked, only
+// the root hash of the last header of each shard is checked as the older states are removed by the trie pruning
+func (cc *crossChecker) Check(units []*databases.UnitInfo) []*Issue {
+	cc.openedDatabases = make(map[string]databases.ReadOnlyDB)
+	cc.triePaths = ComputeTriePaths(cc.generalConfig, cc.pathManager, units)
+	defer cc.closeDatabases()
+
+	issues := make([]*Issue, 0)
+	lastHeaders := make(map[string]*headerInfo)
+	for _, unit := range units {
+		if unit.IsStatic || unit.Identifier != cc.headersIdentifier(unit.ShardID) {
+			continue
+		}
+
+		log.Info("checking headers", "shard", unit.ShardID, "epoch", unit.Epoch)
+		issues = append(issues, cc.checkHeadersUnit(unit, lastHeaders)...)
+	}
+
+	if cc.checkAllRootHashes {
+		return issues
+	}
+
+	for _, lastHeader := range lastHeaders {
+		if !cc.isRootHashResolvable(lastHeader.shardID, lastHeader.rootHash) {
+			issues = append(issues, lastHeader.newIssue(fmt.Sprintf(
+				"root hash %s of the last header cannot be resolved from the trie storage",
+				hex.EncodeToString(lastHeader.rootHash))))
+		}
+	}
+
+	return issues
+}
+
+// ComputeTriePaths returns, for each shard, the paths of the main accounts trie storage and of its snapshots
+func ComputeTriePaths(
+	generalConfig config.Config,
+	pathManager storage.PathManagerHandler,
+	units []*databases.UnitInfo,
+) map[string][]string {
+	mainIdentifier := generalConfig.AccountsTrieStorage.DB.FilePath
+	snapshotsPrefix := path.Join(path.Dir(mainIdentifier), generalConfig.TrieSnapshotDB.FilePath) + "/"
+
+	triePaths := make(map[string][]string)
+	for _, unit := range units {
+		if !unit.IsStatic || unit.Identifier != mainIdentifier {
+			continue
+		}
+
+		triePaths[unit.ShardID] = append(triePaths[unit.ShardID], pathManager.PathForStatic(unit.ShardID, mainIdentifier))
+	}
+	for _, unit := range units {
+		if !unit.IsStatic || !strings.HasPrefix(unit.Identifier, snapshotsPrefix) {
+			continue
+		}
+
+		triePaths[unit.ShardID] = append(triePaths[unit.ShardID], pathManager.PathForStatic(unit.ShardID, unit.Identifier))
+	}
+
+	return triePaths
+}
+
+func (cc *crossChecker) headersIdentifier(shardID string) string {
+	if shardID == metachainShardID {
+		return cc.generalConfig.MetaBlockStorage.DB.FilePath
+	}
+
+	return cc.generalConfig.BlockHeaderStorage.DB.FilePath
+}
+
+func (cc *crossChecker) checkHeadersUnit(unit *databases.UnitInfo, lastHeaders map[string]*headerInfo) []*Issue {
+	issues := make([]*Issue, 0)
+	db, err := cc.openDatabase(cc.pathManager.PathForEpoch(unit.ShardID, unit.Epoch, unit.Identifier))
+	if err != nil {
+		return append(issues, &Issue{
+			ShardID:     unit.ShardID,
+			Epoch:       unit.Epoch,
+			Description: fmt.Sprintf("cannot open headers unit: %s", err.Error()),
+		})
+	}
+
+	err = db.RangeKeys(func(key []byte, value []byte) bool {
+		header, errUnmarshal := cc.unmarshalHeader(unit.ShardID, value)
+		if errUnmarshal != nil {
+			issues = append(issues, &Issue{
+				ShardID:     unit.ShardID,
+				Epoch:       unit.Epoch,
+				HeaderHash:  key,
+				Description: fmt.Sprintf("cannot unmarshal header: %s", errUnmarshal.Error()),
+			})
+			return true
+		}
+		if core.GetShardIDString(header.GetShardID()) != unit.ShardID {
+			// the metachain notarizes the shard headers in its own headers unit
+			return true
+		}
+
+		info := &headerInfo{
+			shardID:  unit.ShardID,
+			epoch:    unit.Epoch,
+			nonce:    header.GetNonce(),
+			hash:     key,
+			rootHash: header.GetRootHash(),
+		}
+		issues = append(issues, cc.checkHeader(info, header)...)
+
+		lastHeader, found := lastHeaders[unit.ShardID]
+		if !found || lastHeader.nonce < info.nonce {
+			lastHeaders[unit.ShardID] = info
+		}
+
+		return true
+	})
+	if err != nil {
+		issues = append(issues, &Issue{
+			ShardID:     unit.ShardID,
+			Epoch:       unit.Epoch,
+			Description: fmt.Sprintf("cannot read headers unit: %s", err.Error()),
+		})
+	}
+
+	return issues
+}
+
+func (cc *crossChecker) unmarshalHeader(shardID string, headerBytes []byte) (data.HeaderHandler, error) {
+	if shardID == metachainShardID {
+		metaBlock := &block.MetaBlock{}
+		err := cc.marshalizer.Unmarshal(metaBlock, headerBytes)
+		return metaBlock, err
+	}
+
+	header := &block.Header{}
+	err := cc.marshalizer.Unmarshal(header, headerBytes)
+	return header, err
+}
+
+func (cc *crossChecker) checkHeader(info *headerInfo, header data.HeaderHandler) []*Issue {
+	issues := make([]*Issue, 0)
+	for _, miniBlockHash := range header.GetMiniBlockHeadersHashes() {
+		miniBlockBytes, err := cc.getFromEpochUnit(info, cc.generalConfig.MiniBlocksStorage.DB.FilePath, miniBlockHash)
+		if err != nil {
+			issues = append(issues, info.newIssue(
+				fmt.Sprintf("miniblock %s not found: %s", hex.EncodeToString(miniBlockHash), err.Error())))
+			continue
+		}
+
+		miniBlock := &block.MiniBlock{}
+		err = cc.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+		if err != nil {
+			issues = append(issues, info.newIssue(
+				fmt.Sprintf("cannot unmarshal miniblock %s: %s", hex.EncodeToString(miniBlockHash), err.Error())))
+			continue
+		}
+
+		issues = append(issues, cc.checkMiniBlockTransactions(info, miniBlockHash, miniBlock)...)
+	}
+
+	if cc.checkAllRootHashes && !cc.isRootHashResolvable(info.shardID, info.rootHash) {
+		issues = append(issues, info.newIssue(
+			fmt.Sprintf("root hash %s cannot be resolved from the trie storage", hex.EncodeToString(info.rootHash))))
+	}
+
+	return issues
+}
+
+func (cc *crossChecker) checkMiniBlockTransactions(info *headerInfo, miniBlockHash []byte, miniBlock *block.MiniBlock) []*Issue {
+	txsIdentifier, ok := cc.transactionsIdentifier(miniBlock.Type)
+	if !ok {
+		return nil
+	}
+
+	issues := make([]*Issue, 0)
+	for _, txHash := range miniBlock.TxHashes {
+		_, err := cc.getFromEpochUnit(info, txsIdentifier, txHash)
+		if err != nil {
+			issues = append(issues, info.newIssue(fmt.Sprintf("transaction %s from miniblock %s not found in %s: %s",
+				hex.EncodeToString(txHash), hex.EncodeToString(miniBlockHash), txsIdentifier, err.Error())))
+		}
+	}
+
+	return issues
+}
+
+func (cc *crossChecker) transactionsIdentifier(miniBlockType block.Type) (string, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return cc.generalConfig.TxStorage.DB.FilePath, true
+	case block.SmartContractResultBlock:
+		return cc.generalConfig.UnsignedTransactionStorage.DB.FilePath, true
+	case block.RewardsBlock:
+		return cc.generalConfig.RewardTxStorage.DB.FilePath, true
+	default:
+		return "", false
+	}
+}
+
+// getFromEpochUnit searches the key in the header's epoch unit and in the previous epoch one, as the data
+// of the first block of an epoch may have been saved in the previous epoch
+func (cc *crossChecker) getFromEpochUnit(info *headerInfo, identifier string, key []byte) ([]byte, error) {
+	epochs := []uint32{info.epoch}
+	if info.epoch > 0 {
+		epochs = append(epochs, info.epoch-1)
+	}
+
+	var err error
+	for _, epoch := range epochs {
+		var db databases.ReadOnlyDB
+		db, err = cc.openDatabase(cc.pathManager.PathForEpoch(info.shardID, epoch, identifier))
+		if err != nil {
+			continue
+		}
+
+		var value []byte
+		value, err = db.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (cc *crossChecker) isRootHashResolvable(shardID string, rootHash []byte) bool {
+	if len(rootHash) == 0 {
+		return true
+	}
+
+	for _, triePath := range cc.triePaths[shardID] {
+		db, err := cc.openDatabase(triePath)
+		if err != nil {
+			continue
+		}
+
+		err = db.Has(rootHash)
+		if err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (cc *crossChecker) openDatabase(dbPath string) (databases.ReadOnlyDB, error) {
+	db, found := cc.openedDatabases[dbPath]
+	if found {
+		return db, nil
+	}
+
+	_, err := os.Stat(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnitNotFound, dbPath)
+	}
+
+	db, err = cc.databaseOpener.OpenReadOnly(dbPath)
+	if err != nil {
+		log.Warn("cannot open unit", "path", dbPath, "error", err)
+		return nil, err
+	}
+
+	cc.openedDatabases[dbPath] = db
+
+	return db, nil
+}
+
+func (cc *crossChecker) closeDatabases() {
+	for dbPath, db := range cc.openedDatabases {
+		err := db.Close()
+		if err != nil {
+			log.Warn("cannot close unit", "path", dbPath, "error", err)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cc *crossChecker) IsInterfaceNil() bool {
+	return cc == nil
+}
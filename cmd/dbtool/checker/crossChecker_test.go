@@ -0,0 +1,188 @@
+package checker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/checker"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/databases"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGeneralConfig() config.Config {
+	return config.Config{
+		BlockHeaderStorage:         config.StorageConfig{DB: config.DBConfig{FilePath: "BlockHeaders"}},
+		MetaBlockStorage:           config.StorageConfig{DB: config.DBConfig{FilePath: "MetaBlock"}},
+		MiniBlocksStorage:          config.StorageConfig{DB: config.DBConfig{FilePath: "MiniBlocks"}},
+		TxStorage:                  config.StorageConfig{DB: config.DBConfig{FilePath: "Transactions"}},
+		UnsignedTransactionStorage: config.StorageConfig{DB: config.DBConfig{FilePath: "UnsignedTransactions"}},
+		RewardTxStorage:            config.StorageConfig{DB: config.DBConfig{FilePath: "RewardTransactions"}},
+		AccountsTrieStorage:        config.StorageConfig{DB: config.DBConfig{FilePath: "AccountsTrie/MainDB"}},
+		TrieSnapshotDB:             config.DBConfig{FilePath: "TrieSnapshot"},
+	}
+}
+
+func createArgs(t *testing.T, dbPath string) checker.ArgsCrossChecker {
+	pathManager, err := pathmanager.NewPathManager(
+		filepath.Join(dbPath, "Epoch_"+core.PathEpochPlaceholder, "Shard_"+core.PathShardPlaceholder, core.PathIdentifierPlaceholder),
+		filepath.Join(dbPath, "Static", "Shard_"+core.PathShardPlaceholder, core.PathIdentifierPlaceholder),
+	)
+	require.Nil(t, err)
+
+	return checker.ArgsCrossChecker{
+		GeneralConfig:  createGeneralConfig(),
+		PathManager:    pathManager,
+		Marshalizer:    &marshal.GogoProtoMarshalizer{},
+		DatabaseOpener: databases.NewReadOnlyOpener(),
+	}
+}
+
+func putInUnit(t *testing.T, path string, records map[string][]byte) {
+	db, err := leveldb.NewDB(path, 10, 1, 10)
+	require.Nil(t, err)
+
+	for key, value := range records {
+		err = db.Put([]byte(key), value)
+		require.Nil(t, err)
+	}
+
+	err = db.Close()
+	require.Nil(t, err)
+}
+
+func marshalObject(t *testing.T, obj interface{}) []byte {
+	buff, err := (&marshal.GogoProtoMarshalizer{}).Marshal(obj)
+	require.Nil(t, err)
+
+	return buff
+}
+
+func createDatabases(t *testing.T, dbPath string, withMissingTransaction bool) {
+	shardDir := filepath.Join(dbPath, "Epoch_1", "Shard_0")
+
+	txs := map[string][]byte{"tx1": []byte("tx1 data")}
+	if !withMissingTransaction {
+		txs["tx2"] = []byte("tx2 data")
+	}
+	putInUnit(t, filepath.Join(shardDir, "Transactions"), txs)
+
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("tx1"), []byte("tx2")}, Type: block.TxBlock}
+	putInUnit(t, filepath.Join(shardDir, "MiniBlocks"), map[string][]byte{"mb1": marshalObject(t, miniBlock)})
+
+	header1 := &block.Header{
+		Nonce:            1,
+		ShardID:          0,
+		RootHash:         []byte("old root"),
+		MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mb1")}},
+	}
+	header2 := &block.Header{
+		Nonce:    2,
+		ShardID:  0,
+		RootHash: []byte("root"),
+	}
+	putInUnit(t, filepath.Join(shardDir, "BlockHeaders"), map[string][]byte{
+		"hdr1": marshalObject(t, header1),
+		"hdr2": marshalObject(t, header2),
+	})
+
+	putInUnit(t, filepath.Join(dbPath, "Static", "Shard_0", "AccountsTrie", "MainDB"), map[string][]byte{"root": []byte("root node")})
+}
+
+func getUnits(t *testing.T, dbPath string) []*databases.UnitInfo {
+	walker, err := databases.NewUnitsWalker(dbPath, factory.NewDirectoryReader())
+	require.Nil(t, err)
+	units, err := walker.Units()
+	require.Nil(t, err)
+
+	return units
+}
+
+func TestNewCrossChecker(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs(t, "db")
+	args.PathManager = nil
+	cc, err := checker.NewCrossChecker(args)
+	assert.Nil(t, cc)
+	assert.Equal(t, checker.ErrNilPathManager, err)
+
+	args = createArgs(t, "db")
+	args.Marshalizer = nil
+	cc, err = checker.NewCrossChecker(args)
+	assert.Nil(t, cc)
+	assert.Equal(t, checker.ErrNilMarshalizer, err)
+
+	args = createArgs(t, "db")
+	args.DatabaseOpener = nil
+	cc, err = checker.NewCrossChecker(args)
+	assert.Nil(t, cc)
+	assert.Equal(t, checker.ErrNilDatabaseOpener, err)
+
+	cc, err = checker.NewCrossChecker(createArgs(t, "db"))
+	assert.Nil(t, err)
+	assert.False(t, cc.IsInterfaceNil())
+}
+
+func TestCrossChecker_CheckConsistentDatabasesShouldNotReportIssues(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+	createDatabases(t, dbPath, false)
+
+	cc, _ := checker.NewCrossChecker(createArgs(t, dbPath))
+	issues := cc.Check(getUnits(t, dbPath))
+
+	assert.Equal(t, 0, len(issues))
+}
+
+func TestCrossChecker_CheckShouldReportMissingTransaction(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+	createDatabases(t, dbPath, true)
+
+	cc, _ := checker.NewCrossChecker(createArgs(t, dbPath))
+	issues := cc.Check(getUnits(t, dbPath))
+
+	require.Equal(t, 1, len(issues))
+	assert.Equal(t, "0", issues[0].ShardID)
+	assert.Equal(t, uint32(1), issues[0].Epoch)
+	assert.Equal(t, uint64(1), issues[0].HeaderNonce)
+	assert.Equal(t, []byte("hdr1"), issues[0].HeaderHash)
+	assert.Contains(t, issues[0].Description, "transaction 747832")
+}
+
+func TestCrossChecker_CheckAllRootHashesShouldReportPrunedRootHashes(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+	createDatabases(t, dbPath, false)
+
+	args := createArgs(t, dbPath)
+	args.CheckAllRootHashes = true
+	cc, _ := checker.NewCrossChecker(args)
+	issues := cc.Check(getUnits(t, dbPath))
+
+	require.Equal(t, 1, len(issues))
+	assert.Equal(t, uint64(1), issues[0].HeaderNonce)
+	assert.Contains(t, issues[0].Description, "root hash")
+}
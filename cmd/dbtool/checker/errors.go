@@ -0,0 +1,15 @@
+package checker
+
+import "errors"
+
+// ErrNilPathManager signals that a nil path manager has been provided
+var ErrNilPathManager = errors.New("nil path manager")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilDatabaseOpener signals that a nil database opener has been provided
+var ErrNilDatabaseOpener = errors.New("nil database opener")
+
+// ErrUnitNotFound signals that a storage unit does not exist on disk
+var ErrUnitNotFound = errors.New("unit not found")
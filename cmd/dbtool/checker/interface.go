@@ -0,0 +1,9 @@
+package checker
+
+import "github.com/ElrondNetwork/elrond-go/cmd/dbtool/databases"
+
+// DatabaseOpener defines the component able to open the storage units without altering them
+type DatabaseOpener interface {
+	OpenReadOnly(path string) (databases.ReadOnlyDB, error)
+	IsInterfaceNil() bool
+}
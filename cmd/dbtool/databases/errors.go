@@ -0,0 +1,21 @@
+package databases
+
+import "errors"
+
+// ErrEmptyDbFilePath signals that an empty database file path has been provided
+var ErrEmptyDbFilePath = errors.New("empty db file path")
+
+// ErrNilDirectoryReader signals that a nil directory reader has been provided
+var ErrNilDirectoryReader = errors.New("nil directory reader")
+
+// ErrNoDatabaseFound signals that no database has been found in the provided path
+var ErrNoDatabaseFound = errors.New("no database found")
+
+// ErrUnknownDatabaseType signals that the type of the database from the provided path could not be determined
+var ErrUnknownDatabaseType = errors.New("unknown database type")
+
+// ErrNilHandler signals that a nil handler has been provided
+var ErrNilHandler = errors.New("nil handler")
+
+// ErrReadOnlyDatabase signals that a write operation has been attempted on a database opened in read only mode
+var ErrReadOnlyDatabase = errors.New("read only database")
@@ -0,0 +1,9 @@
+package databases
+
+// ReadOnlyDB defines the read operations available on a database opened by the tool
+type ReadOnlyDB interface {
+	Get(key []byte) ([]byte, error)
+	Has(key []byte) error
+	RangeKeys(handler func(key []byte, value []byte) bool) error
+	Close() error
+}
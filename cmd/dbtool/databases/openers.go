@@ -0,0 +1,375 @@
+package databases
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"runtime"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// DBType represents the on-disk format of a storage unit
+type DBType string
+
+const (
+	// UnknownDB is the type of a directory that does not hold a database
+	UnknownDB DBType = "unknown"
+	// LevelDB is the type of the units created by the LvlDB and LvlDBSerial persisters
+	LevelDB DBType = "LevelDB"
+	// BadgerDB is the type of the units created by the BadgerDB persister
+	BadgerDB DBType = "BadgerDB"
+)
+
+const levelDBMarkerFile = "CURRENT"
+const badgerDBMarkerFile = "KEYREGISTRY"
+
+const badgerGCDiscardRatio = 0.5
+
+// DetectDBType returns the type of the database stored in the provided directory
+func DetectDBType(path string) (DBType, error) {
+	filesInfo, err := ioutil.ReadDir(path)
+	if err != nil {
+		return UnknownDB, err
+	}
+
+	files := make([]string, 0, len(filesInfo))
+	for _, fileInfo := range filesInfo {
+		if !fileInfo.IsDir() {
+			files = append(files, fileInfo.Name())
+		}
+	}
+
+	dbType := detectDBTypeFromFiles(files)
+	if dbType == UnknownDB {
+		return UnknownDB, fmt.Errorf("%w for path %s", ErrUnknownDatabaseType, path)
+	}
+
+	return dbType, nil
+}
+
+func detectDBTypeFromFiles(files []string) DBType {
+	for _, file := range files {
+		switch file {
+		case levelDBMarkerFile:
+			return LevelDB
+		case badgerDBMarkerFile:
+			return BadgerDB
+		}
+	}
+
+	return UnknownDB
+}
+
+// OpenReadOnly opens the database from the provided path without altering it. The LevelDB databases are opened
+// in strict mode so any corrupted block is reported instead of being silently skipped
+func OpenReadOnly(path string) (ReadOnlyDB, error) {
+	dbType, err := DetectDBType(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch dbType {
+	case LevelDB:
+		options := &opt.Options{
+			ReadOnly:           true,
+			ErrorIfMissing:     true,
+			Strict:             opt.DefaultStrict | opt.StrictReader,
+			BlockCacheCapacity: -1,
+		}
+		db, errOpen := leveldb.OpenFile(path, options)
+		if errOpen != nil {
+			return nil, errOpen
+		}
+
+		formatMarker, errGet := db.Get(compression.FormatMarkerKey, nil)
+		if errGet != nil && errGet != leveldb.ErrNotFound {
+			_ = db.Close()
+			return nil, errGet
+		}
+
+		return &levelDBReader{db: db, decoder: compression.NewDecoder(formatMarker)}, nil
+	case BadgerDB:
+		options := badger.DefaultOptions(path).
+			WithReadOnly(true).
+			WithLogger(nil).
+			WithMetricsEnabled(false)
+		db, errOpen := badger.Open(options)
+		if errOpen != nil {
+			return nil, errOpen
+		}
+
+		formatMarker, errGet := getBadgerFormatMarker(db)
+		if errGet != nil {
+			_ = db.Close()
+			return nil, errGet
+		}
+
+		return &badgerDBReader{db: db, decoder: compression.NewDecoder(formatMarker)}, nil
+	default:
+		return nil, ErrUnknownDatabaseType
+	}
+}
+
+func getBadgerFormatMarker(db *badger.DB) ([]byte, error) {
+	var formatMarker []byte
+	err := db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(compression.FormatMarkerKey)
+		if errGet != nil {
+			return errGet
+		}
+
+		formatMarker, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+
+	return formatMarker, err
+}
+
+// Compact compacts the database from the provided path. If the repair flag is set, a corrupted LevelDB database
+// is recovered before being compacted, otherwise the corruption is returned as error
+func Compact(path string, repair bool) error {
+	dbType, err := DetectDBType(path)
+	if err != nil {
+		return err
+	}
+
+	switch dbType {
+	case LevelDB:
+		return compactLevelDB(path, repair)
+	case BadgerDB:
+		return compactBadgerDB(path)
+	default:
+		return ErrUnknownDatabaseType
+	}
+}
+
+func compactLevelDB(path string, repair bool) error {
+	options := &opt.Options{
+		ErrorIfMissing:     true,
+		BlockCacheCapacity: -1,
+	}
+
+	db, err := leveldb.OpenFile(path, options)
+	if errors.IsCorrupted(err) {
+		if !repair {
+			return fmt.Errorf("%w, use the repair flag to recover it", err)
+		}
+
+		log.Warn("recovering corrupted DB", "path", path, "error", err)
+		db, err = leveldb.RecoverFile(path, options)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = db.CompactRange(util.Range{})
+	if err != nil {
+		_ = db.Close()
+		return err
+	}
+
+	return db.Close()
+}
+
+func compactBadgerDB(path string) error {
+	options := badger.DefaultOptions(path).
+		WithLogger(nil).
+		WithMetricsEnabled(false)
+	db, err := badger.Open(options)
+	if err != nil {
+		return err
+	}
+
+	err = db.Flatten(runtime.NumCPU())
+	if err != nil {
+		_ = db.Close()
+		return err
+	}
+
+	for err == nil {
+		err = db.RunValueLogGC(badgerGCDiscardRatio)
+	}
+	if err != badger.ErrNoRewrite {
+		_ = db.Close()
+		return err
+	}
+
+	return db.Close()
+}
+
+type readOnlyOpener struct {
+}
+
+// NewReadOnlyOpener returns an opener for the databases that does not alter them
+func NewReadOnlyOpener() *readOnlyOpener {
+	return &readOnlyOpener{}
+}
+
+// OpenReadOnly opens the database from the provided path in read only mode
+func (roo *readOnlyOpener) OpenReadOnly(path string) (ReadOnlyDB, error) {
+	return OpenReadOnly(path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (roo *readOnlyOpener) IsInterfaceNil() bool {
+	return roo == nil
+}
+
+type levelDBReader struct {
+	db      *leveldb.DB
+	decoder *compression.Decoder
+}
+
+// Get returns the value associated to the key
+func (ldr *levelDBReader) Get(key []byte) ([]byte, error) {
+	value, err := ldr.db.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ldr.decoder.Decode(value)
+}
+
+// Has returns nil if the given key is present in the database
+func (ldr *levelDBReader) Has(key []byte) error {
+	has, err := ldr.db.Has(key, nil)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return storage.ErrKeyNotFound
+	}
+
+	return nil
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (ldr *levelDBReader) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return ErrNilHandler
+	}
+
+	iterator := ldr.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	for iterator.Next() {
+		if bytes.Equal(iterator.Key(), compression.FormatMarkerKey) {
+			continue
+		}
+
+		clonedKey := append([]byte{}, iterator.Key()...)
+		value, err := ldr.decoder.Decode(append([]byte{}, iterator.Value()...))
+		if err != nil {
+			return fmt.Errorf("%w for key %x", err, clonedKey)
+		}
+
+		shouldContinue := handler(clonedKey, value)
+		if !shouldContinue {
+			break
+		}
+	}
+
+	return iterator.Error()
+}
+
+// Close closes the database
+func (ldr *levelDBReader) Close() error {
+	return ldr.db.Close()
+}
+
+type badgerDBReader struct {
+	db      *badger.DB
+	decoder *compression.Decoder
+}
+
+// Get returns the value associated to the key
+func (bdr *badgerDBReader) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := bdr.db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		value, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return bdr.decoder.Decode(value)
+}
+
+// Has returns nil if the given key is present in the database
+func (bdr *badgerDBReader) Has(key []byte) error {
+	err := bdr.db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (bdr *badgerDBReader) RangeKeys(handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return ErrNilHandler
+	}
+
+	return bdr.db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			if bytes.Equal(item.Key(), compression.FormatMarkerKey) {
+				continue
+			}
+
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			key := item.KeyCopy(nil)
+			value, err = bdr.decoder.Decode(value)
+			if err != nil {
+				return fmt.Errorf("%w for key %x", err, key)
+			}
+
+			shouldContinue := handler(key, value)
+			if !shouldContinue {
+				return nil
+			}
+		}
+
+		return nil
+	})
+}
+
+// Close closes the database
+func (bdr *badgerDBReader) Close() error {
+	return bdr.db.Close()
+}
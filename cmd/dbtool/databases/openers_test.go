@@ -0,0 +1,131 @@
+package databases_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/databases"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testOpenReadOnlyShouldWork(t *testing.T, createUnit func(t *testing.T, path string)) {
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+	createUnit(t, dbPath)
+
+	db, err := databases.OpenReadOnly(dbPath)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	value, err := db.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+	assert.Nil(t, db.Has([]byte("key")))
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has([]byte("missing key")))
+	_, err = db.Get([]byte("missing key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	recovered := make(map[string][]byte)
+	err = db.RangeKeys(func(key []byte, value []byte) bool {
+		recovered[string(key)] = value
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]byte{"key": []byte("value")}, recovered)
+}
+
+func TestOpenReadOnly_LevelDBShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testOpenReadOnlyShouldWork(t, createLevelDBUnit)
+}
+
+func TestOpenReadOnly_BadgerDBShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testOpenReadOnlyShouldWork(t, createBadgerDBUnit)
+}
+
+func TestOpenReadOnly_UnknownDatabaseShouldError(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+
+	db, err := databases.OpenReadOnly(dbPath)
+	assert.Nil(t, db)
+	assert.True(t, errors.Is(err, databases.ErrUnknownDatabaseType))
+}
+
+func TestVerifyUnit_ShouldCountRecords(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+	createLevelDBUnit(t, dbPath)
+
+	numRecords, err := databases.VerifyUnit(dbPath)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numRecords)
+}
+
+func TestCompact_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+	levelDBPath := filepath.Join(dbPath, "level")
+	createLevelDBUnit(t, levelDBPath)
+	badgerDBPath := filepath.Join(dbPath, "badger")
+	createBadgerDBUnit(t, badgerDBPath)
+
+	assert.Nil(t, databases.Compact(levelDBPath, false))
+	assert.Nil(t, databases.Compact(badgerDBPath, false))
+
+	numRecords, err := databases.VerifyUnit(levelDBPath)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numRecords)
+	numRecords, err = databases.VerifyUnit(badgerDBPath)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numRecords)
+}
+
+func TestCompact_CorruptedLevelDBShouldRequireRepair(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+	createLevelDBUnit(t, dbPath)
+	err := os.Remove(filepath.Join(dbPath, "MANIFEST-000000"))
+	require.Nil(t, err)
+
+	_, err = databases.VerifyUnit(dbPath)
+	assert.NotNil(t, err)
+
+	err = databases.Compact(dbPath, false)
+	assert.NotNil(t, err)
+
+	err = databases.Compact(dbPath, true)
+	assert.Nil(t, err)
+
+	numRecords, err := databases.VerifyUnit(dbPath)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numRecords)
+}
@@ -0,0 +1,61 @@
+package databases
+
+import (
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+type readOnlyTrieStorer struct {
+	dbs []ReadOnlyDB
+}
+
+// NewReadOnlyTrieStorer returns a trie storer that resolves the trie nodes from the provided databases, in order,
+// so that the nodes moved in the trie snapshots are also found. All the write operations are rejected
+func NewReadOnlyTrieStorer(dbs []ReadOnlyDB) (*readOnlyTrieStorer, error) {
+	if len(dbs) == 0 {
+		return nil, ErrNoDatabaseFound
+	}
+
+	return &readOnlyTrieStorer{
+		dbs: dbs,
+	}, nil
+}
+
+// Get returns the value associated to the key from the first database holding it
+func (rots *readOnlyTrieStorer) Get(key []byte) ([]byte, error) {
+	for _, db := range rots.dbs {
+		value, err := db.Get(key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Put returns ErrReadOnlyDatabase as the databases opened by the tool are not altered
+func (rots *readOnlyTrieStorer) Put(_ []byte, _ []byte) error {
+	return ErrReadOnlyDatabase
+}
+
+// Remove returns ErrReadOnlyDatabase as the databases opened by the tool are not altered
+func (rots *readOnlyTrieStorer) Remove(_ []byte) error {
+	return ErrReadOnlyDatabase
+}
+
+// Close closes all the underlying databases
+func (rots *readOnlyTrieStorer) Close() error {
+	var lastErr error
+	for _, db := range rots.dbs {
+		err := db.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rots *readOnlyTrieStorer) IsInterfaceNil() bool {
+	return rots == nil
+}
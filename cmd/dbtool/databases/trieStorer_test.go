@@ -0,0 +1,86 @@
+package databases_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/databases"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openUnitWithRecord(t *testing.T, dbPath string, key string, value string) databases.ReadOnlyDB {
+	db, err := leveldb.NewDB(dbPath, 10, 1, 10)
+	require.Nil(t, err)
+	_ = db.Put([]byte(key), []byte(value))
+	_ = db.Close()
+
+	readOnlyDB, err := databases.OpenReadOnly(dbPath)
+	require.Nil(t, err)
+
+	return readOnlyDB
+}
+
+func TestNewReadOnlyTrieStorer_NoDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storer, err := databases.NewReadOnlyTrieStorer(nil)
+	assert.True(t, check.IfNil(storer))
+	assert.Equal(t, databases.ErrNoDatabaseFound, err)
+}
+
+func TestReadOnlyTrieStorer_GetShouldSearchAllDatabases(t *testing.T) {
+	t.Parallel()
+
+	firstPath, _ := ioutil.TempDir("", "dbtool_temp")
+	secondPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(firstPath)
+		_ = os.RemoveAll(secondPath)
+	}()
+
+	storer, err := databases.NewReadOnlyTrieStorer([]databases.ReadOnlyDB{
+		openUnitWithRecord(t, firstPath, "key1", "value1"),
+		openUnitWithRecord(t, secondPath, "key2", "value2"),
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	value, err := storer.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	value, err = storer.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+
+	_, err = storer.Get([]byte("missing key"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestReadOnlyTrieStorer_WritesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+
+	storer, _ := databases.NewReadOnlyTrieStorer([]databases.ReadOnlyDB{openUnitWithRecord(t, dbPath, "key", "value")})
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	assert.Equal(t, databases.ErrReadOnlyDatabase, storer.Put([]byte("key"), []byte("new value")))
+	assert.Equal(t, databases.ErrReadOnlyDatabase, storer.Remove([]byte("key")))
+
+	value, err := storer.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
@@ -0,0 +1,160 @@
+package databases
+
+import (
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("dbtool/databases")
+
+const epochDirectoryPrefix = factory.DefaultEpochString + "_"
+const shardDirectoryPrefix = factory.DefaultShardString + "_"
+
+// UnitInfo holds the location of a storage unit inside the node's db directory
+type UnitInfo struct {
+	Path       string
+	Identifier string
+	ShardID    string
+	Epoch      uint32
+	IsStatic   bool
+	Type       DBType
+}
+
+type unitsWalker struct {
+	directoryReader   storage.DirectoryReaderHandler
+	dbPathWithChainID string
+}
+
+// NewUnitsWalker returns a walker over the layout produced by the node's path manager:
+// <db path>/<chain ID>/Epoch_<epoch>/Shard_<shard>/<identifier> for the pruning storers and
+// <db path>/<chain ID>/Static/Shard_<shard>/<identifier> for the static storers
+func NewUnitsWalker(dbPathWithChainID string, directoryReader storage.DirectoryReaderHandler) (*unitsWalker, error) {
+	if len(dbPathWithChainID) == 0 {
+		return nil, ErrEmptyDbFilePath
+	}
+	if check.IfNil(directoryReader) {
+		return nil, ErrNilDirectoryReader
+	}
+
+	return &unitsWalker{
+		directoryReader:   directoryReader,
+		dbPathWithChainID: dbPathWithChainID,
+	}, nil
+}
+
+// Units returns all the storage units found, sorted by epoch, shard and identifier. The static units come last
+func (uw *unitsWalker) Units() ([]*UnitInfo, error) {
+	topDirectories, err := uw.directoryReader.ListDirectoriesAsString(uw.dbPathWithChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	units := make([]*UnitInfo, 0)
+	for _, dirName := range topDirectories {
+		if dirName == factory.DefaultStaticDbString {
+			units = append(units, uw.unitsInShardsDirectories(dirName, 0, true)...)
+			continue
+		}
+
+		if !strings.HasPrefix(dirName, epochDirectoryPrefix) {
+			log.Debug("skipping directory", "name", dirName)
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(dirName, epochDirectoryPrefix), 10, 32)
+		if errParse != nil {
+			log.Warn("cannot parse epoch number from directory name", "directory name", dirName)
+			continue
+		}
+
+		units = append(units, uw.unitsInShardsDirectories(dirName, uint32(epoch), false)...)
+	}
+
+	if len(units) == 0 {
+		return nil, ErrNoDatabaseFound
+	}
+
+	sort.SliceStable(units, func(i, j int) bool {
+		if units[i].IsStatic != units[j].IsStatic {
+			return !units[i].IsStatic
+		}
+		if units[i].Epoch != units[j].Epoch {
+			return units[i].Epoch < units[j].Epoch
+		}
+		if units[i].ShardID != units[j].ShardID {
+			return units[i].ShardID < units[j].ShardID
+		}
+
+		return units[i].Identifier < units[j].Identifier
+	})
+
+	return units, nil
+}
+
+func (uw *unitsWalker) unitsInShardsDirectories(parentDirName string, epoch uint32, isStatic bool) []*UnitInfo {
+	parentPath := filepath.Join(uw.dbPathWithChainID, parentDirName)
+	shardDirectories, err := uw.directoryReader.ListDirectoriesAsString(parentPath)
+	if err != nil {
+		log.Debug("no shard directories found", "path", parentPath, "error", err)
+		return nil
+	}
+
+	units := make([]*UnitInfo, 0)
+	for _, shardDirName := range shardDirectories {
+		if !strings.HasPrefix(shardDirName, shardDirectoryPrefix) {
+			continue
+		}
+
+		shardID := strings.TrimPrefix(shardDirName, shardDirectoryPrefix)
+		shardPath := filepath.Join(parentPath, shardDirName)
+		for _, unit := range uw.findUnits(shardPath, "") {
+			unit.ShardID = shardID
+			unit.Epoch = epoch
+			unit.IsStatic = isStatic
+			units = append(units, unit)
+		}
+	}
+
+	return units
+}
+
+// findUnits recursively searches for the database directories as some identifiers contain more path
+// elements, like the trie storage and its snapshots
+func (uw *unitsWalker) findUnits(basePath string, relativePath string) []*UnitInfo {
+	directories, err := uw.directoryReader.ListDirectoriesAsString(filepath.Join(basePath, relativePath))
+	if err != nil {
+		return nil
+	}
+
+	units := make([]*UnitInfo, 0)
+	for _, dirName := range directories {
+		identifier := filepath.Join(relativePath, dirName)
+		path := filepath.Join(basePath, identifier)
+		files, _ := uw.directoryReader.ListFilesAsString(path)
+		dbType := detectDBTypeFromFiles(files)
+		if dbType == UnknownDB {
+			units = append(units, uw.findUnits(basePath, identifier)...)
+			continue
+		}
+
+		units = append(units, &UnitInfo{
+			Path:       path,
+			Identifier: filepath.ToSlash(identifier),
+			Type:       dbType,
+		})
+	}
+
+	return units
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (uw *unitsWalker) IsInterfaceNil() bool {
+	return uw == nil
+}
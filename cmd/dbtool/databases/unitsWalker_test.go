@@ -0,0 +1,123 @@
+package databases_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/databases"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLevelDBUnit(t *testing.T, path string) {
+	db, err := leveldb.NewDB(path, 10, 1, 10)
+	require.Nil(t, err)
+
+	_ = db.Put([]byte("key"), []byte("value"))
+	_ = db.Close()
+}
+
+func createBadgerDBUnit(t *testing.T, path string) {
+	db, err := badgerdb.NewDB(path, 10, 1)
+	require.Nil(t, err)
+
+	_ = db.Put([]byte("key"), []byte("value"))
+	_ = db.Close()
+}
+
+func TestNewUnitsWalker(t *testing.T) {
+	t.Parallel()
+
+	walker, err := databases.NewUnitsWalker("", factory.NewDirectoryReader())
+	assert.Nil(t, walker)
+	assert.Equal(t, databases.ErrEmptyDbFilePath, err)
+
+	walker, err = databases.NewUnitsWalker("db", nil)
+	assert.Nil(t, walker)
+	assert.Equal(t, databases.ErrNilDirectoryReader, err)
+
+	walker, err = databases.NewUnitsWalker("db", factory.NewDirectoryReader())
+	assert.Nil(t, err)
+	assert.False(t, walker.IsInterfaceNil())
+}
+
+func TestUnitsWalker_UnitsShouldFindAllUnits(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+
+	createLevelDBUnit(t, filepath.Join(dbPath, "Static", "Shard_0", "AccountsTrie", "MainDB"))
+	createBadgerDBUnit(t, filepath.Join(dbPath, "Static", "Shard_0", "AccountsTrie", "TrieSnapshot", "0"))
+	createLevelDBUnit(t, filepath.Join(dbPath, "Epoch_1", "Shard_metachain", "MetaBlock"))
+	createLevelDBUnit(t, filepath.Join(dbPath, "Epoch_0", "Shard_0", "MiniBlocks"))
+	createLevelDBUnit(t, filepath.Join(dbPath, "Epoch_0", "Shard_0", "BlockHeaders"))
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_0", "Shard_0", "Empty"), 0700))
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_x", "Shard_0", "BlockHeaders"), 0700))
+
+	walker, _ := databases.NewUnitsWalker(dbPath, factory.NewDirectoryReader())
+	units, err := walker.Units()
+	require.Nil(t, err)
+
+	expectedUnits := []*databases.UnitInfo{
+		{
+			Path:       filepath.Join(dbPath, "Epoch_0", "Shard_0", "BlockHeaders"),
+			Identifier: "BlockHeaders",
+			ShardID:    "0",
+			Epoch:      0,
+			Type:       databases.LevelDB,
+		},
+		{
+			Path:       filepath.Join(dbPath, "Epoch_0", "Shard_0", "MiniBlocks"),
+			Identifier: "MiniBlocks",
+			ShardID:    "0",
+			Epoch:      0,
+			Type:       databases.LevelDB,
+		},
+		{
+			Path:       filepath.Join(dbPath, "Epoch_1", "Shard_metachain", "MetaBlock"),
+			Identifier: "MetaBlock",
+			ShardID:    "metachain",
+			Epoch:      1,
+			Type:       databases.LevelDB,
+		},
+		{
+			Path:       filepath.Join(dbPath, "Static", "Shard_0", "AccountsTrie", "MainDB"),
+			Identifier: "AccountsTrie/MainDB",
+			ShardID:    "0",
+			IsStatic:   true,
+			Type:       databases.LevelDB,
+		},
+		{
+			Path:       filepath.Join(dbPath, "Static", "Shard_0", "AccountsTrie", "TrieSnapshot", "0"),
+			Identifier: "AccountsTrie/TrieSnapshot/0",
+			ShardID:    "0",
+			IsStatic:   true,
+			Type:       databases.BadgerDB,
+		},
+	}
+	assert.Equal(t, expectedUnits, units)
+}
+
+func TestUnitsWalker_UnitsWithoutDatabasesShouldError(t *testing.T) {
+	t.Parallel()
+
+	dbPath, _ := ioutil.TempDir("", "dbtool_temp")
+	defer func() {
+		_ = os.RemoveAll(dbPath)
+	}()
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_0", "Shard_0", "Empty"), 0700))
+
+	walker, _ := databases.NewUnitsWalker(dbPath, factory.NewDirectoryReader())
+	units, err := walker.Units()
+
+	assert.Nil(t, units)
+	assert.Equal(t, databases.ErrNoDatabaseFound, err)
+}
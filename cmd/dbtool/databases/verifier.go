@@ -0,0 +1,22 @@
+package databases
+
+// VerifyUnit opens the database from the provided path in read only mode and reads all its records, returning
+// the number of records found
+func VerifyUnit(path string) (int, error) {
+	db, err := OpenReadOnly(path)
+	if err != nil {
+		return 0, err
+	}
+
+	numRecords := 0
+	err = db.RangeKeys(func(_ []byte, _ []byte) bool {
+		numRecords++
+		return true
+	})
+	if err != nil {
+		_ = db.Close()
+		return numRecords, err
+	}
+
+	return numRecords, db.Close()
+}
@@ -0,0 +1,451 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/checker"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/databases"
+	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	stateFactory "github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/analyzer"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/dbSnapshot"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
+	"github.com/urfave/cli"
+)
+
+var (
+	dbToolHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} [global options] command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPathFlag defines a flag for setting the path of the database directory that contains the epochs directories
+	dbPathFlag = cli.StringFlag{
+		Name:  "db-path",
+		Usage: "This string flag specifies the `path` for the database directory of the chain, the one named after the chain ID",
+		Value: "db/1",
+	}
+	// configurationFileFlag defines a flag for the path to the node's main toml configuration file
+	configurationFileFlag = cli.StringFlag{
+		Name:  "config",
+		Usage: "This string flag specifies the `filepath` for the node's toml configuration file",
+		Value: "../node/config/config.toml",
+	}
+	// logLevelFlag defines the logger level
+	logLevelFlag = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	// repairFlag defines a flag that enables the recovery of the corrupted units before compacting them
+	repairFlag = cli.BoolFlag{
+		Name:  "repair",
+		Usage: "Boolean option for recovering the corrupted LevelDB units before compacting them. The data that can not be recovered is lost.",
+	}
+	// allRootHashesFlag defines a flag that enables the root hash check for all the headers
+	allRootHashesFlag = cli.BoolFlag{
+		Name: "all-root-hashes",
+		Usage: "Boolean option for checking the root hashes of all the headers. By default only the root hash of the " +
+			"last header of each shard is checked, as the older states are removed when the trie pruning is enabled.",
+	}
+	// outputFlag defines a flag for the path of the snapshot archive to be written
+	outputFlag = cli.StringFlag{
+		Name:  "output",
+		Usage: "This string flag specifies the `filepath` of the snapshot archive to be written",
+		Value: "db-snapshot.tar.gz",
+	}
+	// shardFlag defines a flag for the shard whose storage units are exported
+	shardFlag = cli.StringFlag{
+		Name: "shard",
+		Usage: "This string flag specifies the `shard` whose storage units are exported, for example 0 or metachain. " +
+			"If not set, it is detected from the directories of the last epoch",
+	}
+	// rootHashFlag defines a flag for the hex encoded root hash of the accounts trie to be analyzed
+	rootHashFlag = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "This string flag specifies the hex encoded `root hash` of the accounts trie to be analyzed",
+	}
+	// analyzedShardFlag defines a flag for the shard whose accounts trie is analyzed
+	analyzedShardFlag = cli.StringFlag{
+		Name:  "shard",
+		Usage: "This string flag specifies the `shard` whose accounts trie is analyzed, for example 0 or metachain",
+		Value: "0",
+	}
+	// topFlag defines a flag for the number of accounts with the biggest data tries to be reported
+	topFlag = cli.UintFlag{
+		Name:  "top",
+		Usage: "This flag specifies the `number` of accounts with the biggest data tries to be reported",
+		Value: 20,
+	}
+
+	log = logger.GetOrCreate("dbtool")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbToolHelpTemplate
+	app.Name = "Elrond database tool"
+	app.Usage = "Offline verification, compaction, repair, snapshot export and trie analysis of the node's databases. The node must be stopped before running the tool"
+	app.Version = fmt.Sprintf("%s/%s/%s-%s", "1.0.0", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+	app.Flags = []cli.Flag{
+		dbPathFlag,
+		configurationFileFlag,
+		logLevelFlag,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "verify",
+			Usage:  "opens every storage unit in read only mode and reads all its records",
+			Action: verify,
+		},
+		{
+			Name:   "compact",
+			Usage:  "compacts every storage unit, optionally recovering the corrupted ones",
+			Flags:  []cli.Flag{repairFlag},
+			Action: compact,
+		},
+		{
+			Name:   "check",
+			Usage:  "cross checks the headers against the miniblocks, transactions and trie storage units",
+			Flags:  []cli.Flag{allRootHashesFlag},
+			Action: crossCheck,
+		},
+		{
+			Name: "export-snapshot",
+			Usage: "packs the static and the last epoch storage units of a shard in an archive that can be imported by " +
+				"a new node with the --import-snapshot and --import-snapshot-metablock-hash flags",
+			Flags:  []cli.Flag{outputFlag, shardFlag},
+			Action: exportSnapshot,
+		},
+		{
+			Name: "analyze-trie",
+			Usage: "walks the accounts trie of a shard found at a root hash, alongside all its data tries, and prints " +
+				"their node statistics and the accounts holding the biggest data tries",
+			Flags:  []cli.Flag{rootHashFlag, analyzedShardFlag, topFlag},
+			Action: analyzeTrie,
+		},
+	}
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func getUnits(ctx *cli.Context) ([]*databases.UnitInfo, error) {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevelFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	dbPath := ctx.GlobalString(dbPathFlag.Name)
+	if !core.DoesFileExist(dbPath) {
+		return nil, fmt.Errorf("no db directory found, path: %s", dbPath)
+	}
+
+	walker, err := databases.NewUnitsWalker(dbPath, factory.NewDirectoryReader())
+	if err != nil {
+		return nil, err
+	}
+
+	return walker.Units()
+}
+
+func verify(ctx *cli.Context) error {
+	units, err := getUnits(ctx)
+	if err != nil {
+		return err
+	}
+
+	numFailed := 0
+	for _, unit := range units {
+		numRecords, errVerify := databases.VerifyUnit(unit.Path)
+		if errVerify != nil {
+			numFailed++
+			log.Error("unit verification failed", "path", unit.Path, "type", unit.Type, "error", errVerify)
+			continue
+		}
+
+		log.Info("unit verified", "path", unit.Path, "type", unit.Type, "num records", numRecords)
+	}
+
+	log.Info("verification finished", "num units", len(units), "num failed", numFailed)
+	if numFailed > 0 {
+		return fmt.Errorf("%d out of %d units failed the verification", numFailed, len(units))
+	}
+
+	return nil
+}
+
+func compact(ctx *cli.Context) error {
+	units, err := getUnits(ctx)
+	if err != nil {
+		return err
+	}
+
+	repair := ctx.Bool(repairFlag.Name)
+	numFailed := 0
+	for _, unit := range units {
+		log.Info("compacting unit", "path", unit.Path, "type", unit.Type)
+		errCompact := databases.Compact(unit.Path, repair)
+		if errCompact != nil {
+			numFailed++
+			log.Error("unit compaction failed", "path", unit.Path, "error", errCompact)
+		}
+	}
+
+	log.Info("compaction finished", "num units", len(units), "num failed", numFailed)
+	if numFailed > 0 {
+		return fmt.Errorf("%d out of %d units could not be compacted", numFailed, len(units))
+	}
+
+	return nil
+}
+
+func crossCheck(ctx *cli.Context) error {
+	units, err := getUnits(ctx)
+	if err != nil {
+		return err
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, ctx.GlobalString(configurationFileFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := createPathManager(ctx.GlobalString(dbPathFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	crossChecker, err := checker.NewCrossChecker(checker.ArgsCrossChecker{
+		GeneralConfig:      *generalConfig,
+		PathManager:        pathManager,
+		Marshalizer:        marshalizer,
+		DatabaseOpener:     databases.NewReadOnlyOpener(),
+		CheckAllRootHashes: ctx.Bool(allRootHashesFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	issues := crossChecker.Check(units)
+	for _, issue := range issues {
+		log.Error("inconsistency found", "issue", issue.String())
+	}
+
+	log.Info("cross check finished", "num issues", len(issues))
+	if len(issues) > 0 {
+		return fmt.Errorf("found %d inconsistencies", len(issues))
+	}
+
+	return nil
+}
+
+func exportSnapshot(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevelFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, ctx.GlobalString(configurationFileFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := dbSnapshot.NewExporter(dbSnapshot.ArgsDBSnapshot{
+		GeneralConfig:         *generalConfig,
+		Marshalizer:           marshalizer,
+		Hasher:                hasher,
+		DirectoryReader:       factory.NewDirectoryReader(),
+		DbPathWithChainID:     ctx.GlobalString(dbPathFlag.Name),
+		DefaultEpochString:    nodeFactory.DefaultEpochString,
+		DefaultShardString:    nodeFactory.DefaultShardString,
+		DefaultStaticDbString: nodeFactory.DefaultStaticDbString,
+	})
+	if err != nil {
+		return err
+	}
+
+	outputPath := ctx.String(outputFlag.Name)
+	output, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+
+	_, err = exporter.Export(ctx.String(shardFlag.Name), output)
+	errClose := output.Close()
+	if err != nil {
+		_ = os.Remove(outputPath)
+		return err
+	}
+
+	return errClose
+}
+
+func analyzeTrie(ctx *cli.Context) error {
+	rootHash, err := hex.DecodeString(ctx.String(rootHashFlag.Name))
+	if err != nil {
+		return fmt.Errorf("invalid root hash: %w", err)
+	}
+	if len(rootHash) == 0 {
+		return fmt.Errorf("the %s flag is required", rootHashFlag.Name)
+	}
+
+	units, err := getUnits(ctx)
+	if err != nil {
+		return err
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, ctx.GlobalString(configurationFileFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	addressPubkeyConverter, err := stateFactory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return err
+	}
+
+	pathManager, err := createPathManager(ctx.GlobalString(dbPathFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	shardID := ctx.String(analyzedShardFlag.Name)
+	triePaths := checker.ComputeTriePaths(*generalConfig, pathManager, units)[shardID]
+	dbs := make([]databases.ReadOnlyDB, 0, len(triePaths))
+	for _, triePath := range triePaths {
+		db, errOpen := databases.OpenReadOnly(triePath)
+		if errOpen != nil {
+			log.Warn("cannot open unit", "path", triePath, "error", errOpen)
+			continue
+		}
+
+		dbs = append(dbs, db)
+	}
+
+	trieStorer, err := databases.NewReadOnlyTrieStorer(dbs)
+	if err != nil {
+		return fmt.Errorf("%w for the accounts trie of shard %s", err, shardID)
+	}
+	defer func() {
+		errClose := trieStorer.Close()
+		log.LogIfError(errClose)
+	}()
+
+	trieStorageManager, err := trie.NewTrieStorageManagerWithoutPruning(trieStorer)
+	if err != nil {
+		return err
+	}
+
+	accountsTrie, err := trie.NewTrie(
+		trieStorageManager,
+		marshalizer,
+		hasher,
+		generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
+	)
+	if err != nil {
+		return err
+	}
+
+	trieAnalyzer, err := analyzer.NewTrieAnalyzer(analyzer.ArgsTrieAnalyzer{
+		Marshalizer:            marshalizer,
+		AddressPubkeyConverter: addressPubkeyConverter,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("analyzing the accounts trie", "shard", shardID, "root hash", rootHash, "num units", len(dbs))
+	analysis, err := trieAnalyzer.Analyze(accountsTrie, rootHash, uint32(ctx.Uint(topFlag.Name)))
+	if err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(analysis, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+
+	return nil
+}
+
+func createPathManager(dbPathWithChainID string) (storage.PathManagerHandler, error) {
+	pathTemplateForPruningStorer := filepath.Join(
+		dbPathWithChainID,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultEpochString, core.PathEpochPlaceholder),
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	pathTemplateForStaticStorer := filepath.Join(
+		dbPathWithChainID,
+		nodeFactory.DefaultStaticDbString,
+		fmt.Sprintf("%s_%s", nodeFactory.DefaultShardString, core.PathShardPlaceholder),
+		core.PathIdentifierPlaceholder)
+
+	return pathmanager.NewPathManager(pathTemplateForPruningStorer, pathTemplateForStaticStorer)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion"
 	factorySoftwareVersion "github.com/ElrondNetwork/elrond-go/core/statistics/softwareVersion/factory"
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -29,6 +30,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	storageResolversContainers "github.com/ElrondNetwork/elrond-go/dataRetriever/factory/storageResolversContainer"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
 	metachainEpochStart "github.com/ElrondNetwork/elrond-go/epochStart/metachain"
@@ -129,6 +131,7 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	EventsHub                subscriptions.EventsHub
 }
 
 type processComponentsFactoryArgs struct {
@@ -173,6 +176,7 @@ type processComponentsFactoryArgs struct {
 	uint64Converter           typeConverters.Uint64ByteSliceConverter
 	tpsBenchmark              statistics.TPSBenchmark
 	historyRepo               dblookupext.HistoryRepository
+	eventsHub                 subscriptions.EventsHub
 	epochNotifier             process.EpochNotifier
 	txSimulatorProcessorArgs  *txsimulator.ArgsTxSimulator
 	storageReolverImportPath  string
@@ -355,6 +359,13 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 	}
 
 	args.txLogsProcessor = txLogsProcessor
+
+	eventsHub, err := newEventsHub(args.mainConfig.EventsSubscriptions, args.state.AddressPubkeyConverter, txLogsProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	args.eventsHub = eventsHub
 	genesisBlocks, err := generateGenesisHeadersAndApplyInitialBalances(args, args.workingDir)
 	if err != nil {
 		return nil, err
@@ -428,7 +439,16 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
-	bootStr := args.data.Store.GetStorer(dataRetriever.BootstrapUnit)
+	// the bootstrap data is written in the storage group of the committed block it points to
+	bootStr, err := storageGroup.NewGroupedStorer(
+		args.data.Store.GetStorer(dataRetriever.BootstrapUnit),
+		dataRetriever.BootstrapUnit,
+		args.data.StorageGroupCommitter,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	bootStorer, err := bootstrapStorage.NewBootstrapStorer(args.coreData.InternalMarshalizer, bootStr)
 	if err != nil {
 		return nil, err
@@ -586,9 +606,27 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		EventsHub:                eventsHub,
 	}, nil
 }
 
+func newEventsHub(
+	eventsSubscriptionsConfig config.EventsSubscriptionsConfig,
+	addressPubkeyConverter core.PubkeyConverter,
+	txLogsGetter subscriptions.TransactionLogsGetter,
+) (subscriptions.EventsHub, error) {
+	if !eventsSubscriptionsConfig.Enabled {
+		return subscriptions.NewDisabledEventsHub(), nil
+	}
+
+	return subscriptions.NewEventsHub(subscriptions.ArgsEventsHub{
+		PubkeyConverter:               addressPubkeyConverter,
+		TxLogsGetter:                  txLogsGetter,
+		MaxSubscriptionsPerConnection: eventsSubscriptionsConfig.MaxSubscriptionsPerConnection,
+		EventsQueueSize:               eventsSubscriptionsConfig.EventsQueueSize,
+	})
+}
+
 func indexGenesisAccounts(startTime int64, accountsAdapter state.AccountsAdapter, indexer process.Indexer, marshalizer marshal.Marshalizer) error {
 	if indexer.IsNilIndexer() {
 		return nil
@@ -723,7 +761,7 @@ func indexGenesisBlocks(args *processComponentsFactoryArgs, genesisBlocks map[ui
 		}
 
 		log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", shardID, "hash", genesisBlockHash)
-		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil)
+		err = args.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -1449,6 +1487,7 @@ func newBlockProcessor(
 			processArgs.tpsBenchmark,
 			headerIntegrityVerifier,
 			processArgs.historyRepo,
+			processArgs.eventsHub,
 			processArgs.epochNotifier,
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig,
@@ -1485,6 +1524,7 @@ func newBlockProcessor(
 			processArgs.tpsBenchmark,
 			headerIntegrityVerifier,
 			processArgs.historyRepo,
+			processArgs.eventsHub,
 			processArgs.epochNotifier,
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig,
@@ -1522,6 +1562,7 @@ func newShardBlockProcessor(
 	tpsBenchmark statistics.TPSBenchmark,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
 	historyRepository dblookupext.HistoryRepository,
+	committedBlockNotifier process.CommittedBlockNotifier,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalConfig config.Config,
@@ -1588,12 +1629,18 @@ func newShardBlockProcessor(
 		return nil, err
 	}
 
+	// the transactions saved for a committed block are written in the same storage group as its header and body
+	groupedStore, err := storageGroup.NewGroupedStorageService(data.Store, data.StorageGroupCommitter)
+	if err != nil {
+		return nil, err
+	}
+
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
 		core.Hasher,
 		stateComponents.AddressPubkeyConverter,
-		data.Store,
+		groupedStore,
 		data.Datapool,
 		economics,
 	)
@@ -1733,7 +1780,7 @@ func newShardBlockProcessor(
 
 	preProcFactory, err := shard.NewPreProcessorsContainerFactory(
 		shardCoordinator,
-		data.Store,
+		groupedStore,
 		core.InternalMarshalizer,
 		core.Hasher,
 		data.Datapool,
@@ -1808,6 +1855,8 @@ func newShardBlockProcessor(
 		Indexer:                 indexer,
 		TpsBenchmark:            tpsBenchmark,
 		HistoryRepository:       historyRepository,
+		CommittedBlockNotifier:  committedBlockNotifier,
+		StorageGroupCommitter:   data.StorageGroupCommitter,
 		EpochNotifier:           epochNotifier,
 		HeaderIntegrityVerifier: headerIntegrityVerifier,
 	}
@@ -1857,6 +1906,7 @@ func newMetaBlockProcessor(
 	tpsBenchmark statistics.TPSBenchmark,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
 	historyRepository dblookupext.HistoryRepository,
+	committedBlockNotifier process.CommittedBlockNotifier,
 	epochNotifier process.EpochNotifier,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalConfig config.Config,
@@ -1918,12 +1968,18 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	// the transactions saved for a committed block are written in the same storage group as its header and body
+	groupedStore, err := storageGroup.NewGroupedStorageService(data.Store, data.StorageGroupCommitter)
+	if err != nil {
+		return nil, err
+	}
+
 	interimProcFactory, err := metachain.NewIntermediateProcessorsContainerFactory(
 		shardCoordinator,
 		core.InternalMarshalizer,
 		core.Hasher,
 		stateComponents.AddressPubkeyConverter,
-		data.Store,
+		groupedStore,
 		data.Datapool,
 		economicsData,
 	)
@@ -2051,7 +2107,7 @@ func newMetaBlockProcessor(
 
 	preProcFactory, err := metachain.NewPreProcessorsContainerFactory(
 		shardCoordinator,
-		data.Store,
+		groupedStore,
 		core.InternalMarshalizer,
 		core.Hasher,
 		data.Datapool,
@@ -2232,6 +2288,8 @@ func newMetaBlockProcessor(
 		Indexer:                 indexer,
 		TpsBenchmark:            tpsBenchmark,
 		HistoryRepository:       historyRepository,
+		CommittedBlockNotifier:  committedBlockNotifier,
+		StorageGroupCommitter:   data.StorageGroupCommitter,
 		EpochNotifier:           epochNotifier,
 	}
 
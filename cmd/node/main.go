@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -53,8 +54,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go/fallback"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/hashing"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
 	"github.com/ElrondNetwork/elrond-go/health"
 	"github.com/ElrondNetwork/elrond-go/marshal"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/nodeDebugFactory"
@@ -79,6 +82,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/redundancy"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/dbSnapshot"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/pathmanager"
@@ -374,6 +378,27 @@ VERSION:
 		Name:  "import-db-no-sig-check",
 		Usage: "This flag, if set, will cause the signature checks on headers to be skipped. Can be used only if the import-db was previously set",
 	}
+	// importSnapshot defines a flag for the optional database snapshot archive to be unpacked before starting the node
+	importSnapshot = cli.StringFlag{
+		Name: "import-snapshot",
+		Usage: "This flag, if set, will make the node unpack the provided database snapshot archive and validate it against " +
+			"the epoch start meta block's root hashes before starting. The node's database directory must be empty. " +
+			"Requires the import-snapshot-metablock-hash flag",
+		Value: "",
+	}
+	// importSnapshotMetaBlockHash defines a flag for the trusted epoch start meta block hash of the imported snapshot
+	importSnapshotMetaBlockHash = cli.StringFlag{
+		Name: "import-snapshot-metablock-hash",
+		Usage: "The hex encoded hash of the epoch start meta block, obtained from a trusted source, against which the " +
+			"snapshot provided with the import-snapshot flag is validated",
+		Value: "",
+	}
+	// importSnapshotMaxSizeInGB defines a flag for the maximum size of the unpacked database snapshot
+	importSnapshotMaxSizeInGB = cli.Uint64Flag{
+		Name:  "import-snapshot-max-size-gb",
+		Usage: "The maximum size, in GB, of the data unpacked from the snapshot provided with the import-snapshot flag",
+		Value: 500,
+	}
 
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
@@ -448,6 +473,9 @@ func main() {
 		startInEpoch,
 		importDbDirectory,
 		importDbNoSigCheck,
+		importSnapshot,
+		importSnapshotMetaBlockHash,
+		importSnapshotMaxSizeInGB,
 		redundancyLevel,
 	}
 	app.Authors = []cli.Author{
@@ -717,6 +745,11 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	err = importSnapshotIfNecessary(workingDir, genesisNodesConfig.ChainID, generalConfig, ctx, log)
+	if err != nil {
+		return err
+	}
+
 	pathTemplateForPruningStorer := filepath.Join(
 		workingDir,
 		factory.DefaultDBPath,
@@ -1540,7 +1573,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(log, healthService, processComponents.EventsHub, dataComponents, triesComponents, networkComponents, chanCloseComponents)
 	}()
 
 	select {
@@ -1602,6 +1635,7 @@ func changeStorageConfigForDBImport(storageConfig *config.StorageConfig) {
 func closeAllComponents(
 	log logger.Logger,
 	healthService io.Closer,
+	eventsHub io.Closer,
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
@@ -1611,6 +1645,10 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing events hub...")
+	err = eventsHub.Close()
+	log.LogIfError(err)
+
 	log.Debug("closing all store units....")
 	err = dataComponents.Store.CloseAll()
 	log.LogIfError(err)
@@ -1669,6 +1707,64 @@ func cleanupStorageIfNecessary(workingDir string, ctx *cli.Context, log logger.L
 	return nil
 }
 
+func importSnapshotIfNecessary(
+	workingDir string,
+	chainID string,
+	generalConfig *config.Config,
+	ctx *cli.Context,
+	log logger.Logger,
+) error {
+	snapshotPath := ctx.GlobalString(importSnapshot.Name)
+	if len(snapshotPath) == 0 {
+		return nil
+	}
+
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	trustedMetaBlockHash, err := hex.DecodeString(ctx.GlobalString(importSnapshotMetaBlockHash.Name))
+	if err != nil {
+		return fmt.Errorf("%w for the %s flag", err, importSnapshotMetaBlockHash.Name)
+	}
+
+	importer, err := dbSnapshot.NewImporter(dbSnapshot.ArgsImporter{
+		ArgsDBSnapshot: dbSnapshot.ArgsDBSnapshot{
+			GeneralConfig:         *generalConfig,
+			Marshalizer:           marshalizer,
+			Hasher:                hasher,
+			DirectoryReader:       storageFactory.NewDirectoryReader(),
+			DbPathWithChainID:     filepath.Join(workingDir, factory.DefaultDBPath, chainID),
+			DefaultEpochString:    factory.DefaultEpochString,
+			DefaultShardString:    factory.DefaultShardString,
+			DefaultStaticDbString: factory.DefaultStaticDbString,
+		},
+		TrustedEpochStartMetaBlockHash: trustedMetaBlockHash,
+		MaxUnpackedBytes:               ctx.GlobalUint64(importSnapshotMaxSizeInGB.Name) * 1024 * core.MegabyteSize,
+	})
+	if err != nil {
+		return err
+	}
+
+	snapshotFile, err := core.OpenFile(snapshotPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := snapshotFile.Close()
+		log.LogIfError(errClose, "path", snapshotPath)
+	}()
+
+	log.Info("importing database snapshot", "path", snapshotPath)
+	_, err = importer.Import(snapshotFile)
+
+	return err
+}
+
 func copyConfigToStatsFolder(log logger.Logger, statsFolder string, configs []string) {
 	err := os.MkdirAll(statsFolder, os.ModePerm)
 	log.LogIfError(err)
@@ -2364,6 +2460,7 @@ func createNode(
 		node.WithWatchdogTimer(watchdogTimer),
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
 		node.WithHistoryRepository(historyRepository),
+		node.WithEventsHub(process.EventsHub),
 		node.WithEnableSignTxWithHashEpoch(config.GeneralSettings.TransactionSignedWithTxHashEnableEpoch),
 		node.WithTxSignHasher(coreData.TxSignHasher),
 		node.WithTxVersionChecker(txVersionCheckerHandler),
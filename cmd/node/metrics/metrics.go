@@ -15,6 +15,7 @@ import (
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 const millisecondsInSecond = 1000
@@ -177,6 +178,11 @@ func StartStatusPolling(
 		return err
 	}
 
+	err = registerStorersMetrics(appStatusPollingHandler)
+	if err != nil {
+		return err
+	}
+
 	appStatusPollingHandler.Poll()
 
 	return nil
@@ -221,6 +227,55 @@ func registerShardsInformation(
 	return nil
 }
 
+func registerStorersMetrics(appStatusPollingHandler *appStatusPolling.AppStatusPolling) error {
+	computeStorersMetrics := func(appStatusHandler core.AppStatusHandler) {
+		for _, snapshot := range storage.GetStorersMetricsSnapshots() {
+			setStorerMetrics(appStatusHandler, snapshot)
+		}
+	}
+
+	err := appStatusPollingHandler.RegisterPollingFunc(computeStorersMetrics)
+	if err != nil {
+		return fmt.Errorf("%w, cannot register handler func for storers metrics", err)
+	}
+
+	return nil
+}
+
+// setStorerMetrics saves the storer metrics using prometheus style labels in the keys, as the same metric
+// is published for each storer. The labeled metrics are only exported on the prometheus endpoint
+func setStorerMetrics(appStatusHandler core.AppStatusHandler, snapshot *storage.StorerMetricsSnapshot) {
+	unitLabel := fmt.Sprintf("unit=\"%s\"", snapshot.Name)
+
+	appStatusHandler.SetUInt64Value(labeledMetric(core.MetricStorerGets, unitLabel), snapshot.Gets)
+	appStatusHandler.SetUInt64Value(labeledMetric(core.MetricStorerPuts, unitLabel), snapshot.Puts)
+	appStatusHandler.SetUInt64Value(labeledMetric(core.MetricStorerCacheHits, unitLabel), snapshot.CacheHits)
+	appStatusHandler.SetUInt64Value(labeledMetric(core.MetricStorerBloomNegatives, unitLabel), snapshot.BloomNegatives)
+	appStatusHandler.SetUInt64Value(labeledMetric(core.MetricStorerPersisterMisses, unitLabel), snapshot.PersisterMisses)
+	setLatencyHistogramMetrics(appStatusHandler, core.MetricStorerGetLatency, unitLabel, snapshot.GetLatency)
+	setLatencyHistogramMetrics(appStatusHandler, core.MetricStorerPutLatency, unitLabel, snapshot.PutLatency)
+}
+
+func setLatencyHistogramMetrics(
+	appStatusHandler core.AppStatusHandler,
+	metric string,
+	unitLabel string,
+	histogram *storage.LatencyHistogramSnapshot,
+) {
+	for i, upperBound := range storage.LatencyBucketsMicroseconds {
+		bucketLabels := fmt.Sprintf("%s,le=\"%d\"", unitLabel, upperBound)
+		appStatusHandler.SetUInt64Value(labeledMetric(metric+"_bucket", bucketLabels), histogram.Buckets[i])
+	}
+
+	appStatusHandler.SetUInt64Value(labeledMetric(metric+"_bucket", unitLabel+",le=\"+Inf\""), histogram.Count)
+	appStatusHandler.SetUInt64Value(labeledMetric(metric+"_sum", unitLabel), histogram.SumMicroseconds)
+	appStatusHandler.SetUInt64Value(labeledMetric(metric+"_count", unitLabel), histogram.Count)
+}
+
+func labeledMetric(metric string, labels string) string {
+	return fmt.Sprintf("%s{%s}", metric, labels)
+}
+
 func computeNumConnectedPeers(
 	appStatusHandler core.AppStatusHandler,
 	networkComponents *mainFactory.NetworkComponents,
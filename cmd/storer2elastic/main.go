@@ -238,6 +238,8 @@ func startStorer2Elastic(ctx *cli.Context) error {
 		BatchDelaySeconds: 2,
 		MaxBatchSize:      30000,
 		MaxOpenFiles:      200,
+		// the compression layer reads the values written with any compression type, as well as the uncompressed ones
+		Compression: string(storageUnit.SnappyCompression),
 	}
 
 	persisterFactory := factory.NewPersisterFactory(nodeConfigPackage.DBConfig(generalDBConfig))
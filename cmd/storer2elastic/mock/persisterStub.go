@@ -2,7 +2,10 @@ package mock
 
 import (
 	"errors"
+	"strings"
 	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var errKeyNotFound = errors.New("key not found")
@@ -89,6 +92,28 @@ func (p *persisterMock) RangeKeys(handler func(key []byte, val []byte) bool) {
 	p.mutValues.RUnlock()
 }
 
+// RangeKeysWithPrefix -
+func (p *persisterMock) RangeKeysWithPrefix(prefix []byte, _ bool, handler func(key []byte, val []byte) bool) {
+	p.mutValues.RLock()
+	for key, val := range p.values {
+		if strings.HasPrefix(key, string(prefix)) {
+			handler([]byte(key), val)
+		}
+	}
+	p.mutValues.RUnlock()
+}
+
+// RangeKeysFrom -
+func (p *persisterMock) RangeKeysFrom(start []byte, end []byte, _ bool, handler func(key []byte, val []byte) bool) {
+	p.mutValues.RLock()
+	for key, val := range p.values {
+		if storage.IsKeyInRange([]byte(key), start, end) {
+			handler([]byte(key), val)
+		}
+	}
+	p.mutValues.RUnlock()
+}
+
 // IsInterfaceNil -
 func (p *persisterMock) IsInterfaceNil() bool {
 	return p == nil
@@ -24,12 +24,14 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       string
 }
 
 // BloomFilterConfig will map the bloom filter configuration
 type BloomFilterConfig struct {
 	Size     uint
 	HashFunc []string
+	Type     string
 }
 
 // StorageConfig will map the storage unit configuration
@@ -111,6 +113,7 @@ type Config struct {
 	SmartContractsStorageForSCQuery StorageConfig
 
 	BootstrapStorage StorageConfig
+	TrieSyncStorage  StorageConfig
 	MetaBlockStorage StorageConfig
 
 	AccountsTrieStorage      StorageConfig
@@ -153,6 +156,7 @@ type Config struct {
 	GeneralSettings     GeneralSettingsConfig
 	Consensus           TypeConfig
 	StoragePruning      StoragePruningConfig
+	WriteAheadLog       WriteAheadLogConfig
 	TxLogsStorage       StorageConfig
 
 	NTPConfig               NTPConfig
@@ -166,6 +170,7 @@ type Config struct {
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
+	EventsSubscriptions   EventsSubscriptionsConfig
 	Versions              VersionsConfig
 	GasSchedule           GasScheduleConfig
 	Logs                  LogsConfig
@@ -179,10 +184,18 @@ type LogsConfig struct {
 
 // StoragePruningConfig will hold settings related to storage pruning
 type StoragePruningConfig struct {
-	Enabled             bool
-	CleanOldEpochsData  bool
-	NumEpochsToKeep     uint64
-	NumActivePersisters uint64
+	Enabled                  bool
+	CleanOldEpochsData       bool
+	NumEpochsToKeep          uint64
+	NumActivePersisters      uint64
+	ArchivePath              string
+	NumEpochsBeforeArchiving uint64
+}
+
+// WriteAheadLogConfig will hold settings related to the write ahead log of the committed blocks data
+type WriteAheadLogConfig struct {
+	Enabled         bool
+	NumGroupsToKeep uint64
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -270,6 +283,8 @@ type WebServerAntifloodConfig struct {
 	SameSourceRequests           uint32
 	SameSourceResetIntervalInSec uint32
 	EndpointsThrottlers          []EndpointsThrottlersConfig
+	GraphQLMaxQueryDepth         uint32
+	GraphQLMaxQueryCost          uint32
 }
 
 // BlackListConfig will hold the p2p peer black list threshold values
@@ -387,6 +402,15 @@ type DbLookupExtensionsConfig struct {
 	MiniblockHashByTxHashStorageConfig StorageConfig
 	EpochByHashStorageConfig           StorageConfig
 	ResultsHashesByTxHashStorageConfig StorageConfig
+	TransactionsByAddressEnabled       bool
+	TransactionsByAddressStorageConfig StorageConfig
+}
+
+// EventsSubscriptionsConfig holds the configuration for the web socket events subscriptions
+type EventsSubscriptionsConfig struct {
+	Enabled                       bool
+	MaxSubscriptionsPerConnection int
+	EventsQueueSize               int
 }
 
 // DebugConfig will hold debugging configuration
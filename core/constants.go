@@ -442,6 +442,27 @@ const MetricP2PUnknownPeers = "erd_p2p_unknown_shard_peers"
 // MetricP2PNumConnectedPeersClassification is the metric for monitoring the number of connected peers split on the connection type
 const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_classification"
 
+// MetricStorerGets is the metric for monitoring the number of get operations of a storer
+const MetricStorerGets = "erd_storer_gets"
+
+// MetricStorerPuts is the metric for monitoring the number of put operations of a storer
+const MetricStorerPuts = "erd_storer_puts"
+
+// MetricStorerCacheHits is the metric for monitoring the number of get operations of a storer served from the cache
+const MetricStorerCacheHits = "erd_storer_cache_hits"
+
+// MetricStorerBloomNegatives is the metric for monitoring the number of get operations of a storer stopped by the bloom filter
+const MetricStorerBloomNegatives = "erd_storer_bloom_negatives"
+
+// MetricStorerPersisterMisses is the metric for monitoring the number of get operations of a storer not found in the persister
+const MetricStorerPersisterMisses = "erd_storer_persister_misses"
+
+// MetricStorerGetLatency is the metric for monitoring the latency histogram, in microseconds, of the get operations of a storer
+const MetricStorerGetLatency = "erd_storer_get_latency_us"
+
+// MetricStorerPutLatency is the metric for monitoring the latency histogram, in microseconds, of the put operations of a storer
+const MetricStorerPutLatency = "erd_storer_put_latency_us"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -15,3 +15,9 @@ func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) er
 func newErrCannotSaveMiniblockMetadata(hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save miniblock metadata, hash [%s]: %w", hex.EncodeToString(hash), originalErr)
 }
+
+// ErrTransactionsByAddressIndexCorrupted signals that the stored transactions of an address do not match their summary
+var ErrTransactionsByAddressIndexCorrupted = errors.New("transactions by address index is corrupted")
+
+// ErrTransactionsByAddressIndexNotEnabled signals that the transactions by address index is not enabled
+var ErrTransactionsByAddressIndexNotEnabled = errors.New("transactions by address index is not enabled")
@@ -64,6 +64,10 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 	}
+	if hpf.dbLookupExtensionsConfig.TransactionsByAddressEnabled {
+		historyRepArgs.TransactionsByAddressStorer = hpf.store.GetStorer(dataRetriever.TransactionsByAddressUnit)
+	}
+
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
@@ -29,6 +29,7 @@ type HistoryRepositoryArguments struct {
 	MiniblockHashByTxHashStorer storage.Storer
 	EpochByHashStorer           storage.Storer
 	EventsHashesByTxHashStorer  storage.Storer
+	TransactionsByAddressStorer storage.Storer
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 }
@@ -39,6 +40,7 @@ type historyRepository struct {
 	miniblockHashByTxHashIndex storage.Storer
 	epochByHashIndex           *epochByHashIndex
 	eventsHashesByTxHashIndex  *eventsHashesByTxHash
+	transactionsByAddrIndex    *transactionsByAddressIndex
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 
@@ -87,6 +89,12 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 
 	eventsHashesToTxHashIndex := newEventsHashesByTxHash(arguments.EventsHashesByTxHashStorer, arguments.Marshalizer)
 
+	// the transactions by address index is optional, being created only if a storer is provided
+	var transactionsByAddrIndex *transactionsByAddressIndex
+	if !check.IfNil(arguments.TransactionsByAddressStorer) {
+		transactionsByAddrIndex = newTransactionsByAddressIndex(arguments.TransactionsByAddressStorer, arguments.Marshalizer)
+	}
+
 	return &historyRepository{
 		selfShardID:                           arguments.SelfShardID,
 		miniblocksMetadataStorer:              arguments.MiniblocksMetadataStorer,
@@ -99,6 +107,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		pendingNotarizedAtBothNotifications:          container.NewMutexMap(),
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
+		transactionsByAddrIndex:                      transactionsByAddrIndex,
 	}, nil
 }
 
@@ -110,6 +119,7 @@ func (hr *historyRepository) RecordBlock(
 	blockBody data.BodyHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
+	transactionsFromPool map[string]data.TransactionHandler,
 ) error {
 	hr.recordBlockMutex.Lock()
 	defer hr.recordBlockMutex.Unlock()
@@ -128,17 +138,26 @@ func (hr *historyRepository) RecordBlock(
 		return newErrCannotSaveEpochByHash("block header", blockHeaderHash, err)
 	}
 
+	var collector *transactionsByAddressCollector
+	if hr.transactionsByAddrIndex != nil {
+		collector = newTransactionsByAddressCollector(blockHeaderHash, hr.selfShardID, epoch, transactionsFromPool, scrResultsFromPool)
+	}
+
 	for _, miniblock := range body.MiniBlocks {
 		if miniblock.Type == block.PeerBlock {
 			continue
 		}
 
-		err = hr.recordMiniblock(blockHeaderHash, blockHeader, miniblock, epoch)
+		err = hr.recordMiniblock(blockHeaderHash, blockHeader, miniblock, epoch, collector)
 		if err != nil {
 			continue
 		}
 	}
 
+	if collector != nil {
+		hr.transactionsByAddrIndex.saveTransactions(blockHeaderHash, collector.transactionsByAddr)
+	}
+
 	err = hr.eventsHashesByTxHashIndex.saveResultsHashes(epoch, scrResultsFromPool, receiptsFromPool)
 	if err != nil {
 		return err
@@ -147,7 +166,46 @@ func (hr *historyRepository) RecordBlock(
 	return nil
 }
 
-func (hr *historyRepository) recordMiniblock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniblock *block.MiniBlock, epoch uint32) error {
+// RevertBlock removes the transactions of a rolled back block from the history of their addresses and forgets its
+// miniblocks, so that the block can be recorded again if it is committed back
+func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	hr.recordBlockMutex.Lock()
+	defer hr.recordBlockMutex.Unlock()
+
+	blockHeaderHash, err := core.CalculateHash(hr.marshalizer, hr.hasher, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("RevertBlock()", "nonce", blockHeader.GetNonce(), "blockHeaderHash", blockHeaderHash)
+
+	body, ok := blockBody.(*block.Body)
+	if ok {
+		for _, miniblock := range body.MiniBlocks {
+			miniblockHash, errHash := hr.computeMiniblockHash(miniblock)
+			if errHash != nil {
+				continue
+			}
+
+			key := hr.buildKeyOfDeduplicationCacheForInsertMiniblockMetadata(miniblockHash, blockHeader.GetEpoch())
+			hr.deduplicationCacheForInsertMiniblockMetadata.Remove(key)
+		}
+	}
+
+	if hr.transactionsByAddrIndex != nil {
+		hr.transactionsByAddrIndex.revertBlock(blockHeaderHash)
+	}
+
+	return nil
+}
+
+func (hr *historyRepository) recordMiniblock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniblock *block.MiniBlock,
+	epoch uint32,
+	collector *transactionsByAddressCollector,
+) error {
 	miniblockHash, err := hr.computeMiniblockHash(miniblock)
 	if err != nil {
 		return err
@@ -186,6 +244,16 @@ func (hr *historyRepository) recordMiniblock(blockHeaderHash []byte, blockHeader
 			log.Warn("miniblockHashByTxHashIndex.Put()", "txHash", txHash, "err", errPut)
 			continue
 		}
+
+		errPut = hr.epochByHashIndex.saveEpochByHash(txHash, epoch)
+		if errPut != nil {
+			log.Warn("epochByHashIndex.saveEpochByHash()", "txHash", txHash, "err", errPut)
+		}
+	}
+
+	// miniblocks already recorded are skipped above, so that their transactions are not indexed twice for an address
+	if collector != nil {
+		collector.addMiniblock(miniblock)
 	}
 
 	return nil
@@ -258,8 +326,8 @@ func (hr *historyRepository) getMiniblockMetadataByMiniblockHash(hash []byte) (*
 }
 
 // GetEpochByHash will return epoch for a given hash
-// This works for Blocks, Miniblocks
-// It doesn't work for transactions (not needed, there we have a static storer for "miniblockHashByTxHashIndex" as well)!
+// This works for Blocks, Miniblocks and the transactions recorded in miniblocks, so that the transactions can be
+// fetched in bulk, grouped by epoch
 func (hr *historyRepository) GetEpochByHash(hash []byte) (uint32, error) {
 	return hr.epochByHashIndex.getEpochByHash(hash)
 }
@@ -427,6 +495,16 @@ func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint3
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
 }
 
+// GetTransactionsByAddress will return at most limit transactions involving the given address, newest first, skipping
+// the first offset ones, alongside the total number of transactions indexed for that address
+func (hr *historyRepository) GetTransactionsByAddress(address []byte, offset uint64, limit uint64) ([]*TransactionByAddress, uint64, error) {
+	if hr.transactionsByAddrIndex == nil {
+		return nil, 0, ErrTransactionsByAddressIndexNotEnabled
+	}
+
+	return hr.transactionsByAddrIndex.getTransactions(address, offset, limit)
+}
+
 // IsEnabled will always returns true
 func (hr *historyRepository) IsEnabled() bool {
 	return true
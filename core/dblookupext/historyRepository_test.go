@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/mock"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -99,16 +100,104 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
-	// One block, two miniblocks
-	require.Equal(t, 3, repo.epochByHashIndex.storer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
+	// One block, two miniblocks, two transactions
+	require.Equal(t, 5, repo.epochByHashIndex.storer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 	// Two transactions
 	require.Equal(t, 2, repo.miniblockHashByTxHashIndex.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordBlockShouldIndexTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	args.TransactionsByAddressStorer = genericMocks.NewStorerMock("TransactionsByAddress", 0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	transactions := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				Type:     block.TxBlock,
+				TxHashes: [][]byte{[]byte("txA")},
+			},
+		},
+	}
+
+	err = repo.RecordBlock([]byte("fooBlock"), &block.Header{Epoch: 0}, blockBody, nil, nil, transactions)
+	require.Nil(t, err)
+	// the same miniblock, recorded again, should not be indexed twice
+	err = repo.RecordBlock([]byte("fooBlock"), &block.Header{Epoch: 0}, blockBody, nil, nil, transactions)
+	require.Nil(t, err)
+
+	aliceTransactions, total, err := repo.GetTransactionsByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []*TransactionByAddress{{Epoch: 0, TxHash: []byte("txA"), Role: RoleSender, HeaderHash: []byte("fooBlock")}}, aliceTransactions)
+
+	bobTransactions, total, err := repo.GetTransactionsByAddress([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+	require.Equal(t, []*TransactionByAddress{{Epoch: 0, TxHash: []byte("txA"), Role: RoleReceiver, HeaderHash: []byte("fooBlock")}}, bobTransactions)
+}
+
+func TestHistoryRepository_RevertBlockShouldRemoveTheIndexedTransactions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	args.TransactionsByAddressStorer = genericMocks.NewStorerMock("TransactionsByAddress", 0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	transactions := map[string]data.TransactionHandler{
+		"txA": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+	blockBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				Type:     block.TxBlock,
+				TxHashes: [][]byte{[]byte("txA")},
+			},
+		},
+	}
+	blockHeader := &block.Header{Nonce: 5}
+	blockHeaderHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, blockHeader)
+
+	err = repo.RecordBlock(blockHeaderHash, blockHeader, blockBody, nil, nil, transactions)
+	require.Nil(t, err)
+
+	err = repo.RevertBlock(blockHeader, blockBody)
+	require.Nil(t, err)
+	_, total, err := repo.GetTransactionsByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+
+	// the block committed back after the rollback is indexed again
+	err = repo.RecordBlock(blockHeaderHash, blockHeader, blockBody, nil, nil, transactions)
+	require.Nil(t, err)
+	_, total, err = repo.GetTransactionsByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), total)
+}
+
+func TestHistoryRepository_GetTransactionsByAddressNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	repo, err := NewHistoryRepository(createMockHistoryRepoArgs(0))
+	require.Nil(t, err)
+
+	transactions, total, err := repo.GetTransactionsByAddress([]byte("alice"), 0, 10)
+	require.Nil(t, transactions)
+	require.Equal(t, uint64(0), total)
+	require.Equal(t, ErrTransactionsByAddressIndexNotEnabled, err)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -133,7 +222,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil,
+		nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -171,7 +260,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil)
+	}, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -186,10 +275,15 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, 42, int(epoch))
 
-	// Get epoch by transaction hash DOES NOT WORK (not needed)
-	_, err = repo.GetEpochByHash([]byte("txA"))
-	require.NotNil(t, err)
-	_, err = repo.GetEpochByHash([]byte("txA"))
+	// Get epoch by transaction hash
+	epoch, err = repo.GetEpochByHash([]byte("txA"))
+	require.Nil(t, err)
+	require.Equal(t, 42, int(epoch))
+	epoch, err = repo.GetEpochByHash([]byte("txB"))
+	require.Nil(t, err)
+	require.Equal(t, 42, int(epoch))
+
+	_, err = repo.GetEpochByHash([]byte("txC"))
 	require.NotNil(t, err)
 }
 
@@ -229,7 +323,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -429,7 +523,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -437,7 +531,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -484,7 +578,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -541,7 +635,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -554,7 +648,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil,
+		}, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -668,7 +762,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil,
+				}, nil, nil, nil,
 			)
 		}
 
@@ -17,12 +17,15 @@ type HistoryRepository interface {
 		blockBody data.BodyHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
+		transactionsFromPool map[string]data.TransactionHandler,
 	) error
 
+	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
+	GetTransactionsByAddress(address []byte, offset uint64, limit uint64) ([]*TransactionByAddress, uint64, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
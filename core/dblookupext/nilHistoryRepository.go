@@ -13,7 +13,12 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (nhr *nilHistoryRepository) RevertBlock(_ data.HeaderHandler, _ data.BodyHandler) error {
 	return nil
 }
 
@@ -41,6 +46,11 @@ func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*
 	return nil, nil
 }
 
+// GetTransactionsByAddress returns a not enabled error
+func (nhr *nilHistoryRepository) GetTransactionsByAddress(_ []byte, _ uint64, _ uint64) ([]*TransactionByAddress, uint64, error) {
+	return nil, 0, ErrTransactionsByAddressIndexNotEnabled
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
@@ -0,0 +1,1006 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: transactionsByAddress.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransactionRole defines the relation between an address and a transaction indexed for that address
+type TransactionRole int32
+
+const (
+	RoleSender                      TransactionRole = 0
+	RoleReceiver                    TransactionRole = 1
+	RoleSmartContractResultSender   TransactionRole = 2
+	RoleSmartContractResultReceiver TransactionRole = 3
+	RoleRewardReceiver              TransactionRole = 4
+)
+
+var TransactionRole_name = map[int32]string{
+	0: "RoleSender",
+	1: "RoleReceiver",
+	2: "RoleSmartContractResultSender",
+	3: "RoleSmartContractResultReceiver",
+	4: "RoleRewardReceiver",
+}
+
+var TransactionRole_value = map[string]int32{
+	"RoleSender":                      0,
+	"RoleReceiver":                    1,
+	"RoleSmartContractResultSender":   2,
+	"RoleSmartContractResultReceiver": 3,
+	"RoleRewardReceiver":              4,
+}
+
+func (TransactionRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_835191adf6b24158, []int{0}
+}
+
+// TransactionByAddress is used to store a transaction hash, the epoch it was executed in, the role of the address and
+// the hash of the block that recorded it
+type TransactionByAddress struct {
+	Epoch      uint32          `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	TxHash     []byte          `protobuf:"bytes,2,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Role       TransactionRole `protobuf:"varint,3,opt,name=Role,proto3,enum=proto.TransactionRole" json:"Role,omitempty"`
+	HeaderHash []byte          `protobuf:"bytes,4,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+}
+
+func (m *TransactionByAddress) Reset()      { *m = TransactionByAddress{} }
+func (*TransactionByAddress) ProtoMessage() {}
+func (*TransactionByAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_835191adf6b24158, []int{0}
+}
+func (m *TransactionByAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionByAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionByAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionByAddress.Merge(m, src)
+}
+func (m *TransactionByAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionByAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionByAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionByAddress proto.InternalMessageInfo
+
+func (m *TransactionByAddress) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *TransactionByAddress) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *TransactionByAddress) GetRole() TransactionRole {
+	if m != nil {
+		return m.Role
+	}
+	return RoleSender
+}
+
+func (m *TransactionByAddress) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+// TransactionsByAddressChunk is used to store a bounded, ordered, list of transactions of an address
+type TransactionsByAddressChunk struct {
+	Transactions []*TransactionByAddress `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *TransactionsByAddressChunk) Reset()      { *m = TransactionsByAddressChunk{} }
+func (*TransactionsByAddressChunk) ProtoMessage() {}
+func (*TransactionsByAddressChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_835191adf6b24158, []int{1}
+}
+func (m *TransactionsByAddressChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionsByAddressChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionsByAddressChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionsByAddressChunk.Merge(m, src)
+}
+func (m *TransactionsByAddressChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionsByAddressChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionsByAddressChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionsByAddressChunk proto.InternalMessageInfo
+
+func (m *TransactionsByAddressChunk) GetTransactions() []*TransactionByAddress {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// TransactionsByAddressSummary is used to store the number of transactions indexed for an address
+type TransactionsByAddressSummary struct {
+	NumTransactions uint64 `protobuf:"varint,1,opt,name=NumTransactions,proto3" json:"NumTransactions,omitempty"`
+}
+
+func (m *TransactionsByAddressSummary) Reset()      { *m = TransactionsByAddressSummary{} }
+func (*TransactionsByAddressSummary) ProtoMessage() {}
+func (*TransactionsByAddressSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_835191adf6b24158, []int{2}
+}
+func (m *TransactionsByAddressSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionsByAddressSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionsByAddressSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionsByAddressSummary.Merge(m, src)
+}
+func (m *TransactionsByAddressSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionsByAddressSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionsByAddressSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionsByAddressSummary proto.InternalMessageInfo
+
+func (m *TransactionsByAddressSummary) GetNumTransactions() uint64 {
+	if m != nil {
+		return m.NumTransactions
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("proto.TransactionRole", TransactionRole_name, TransactionRole_value)
+	proto.RegisterType((*TransactionByAddress)(nil), "proto.TransactionByAddress")
+	proto.RegisterType((*TransactionsByAddressChunk)(nil), "proto.TransactionsByAddressChunk")
+	proto.RegisterType((*TransactionsByAddressSummary)(nil), "proto.TransactionsByAddressSummary")
+}
+
+func init() { proto.RegisterFile("transactionsByAddress.proto", fileDescriptor_835191adf6b24158) }
+
+var fileDescriptor_835191adf6b24158 = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x8e, 0xda, 0x40,
+	0x10, 0x86, 0x3d, 0x87, 0xef, 0x8a, 0x39, 0x72, 0x87, 0x56, 0x27, 0x64, 0xdd, 0x25, 0x1b, 0x87,
+	0x34, 0xd6, 0x49, 0x01, 0x89, 0x3c, 0x40, 0x14, 0x10, 0x12, 0x55, 0x0a, 0x43, 0x15, 0x29, 0xc5,
+	0xda, 0xde, 0x60, 0x04, 0xf6, 0xa2, 0xf5, 0x3a, 0x81, 0x2e, 0x8f, 0x80, 0x94, 0x97, 0xc8, 0xa3,
+	0xa4, 0xa4, 0xa4, 0x0c, 0x4b, 0x93, 0x92, 0x47, 0x88, 0x58, 0x23, 0x87, 0x10, 0xae, 0x9a, 0xfd,
+	0xff, 0x99, 0xff, 0x1b, 0xcd, 0xe2, 0x83, 0x92, 0x2c, 0xcd, 0x58, 0xa8, 0xc6, 0x22, 0xcd, 0x3a,
+	0x8b, 0xf7, 0x51, 0x24, 0x79, 0x96, 0x35, 0x67, 0x52, 0x28, 0x41, 0x2e, 0x4d, 0xb9, 0x7f, 0x33,
+	0x1a, 0xab, 0x38, 0x0f, 0x9a, 0xa1, 0x48, 0x5a, 0x23, 0x31, 0x12, 0x2d, 0x63, 0x07, 0xf9, 0x67,
+	0xa3, 0x8c, 0x30, 0xaf, 0x22, 0xd5, 0x58, 0x02, 0xde, 0x0d, 0xff, 0x52, 0x4b, 0x28, 0xb9, 0xc3,
+	0xcb, 0xde, 0x4c, 0x84, 0xb1, 0x03, 0x2e, 0x78, 0xcf, 0xfc, 0x42, 0x90, 0x3a, 0x5e, 0x0d, 0xe7,
+	0x7d, 0x96, 0xc5, 0xce, 0x85, 0x0b, 0x5e, 0xd5, 0x3f, 0x28, 0xf2, 0x88, 0xb6, 0x2f, 0xa6, 0xdc,
+	0xa9, 0xb8, 0xe0, 0xdd, 0xb4, 0xeb, 0x05, 0xbc, 0x79, 0x04, 0xde, 0x77, 0x7d, 0x33, 0x43, 0x28,
+	0x62, 0x9f, 0xb3, 0x88, 0x4b, 0xc3, 0xb1, 0x0d, 0xe7, 0xc8, 0x69, 0x7c, 0xc2, 0xfb, 0xe1, 0xb9,
+	0x3b, 0xbb, 0x71, 0x9e, 0x4e, 0xc8, 0x3b, 0xac, 0x1e, 0x77, 0x1d, 0x70, 0x2b, 0xde, 0x75, 0xfb,
+	0xe1, 0xff, 0x8d, 0x65, 0xce, 0xff, 0x27, 0xd0, 0xe8, 0xe3, 0xf3, 0xb3, 0xf8, 0x41, 0x9e, 0x24,
+	0x4c, 0x2e, 0x88, 0x87, 0xb7, 0x1f, 0xf2, 0xe4, 0x64, 0x07, 0x78, 0xb6, 0x7f, 0x6a, 0x3f, 0x7e,
+	0x07, 0xbc, 0x3d, 0x39, 0x91, 0xdc, 0x20, 0xee, 0xeb, 0x80, 0xa7, 0x11, 0x97, 0x35, 0x8b, 0xd4,
+	0xb0, 0x6a, 0x4e, 0xe7, 0x21, 0x1f, 0x7f, 0xe1, 0xb2, 0x06, 0xe4, 0x15, 0xbe, 0x30, 0x13, 0x09,
+	0x93, 0xaa, 0x2b, 0x52, 0x25, 0x59, 0xa8, 0x7c, 0x9e, 0xe5, 0x53, 0x75, 0x08, 0x5d, 0x90, 0xd7,
+	0xf8, 0xf2, 0x89, 0x91, 0x92, 0x53, 0x21, 0x75, 0x24, 0x05, 0xf9, 0x2b, 0x93, 0x51, 0xe9, 0xdb,
+	0x9d, 0xde, 0x6a, 0x43, 0xad, 0xf5, 0x86, 0x5a, 0xbb, 0x0d, 0x85, 0x6f, 0x9a, 0xc2, 0x0f, 0x4d,
+	0xe1, 0xa7, 0xa6, 0xb0, 0xd2, 0x14, 0xd6, 0x9a, 0xc2, 0x2f, 0x4d, 0xe1, 0xb7, 0xa6, 0xd6, 0x4e,
+	0x53, 0x58, 0x6e, 0xa9, 0xb5, 0xda, 0x52, 0x6b, 0xbd, 0xa5, 0xd6, 0xc7, 0xeb, 0x28, 0x98, 0x0a,
+	0x31, 0xc9, 0x67, 0x7c, 0xae, 0x82, 0x2b, 0xf3, 0xa1, 0x6f, 0xff, 0x0c, 0x00, 0xe1, 0xc0, 0xf4,
+	0xea, 0x74, 0x02, 0x00, 0x00,
+}
+
+func (x TransactionRole) String() string {
+	s, ok := TransactionRole_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *TransactionByAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionByAddress)
+	if !ok {
+		that2, ok := that.(TransactionByAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	return true
+}
+func (this *TransactionsByAddressChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionsByAddressChunk)
+	if !ok {
+		that2, ok := that.(TransactionsByAddressChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TransactionsByAddressSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionsByAddressSummary)
+	if !ok {
+		that2, ok := that.(TransactionsByAddressSummary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumTransactions != that1.NumTransactions {
+		return false
+	}
+	return true
+}
+func (this *TransactionByAddress) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&dblookupext.TransactionByAddress{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionsByAddressChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.TransactionsByAddressChunk{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionsByAddressSummary) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.TransactionsByAddressSummary{")
+	s = append(s, "NumTransactions: "+fmt.Sprintf("%#v", this.NumTransactions)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTransactionsByAddress(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TransactionByAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionByAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionByAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionsByAddressChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionsByAddressChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionsByAddressChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransactionsByAddress(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionsByAddressSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionsByAddressSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionsByAddressSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumTransactions != 0 {
+		i = encodeVarintTransactionsByAddress(dAtA, i, uint64(m.NumTransactions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTransactionsByAddress(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTransactionsByAddress(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TransactionByAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTransactionsByAddress(uint64(m.Epoch))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTransactionsByAddress(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTransactionsByAddress(uint64(m.Role))
+	}
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovTransactionsByAddress(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionsByAddressChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovTransactionsByAddress(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TransactionsByAddressSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumTransactions != 0 {
+		n += 1 + sovTransactionsByAddress(uint64(m.NumTransactions))
+	}
+	return n
+}
+
+func sovTransactionsByAddress(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTransactionsByAddress(x uint64) (n int) {
+	return sovTransactionsByAddress(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TransactionByAddress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionByAddress{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionsByAddressChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*TransactionByAddress{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "TransactionByAddress", "TransactionByAddress", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&TransactionsByAddressChunk{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionsByAddressSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionsByAddressSummary{`,
+		`NumTransactions:` + fmt.Sprintf("%v", this.NumTransactions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTransactionsByAddress(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TransactionByAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransactionsByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionByAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionByAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= TransactionRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransactionsByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionsByAddressChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransactionsByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionsByAddressChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionsByAddressChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &TransactionByAddress{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransactionsByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionsByAddressSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransactionsByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionsByAddressSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionsByAddressSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTransactions", wireType)
+			}
+			m.NumTransactions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTransactions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransactionsByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransactionsByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTransactionsByAddress(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTransactionsByAddress
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTransactionsByAddress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTransactionsByAddress
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTransactionsByAddress
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTransactionsByAddress
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTransactionsByAddress        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTransactionsByAddress          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTransactionsByAddress = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,373 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. transactionsByAddress.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// The transactions of an address are stored in chunks of fixed size, so that recording a new transaction only rewrites
+// the last chunk and the summary of that address, regardless of how many transactions were indexed before
+const transactionsByAddressChunkSize = 100
+
+// The addresses indexed for a block are kept under the block hash, so that a block recorded again is skipped and a
+// reverted block can be removed from the history of its addresses
+const blockAddressesKeyPrefix = "blockAddresses_"
+
+type transactionsByAddressIndex struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+}
+
+func newTransactionsByAddressIndex(storer storage.Storer, marshalizer marshal.Marshalizer) *transactionsByAddressIndex {
+	return &transactionsByAddressIndex{
+		marshalizer: marshalizer,
+		storer:      storer,
+	}
+}
+
+// transactionsByAddressCollector groups the transactions of the miniblocks recorded for a block by the addresses involved
+type transactionsByAddressCollector struct {
+	headerHash         []byte
+	selfShardID        uint32
+	epoch              uint32
+	transactions       map[string]data.TransactionHandler
+	scrResults         map[string]data.TransactionHandler
+	transactionsByAddr map[string][]*TransactionByAddress
+}
+
+func newTransactionsByAddressCollector(
+	headerHash []byte,
+	selfShardID uint32,
+	epoch uint32,
+	transactions map[string]data.TransactionHandler,
+	scrResults map[string]data.TransactionHandler,
+) *transactionsByAddressCollector {
+	return &transactionsByAddressCollector{
+		headerHash:         headerHash,
+		selfShardID:        selfShardID,
+		epoch:              epoch,
+		transactions:       transactions,
+		scrResults:         scrResults,
+		transactionsByAddr: make(map[string][]*TransactionByAddress),
+	}
+}
+
+// addMiniblock collects the transactions of the given miniblock, on the sender side if the miniblock originates in
+// the self shard and on the receiver side if the miniblock is destined to the self shard
+// Transactions not found in the provided pools are ignored
+func (tac *transactionsByAddressCollector) addMiniblock(miniblock *block.MiniBlock) {
+	isFromMe := miniblock.SenderShardID == tac.selfShardID
+	isToMe := miniblock.ReceiverShardID == tac.selfShardID
+
+	for _, txHash := range miniblock.TxHashes {
+		switch miniblock.Type {
+		case block.TxBlock:
+			tx, ok := tac.transactions[string(txHash)]
+			if !ok {
+				continue
+			}
+			if isFromMe {
+				tac.add(tx.GetSndAddr(), txHash, RoleSender)
+			}
+			if isToMe {
+				tac.add(tx.GetRcvAddr(), txHash, RoleReceiver)
+			}
+		case block.InvalidBlock:
+			tx, ok := tac.transactions[string(txHash)]
+			if !ok || !isFromMe {
+				continue
+			}
+			tac.add(tx.GetSndAddr(), txHash, RoleSender)
+		case block.SmartContractResultBlock:
+			scr, ok := tac.scrResults[string(txHash)]
+			if !ok {
+				continue
+			}
+			if isFromMe {
+				tac.add(scr.GetSndAddr(), txHash, RoleSmartContractResultSender)
+			}
+			if isToMe {
+				tac.add(scr.GetRcvAddr(), txHash, RoleSmartContractResultReceiver)
+			}
+		case block.RewardsBlock:
+			reward, ok := tac.transactions[string(txHash)]
+			if !ok || !isToMe {
+				continue
+			}
+			tac.add(reward.GetRcvAddr(), txHash, RoleRewardReceiver)
+		}
+	}
+}
+
+func (tac *transactionsByAddressCollector) add(address []byte, txHash []byte, role TransactionRole) {
+	if len(address) == 0 {
+		return
+	}
+
+	key := string(address)
+	tac.transactionsByAddr[key] = append(tac.transactionsByAddr[key], &TransactionByAddress{
+		Epoch:      tac.epoch,
+		TxHash:     txHash,
+		Role:       role,
+		HeaderHash: tac.headerHash,
+	})
+}
+
+// saveTransactions appends the provided transactions, grouped by address, to the ones already indexed. A block that
+// was already recorded is skipped, so that a resynced block does not duplicate the history of its addresses
+func (tai *transactionsByAddressIndex) saveTransactions(headerHash []byte, transactionsByAddress map[string][]*TransactionByAddress) {
+	if len(transactionsByAddress) == 0 {
+		return
+	}
+
+	_, err := tai.storer.Get(blockAddressesKey(headerHash))
+	if err == nil {
+		log.Debug("transactionsByAddressIndex.saveTransactions() block already recorded", "headerHash", headerHash)
+		return
+	}
+
+	addresses := make([][]byte, 0, len(transactionsByAddress))
+	for address, transactions := range transactionsByAddress {
+		err = tai.appendTransactions([]byte(address), transactions)
+		if err != nil {
+			log.Warn("transactionsByAddressIndex.saveTransactions() cannot save transactions",
+				"address", []byte(address),
+				"error", err.Error())
+			continue
+		}
+
+		addresses = append(addresses, []byte(address))
+	}
+
+	err = tai.putRecord(blockAddressesKey(headerHash), batch.New(addresses...))
+	if err != nil {
+		log.Warn("transactionsByAddressIndex.saveTransactions() cannot save the block addresses",
+			"headerHash", headerHash,
+			"error", err.Error())
+	}
+}
+
+// revertBlock removes the transactions recorded for the given block from the history of its addresses. As blocks are
+// reverted from the highest one, the transactions of the block are the last ones indexed for each address
+func (tai *transactionsByAddressIndex) revertBlock(headerHash []byte) {
+	key := blockAddressesKey(headerHash)
+	rawBytes, err := tai.storer.Get(key)
+	if err != nil {
+		return
+	}
+
+	addresses := &batch.Batch{}
+	err = tai.marshalizer.Unmarshal(addresses, rawBytes)
+	if err != nil {
+		log.Warn("transactionsByAddressIndex.revertBlock() cannot unmarshal the block addresses",
+			"headerHash", headerHash,
+			"error", err.Error())
+		return
+	}
+
+	for _, address := range addresses.Data {
+		err = tai.removeLastTransactionsOfBlock(address, headerHash)
+		if err != nil {
+			log.Warn("transactionsByAddressIndex.revertBlock() cannot remove transactions",
+				"address", address,
+				"headerHash", headerHash,
+				"error", err.Error())
+		}
+	}
+
+	err = tai.storer.Remove(key)
+	if err != nil {
+		log.Warn("transactionsByAddressIndex.revertBlock() cannot remove the block addresses",
+			"headerHash", headerHash,
+			"error", err.Error())
+	}
+}
+
+func (tai *transactionsByAddressIndex) removeLastTransactionsOfBlock(address []byte, headerHash []byte) error {
+	summary := tai.getSummary(address)
+	for summary.NumTransactions > 0 {
+		chunkIndex := (summary.NumTransactions - 1) / transactionsByAddressChunkSize
+		chunk, err := tai.getChunk(address, chunkIndex)
+		if err != nil {
+			return err
+		}
+
+		numInChunk := len(chunk.Transactions)
+		for numInChunk > 0 && bytes.Equal(chunk.Transactions[numInChunk-1].HeaderHash, headerHash) {
+			numInChunk--
+		}
+		numRemoved := len(chunk.Transactions) - numInChunk
+		summary.NumTransactions -= uint64(numRemoved)
+		chunk.Transactions = chunk.Transactions[:numInChunk]
+
+		if numInChunk == 0 {
+			err = tai.storer.Remove(chunkKey(address, chunkIndex))
+			if err != nil {
+				return err
+			}
+			if numRemoved > 0 {
+				continue
+			}
+		}
+
+		if numRemoved > 0 {
+			err = tai.putChunk(address, chunkIndex, chunk)
+			if err != nil {
+				return err
+			}
+		}
+
+		break
+	}
+
+	if summary.NumTransactions == 0 {
+		return tai.storer.Remove(address)
+	}
+
+	return tai.putRecord(address, summary)
+}
+
+func (tai *transactionsByAddressIndex) appendTransactions(address []byte, transactions []*TransactionByAddress) error {
+	summary := tai.getSummary(address)
+
+	chunkIndex := summary.NumTransactions / transactionsByAddressChunkSize
+	chunk, err := tai.getChunk(address, chunkIndex)
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range transactions {
+		chunk.Transactions = append(chunk.Transactions, transaction)
+		summary.NumTransactions++
+
+		if len(chunk.Transactions) < transactionsByAddressChunkSize {
+			continue
+		}
+
+		err = tai.putChunk(address, chunkIndex, chunk)
+		if err != nil {
+			return err
+		}
+
+		chunkIndex++
+		chunk = &TransactionsByAddressChunk{}
+	}
+
+	if len(chunk.Transactions) > 0 {
+		err = tai.putChunk(address, chunkIndex, chunk)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tai.putRecord(address, summary)
+}
+
+// getTransactions returns at most limit transactions of the given address, newest first, skipping the first offset
+// ones, alongside the total number of transactions indexed for that address
+func (tai *transactionsByAddressIndex) getTransactions(address []byte, offset uint64, limit uint64) ([]*TransactionByAddress, uint64, error) {
+	summary := tai.getSummary(address)
+	total := summary.NumTransactions
+	if offset >= total || limit == 0 {
+		return make([]*TransactionByAddress, 0), total, nil
+	}
+
+	newest := total - 1 - offset
+	oldest := uint64(0)
+	if newest+1 > limit {
+		oldest = newest + 1 - limit
+	}
+
+	transactions := make([]*TransactionByAddress, 0, newest-oldest+1)
+	loadedChunkIndex := uint64(0)
+	var loadedChunk *TransactionsByAddressChunk
+	for position := newest + 1; position > oldest; position-- {
+		index := position - 1
+		chunkIndex := index / transactionsByAddressChunkSize
+		if loadedChunk == nil || chunkIndex != loadedChunkIndex {
+			chunk, err := tai.getChunk(address, chunkIndex)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			loadedChunk = chunk
+			loadedChunkIndex = chunkIndex
+		}
+
+		indexInChunk := int(index % transactionsByAddressChunkSize)
+		if indexInChunk >= len(loadedChunk.Transactions) {
+			return nil, 0, ErrTransactionsByAddressIndexCorrupted
+		}
+
+		transactions = append(transactions, loadedChunk.Transactions[indexInChunk])
+	}
+
+	return transactions, total, nil
+}
+
+func (tai *transactionsByAddressIndex) getSummary(address []byte) *TransactionsByAddressSummary {
+	summary := &TransactionsByAddressSummary{}
+	rawBytes, err := tai.storer.Get(address)
+	if err != nil {
+		return summary
+	}
+
+	err = tai.marshalizer.Unmarshal(summary, rawBytes)
+	if err != nil {
+		log.Warn("transactionsByAddressIndex.getSummary() cannot unmarshal summary",
+			"address", address,
+			"error", err.Error())
+		return &TransactionsByAddressSummary{}
+	}
+
+	return summary
+}
+
+func (tai *transactionsByAddressIndex) getChunk(address []byte, chunkIndex uint64) (*TransactionsByAddressChunk, error) {
+	chunk := &TransactionsByAddressChunk{}
+	rawBytes, err := tai.storer.Get(chunkKey(address, chunkIndex))
+	if err != nil {
+		// chunk not yet created
+		return chunk, nil
+	}
+
+	err = tai.marshalizer.Unmarshal(chunk, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return chunk, nil
+}
+
+func (tai *transactionsByAddressIndex) putChunk(address []byte, chunkIndex uint64, chunk *TransactionsByAddressChunk) error {
+	return tai.putRecord(chunkKey(address, chunkIndex), chunk)
+}
+
+func (tai *transactionsByAddressIndex) putRecord(key []byte, record interface{}) error {
+	rawBytes, err := tai.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return tai.storer.Put(key, rawBytes)
+}
+
+func blockAddressesKey(headerHash []byte) []byte {
+	return append([]byte(blockAddressesKeyPrefix), headerHash...)
+}
+
+func chunkKey(address []byte, chunkIndex uint64) []byte {
+	key := make([]byte, len(address)+8)
+	copy(key, address)
+	binary.BigEndian.PutUint64(key[len(address):], chunkIndex)
+
+	return key
+}
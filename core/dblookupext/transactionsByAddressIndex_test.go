@@ -0,0 +1,214 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransactionsByAddress(epoch uint32, startIndex int, numTransactions int) []*TransactionByAddress {
+	transactions := make([]*TransactionByAddress, 0, numTransactions)
+	for i := startIndex; i < startIndex+numTransactions; i++ {
+		transactions = append(transactions, &TransactionByAddress{
+			Epoch:  epoch,
+			TxHash: []byte(fmt.Sprintf("tx%d", i)),
+			Role:   RoleSender,
+		})
+	}
+
+	return transactions
+}
+
+func TestTransactionsByAddressIndex_GetTransactionsForUnknownAddress(t *testing.T) {
+	t.Parallel()
+
+	index := newTransactionsByAddressIndex(genericMocks.NewStorerMock("TransactionsByAddress", 0), &mock.MarshalizerMock{})
+
+	transactions, total, err := index.getTransactions([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+	require.Equal(t, 0, len(transactions))
+}
+
+func TestTransactionsByAddressIndex_SaveAndGetTransactionsAcrossChunks(t *testing.T) {
+	t.Parallel()
+
+	storer := genericMocks.NewStorerMock("TransactionsByAddress", 0)
+	index := newTransactionsByAddressIndex(storer, &mock.MarshalizerMock{})
+
+	// append in several rounds, so that chunks get both filled and partially filled
+	numTransactions := 0
+	for i, batchSize := range []int{30, 150, 1, 69, 1} {
+		index.saveTransactions([]byte(fmt.Sprintf("block%d", i)), map[string][]*TransactionByAddress{
+			"alice": createTransactionsByAddress(1, numTransactions, batchSize),
+			"bob":   createTransactionsByAddress(2, numTransactions, 1),
+		})
+		numTransactions += batchSize
+	}
+
+	// 3 chunks and the summary for alice, 1 chunk and the summary for bob, the addresses of the 5 blocks
+	require.Equal(t, 11, storer.GetCurrentEpochData().Len())
+
+	transactions, total, err := index.getTransactions([]byte("alice"), 0, 5)
+	require.Nil(t, err)
+	require.Equal(t, uint64(numTransactions), total)
+	require.Equal(t, 5, len(transactions))
+	require.Equal(t, []byte("tx250"), transactions[0].TxHash)
+	require.Equal(t, []byte("tx246"), transactions[4].TxHash)
+
+	// a page spanning over two chunks
+	transactions, _, err = index.getTransactions([]byte("alice"), 145, 10)
+	require.Nil(t, err)
+	require.Equal(t, 10, len(transactions))
+	for i, tx := range transactions {
+		require.Equal(t, []byte(fmt.Sprintf("tx%d", 105-i)), tx.TxHash)
+		require.Equal(t, uint32(1), tx.Epoch)
+	}
+
+	// the last page is truncated
+	transactions, _, err = index.getTransactions([]byte("alice"), 248, 10)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(transactions))
+	require.Equal(t, []byte("tx0"), transactions[2].TxHash)
+
+	transactions, _, err = index.getTransactions([]byte("alice"), 251, 10)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(transactions))
+
+	transactions, total, err = index.getTransactions([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(5), total)
+	require.Equal(t, 5, len(transactions))
+}
+
+func TestTransactionsByAddressIndex_SummaryNotMatchingChunksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storer := genericMocks.NewStorerMock("TransactionsByAddress", 0)
+	index := newTransactionsByAddressIndex(storer, &mock.MarshalizerMock{})
+	index.saveTransactions([]byte("block"), map[string][]*TransactionByAddress{
+		"alice": createTransactionsByAddress(1, 0, 3),
+	})
+
+	// the summary claims more transactions than the ones stored in the chunk
+	err := index.putRecord([]byte("alice"), &TransactionsByAddressSummary{NumTransactions: 5})
+	require.Nil(t, err)
+
+	transactions, _, err := index.getTransactions([]byte("alice"), 0, 10)
+	require.Nil(t, transactions)
+	require.Equal(t, ErrTransactionsByAddressIndexCorrupted, err)
+}
+
+func createTransactionsOfBlock(headerHash []byte, startIndex int, numTransactions int) []*TransactionByAddress {
+	transactions := createTransactionsByAddress(1, startIndex, numTransactions)
+	for _, tx := range transactions {
+		tx.HeaderHash = headerHash
+	}
+
+	return transactions
+}
+
+func TestTransactionsByAddressIndex_SaveSameBlockTwiceShouldNotDuplicate(t *testing.T) {
+	t.Parallel()
+
+	index := newTransactionsByAddressIndex(genericMocks.NewStorerMock("TransactionsByAddress", 0), &mock.MarshalizerMock{})
+	headerHash := []byte("block")
+	transactionsOfBlock := map[string][]*TransactionByAddress{
+		"alice": createTransactionsOfBlock(headerHash, 0, 3),
+	}
+
+	index.saveTransactions(headerHash, transactionsOfBlock)
+	index.saveTransactions(headerHash, transactionsOfBlock)
+
+	transactions, total, err := index.getTransactions([]byte("alice"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(3), total)
+	require.Equal(t, 3, len(transactions))
+}
+
+func TestTransactionsByAddressIndex_RevertBlockShouldRemoveItsTransactions(t *testing.T) {
+	t.Parallel()
+
+	storer := genericMocks.NewStorerMock("TransactionsByAddress", 0)
+	index := newTransactionsByAddressIndex(storer, &mock.MarshalizerMock{})
+
+	index.saveTransactions([]byte("block1"), map[string][]*TransactionByAddress{
+		"alice": createTransactionsOfBlock([]byte("block1"), 0, 90),
+	})
+	// the transactions of the second block span over two chunks
+	index.saveTransactions([]byte("block2"), map[string][]*TransactionByAddress{
+		"alice": createTransactionsOfBlock([]byte("block2"), 90, 20),
+		"bob":   createTransactionsOfBlock([]byte("block2"), 0, 2),
+	})
+
+	index.revertBlock([]byte("block2"))
+
+	transactions, total, err := index.getTransactions([]byte("alice"), 0, 200)
+	require.Nil(t, err)
+	require.Equal(t, uint64(90), total)
+	require.Equal(t, []byte("tx89"), transactions[0].TxHash)
+
+	_, total, err = index.getTransactions([]byte("bob"), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), total)
+
+	// 1 chunk and the summary for alice, the addresses of the first block
+	require.Equal(t, 3, storer.GetCurrentEpochData().Len())
+
+	// the reverted block is recorded again when committed back
+	index.saveTransactions([]byte("block2"), map[string][]*TransactionByAddress{
+		"alice": createTransactionsOfBlock([]byte("block2"), 90, 20),
+	})
+	_, total, err = index.getTransactions([]byte("alice"), 0, 200)
+	require.Nil(t, err)
+	require.Equal(t, uint64(110), total)
+}
+
+func TestTransactionsByAddressCollector_AddMiniblock(t *testing.T) {
+	t.Parallel()
+
+	transactions := map[string]data.TransactionHandler{
+		"txIntra":   &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"txCross":   &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("carol")},
+		"txInbound": &transaction.Transaction{SndAddr: []byte("dave"), RcvAddr: []byte("bob")},
+		"txInvalid": &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"reward":    &rewardTx.RewardTx{RcvAddr: []byte("bob")},
+	}
+	scrResults := map[string]data.TransactionHandler{
+		"scr": &smartContractResult.SmartContractResult{SndAddr: []byte("contract"), RcvAddr: []byte("carol")},
+	}
+
+	headerHash := []byte("header hash")
+	collector := newTransactionsByAddressCollector(headerHash, 0, 7, transactions, scrResults)
+	collector.addMiniblock(&block.MiniBlock{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txIntra"), []byte("missing")}})
+	collector.addMiniblock(&block.MiniBlock{Type: block.TxBlock, ReceiverShardID: 1, TxHashes: [][]byte{[]byte("txCross")}})
+	collector.addMiniblock(&block.MiniBlock{Type: block.TxBlock, SenderShardID: 2, TxHashes: [][]byte{[]byte("txInbound")}})
+	collector.addMiniblock(&block.MiniBlock{Type: block.InvalidBlock, TxHashes: [][]byte{[]byte("txInvalid")}})
+	collector.addMiniblock(&block.MiniBlock{Type: block.SmartContractResultBlock, SenderShardID: 1, TxHashes: [][]byte{[]byte("scr")}})
+	collector.addMiniblock(&block.MiniBlock{Type: block.RewardsBlock, SenderShardID: 1, TxHashes: [][]byte{[]byte("reward")}})
+
+	expected := map[string][]*TransactionByAddress{
+		"alice": {
+			{Epoch: 7, TxHash: []byte("txIntra"), Role: RoleSender, HeaderHash: headerHash},
+			{Epoch: 7, TxHash: []byte("txCross"), Role: RoleSender, HeaderHash: headerHash},
+			{Epoch: 7, TxHash: []byte("txInvalid"), Role: RoleSender, HeaderHash: headerHash},
+		},
+		"bob": {
+			{Epoch: 7, TxHash: []byte("txIntra"), Role: RoleReceiver, HeaderHash: headerHash},
+			{Epoch: 7, TxHash: []byte("txInbound"), Role: RoleReceiver, HeaderHash: headerHash},
+			{Epoch: 7, TxHash: []byte("reward"), Role: RoleRewardReceiver, HeaderHash: headerHash},
+		},
+		"carol": {
+			{Epoch: 7, TxHash: []byte("scr"), Role: RoleSmartContractResultReceiver, HeaderHash: headerHash},
+		},
+	}
+	require.Equal(t, expected, collector.transactionsByAddr)
+}
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// TxLogsGetterStub -
+type TxLogsGetterStub struct {
+	GetLogCalled func(txHash []byte) (data.LogHandler, error)
+}
+
+// GetLog -
+func (tlgs *TxLogsGetterStub) GetLog(txHash []byte) (data.LogHandler, error) {
+	if tlgs.GetLogCalled != nil {
+		return tlgs.GetLogCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (tlgs *TxLogsGetterStub) IsInterfaceNil() bool {
+	return tlgs == nil
+}
@@ -0,0 +1,38 @@
+package subscriptions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+type disabledEventsHub struct {
+}
+
+// NewDisabledEventsHub returns an events hub that ignores the committed blocks and does not accept subscribers
+func NewDisabledEventsHub() *disabledEventsHub {
+	return &disabledEventsHub{}
+}
+
+// HasSubscribers returns false
+func (deh *disabledEventsHub) HasSubscribers() bool {
+	return false
+}
+
+// NotifyCommittedBlock does nothing
+func (deh *disabledEventsHub) NotifyCommittedBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _ map[string]data.TransactionHandler, _ uint64) {
+}
+
+// CreateSubscriber returns ErrEventsSubscriptionsDisabled
+func (deh *disabledEventsHub) CreateSubscriber() (api.EventsSubscriber, error) {
+	return nil, ErrEventsSubscriptionsDisabled
+}
+
+// Close does nothing
+func (deh *disabledEventsHub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (deh *disabledEventsHub) IsInterfaceNil() bool {
+	return deh == nil
+}
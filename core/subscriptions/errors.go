@@ -0,0 +1,41 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilTransactionLogsGetter signals that a nil transaction logs getter has been provided
+var ErrNilTransactionLogsGetter = errors.New("nil transaction logs getter")
+
+// ErrInvalidMaxSubscriptionsPerConnection signals that an invalid maximum number of subscriptions per connection
+// has been provided
+var ErrInvalidMaxSubscriptionsPerConnection = errors.New("invalid max subscriptions per connection")
+
+// ErrInvalidEventsQueueSize signals that an invalid events queue size has been provided
+var ErrInvalidEventsQueueSize = errors.New("invalid events queue size")
+
+// ErrUnknownTopic signals that a subscription was requested for an unknown topic
+var ErrUnknownTopic = errors.New("unknown topic")
+
+// ErrMissingAddress signals that a subscription requiring an address was requested without one
+var ErrMissingAddress = errors.New("missing address")
+
+// ErrTooManySubscriptions signals that the maximum number of subscriptions of a connection was reached
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
+
+// ErrSubscriptionNotFound signals that the provided subscription ID does not exist
+var ErrSubscriptionNotFound = errors.New("subscription not found")
+
+// ErrSubscriberClosed signals that the subscriber is closed
+var ErrSubscriberClosed = errors.New("subscriber is closed")
+
+// ErrSubscriberTooSlow signals that the subscriber was dropped because it did not consume the events fast enough
+var ErrSubscriberTooSlow = errors.New("subscriber too slow, events queue is full")
+
+// ErrEventsHubOverloaded signals that the subscriber was dropped because the hub could not keep up with the committed
+// blocks
+var ErrEventsHubOverloaded = errors.New("events hub overloaded, committed blocks queue is full")
+
+// ErrEventsSubscriptionsDisabled signals that the events subscriptions are not enabled on this node
+var ErrEventsSubscriptionsDisabled = errors.New("events subscriptions are not enabled")
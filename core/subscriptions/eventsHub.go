@@ -0,0 +1,465 @@
+package subscriptions
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+var log = logger.GetOrCreate("core/subscriptions")
+
+const committedBlocksQueueSize = 100
+
+// ArgsEventsHub holds the arguments needed to create an events hub
+type ArgsEventsHub struct {
+	PubkeyConverter               core.PubkeyConverter
+	TxLogsGetter                  TransactionLogsGetter
+	MaxSubscriptionsPerConnection int
+	EventsQueueSize               int
+}
+
+type pendingTransaction struct {
+	event    *api.TransactionEvent
+	sender   []byte
+	receiver []byte
+}
+
+type pendingLogEvent struct {
+	event   *api.LogEvent
+	address []byte
+}
+
+// committedBlock holds a committed block notification waiting to be turned into events
+type committedBlock struct {
+	headerHash             []byte
+	header                 data.HeaderHandler
+	body                   data.BodyHandler
+	transactions           map[string]data.TransactionHandler
+	highestFinalBlockNonce uint64
+}
+
+// pendingBlock holds the events of a committed block that are pushed only after the block becomes final
+type pendingBlock struct {
+	nonce        uint64
+	transactions []*pendingTransaction
+	logEvents    []*pendingLogEvent
+}
+
+type eventsHub struct {
+	pubkeyConverter               core.PubkeyConverter
+	txLogsGetter                  TransactionLogsGetter
+	maxSubscriptionsPerConnection int
+	eventsQueueSize               int
+
+	mutSubscribers   sync.RWMutex
+	subscribers      map[uint64]*subscriber
+	lastSubscriberID uint64
+
+	mutPendingBlocks sync.Mutex
+	pendingBlocks    []*pendingBlock
+
+	committedBlocks chan *committedBlock
+	cancelFunc      context.CancelFunc
+}
+
+// NewEventsHub creates a new events hub. Block events are pushed as soon as the block is committed, while the
+// transactions and logs events are pushed once the block that contains them becomes final. The events are built on
+// the hub's own go routine, so that fetching the transactions logs does not delay the block commit
+func NewEventsHub(args ArgsEventsHub) (*eventsHub, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.TxLogsGetter) {
+		return nil, ErrNilTransactionLogsGetter
+	}
+	if args.MaxSubscriptionsPerConnection < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidMaxSubscriptionsPerConnection, args.MaxSubscriptionsPerConnection)
+	}
+	if args.EventsQueueSize < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidEventsQueueSize, args.EventsQueueSize)
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	eh := &eventsHub{
+		pubkeyConverter:               args.PubkeyConverter,
+		txLogsGetter:                  args.TxLogsGetter,
+		maxSubscriptionsPerConnection: args.MaxSubscriptionsPerConnection,
+		eventsQueueSize:               args.EventsQueueSize,
+		subscribers:                   make(map[uint64]*subscriber),
+		pendingBlocks:                 make([]*pendingBlock, 0),
+		committedBlocks:               make(chan *committedBlock, committedBlocksQueueSize),
+		cancelFunc:                    cancelFunc,
+	}
+
+	go eh.processCommittedBlocks(ctx)
+
+	return eh, nil
+}
+
+// CreateSubscriber creates and registers a new subscriber
+func (eh *eventsHub) CreateSubscriber() (api.EventsSubscriber, error) {
+	eh.mutSubscribers.Lock()
+	defer eh.mutSubscribers.Unlock()
+
+	eh.lastSubscriberID++
+	s := newSubscriber(eh.lastSubscriberID, eh)
+	eh.subscribers[s.id] = s
+
+	return s, nil
+}
+
+// HasSubscribers returns true if at least one subscriber is connected
+func (eh *eventsHub) HasSubscribers() bool {
+	eh.mutSubscribers.RLock()
+	defer eh.mutSubscribers.RUnlock()
+
+	return len(eh.subscribers) > 0
+}
+
+// NotifyCommittedBlock queues the committed block without blocking. If the queue is full, all the subscribers are
+// dropped as they would otherwise miss events
+func (eh *eventsHub) NotifyCommittedBlock(
+	headerHash []byte,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+	transactions map[string]data.TransactionHandler,
+	highestFinalBlockNonce uint64,
+) {
+	if check.IfNil(header) {
+		return
+	}
+
+	select {
+	case eh.committedBlocks <- &committedBlock{
+		headerHash:             headerHash,
+		header:                 header,
+		body:                   body,
+		transactions:           transactions,
+		highestFinalBlockNonce: highestFinalBlockNonce,
+	}:
+	default:
+		log.Warn("eventsHub: committed blocks queue is full, dropping all subscribers", "nonce", header.GetNonce())
+		eh.dropAllSubscribers(ErrEventsHubOverloaded)
+	}
+}
+
+func (eh *eventsHub) processCommittedBlocks(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("eventsHub: closing the committed blocks processing go routine")
+			return
+		case cb := <-eh.committedBlocks:
+			eh.processCommittedBlock(cb)
+		}
+	}
+}
+
+// processCommittedBlock pushes the block event to the interested subscribers and queues the transactions and logs
+// events of the block until the block becomes final. The queued events of the blocks with a nonce higher or equal to
+// the committed one are discarded as those blocks were reverted
+func (eh *eventsHub) processCommittedBlock(cb *committedBlock) {
+	subscribers := eh.getSubscribers()
+	if len(subscribers) == 0 {
+		eh.resetPendingBlocks()
+		return
+	}
+
+	eh.dispatchBlockEvent(subscribers, cb.headerHash, cb.header)
+
+	newPendingBlock := eh.createPendingBlock(subscribers, cb.headerHash, cb.header, cb.body, cb.transactions)
+	finalBlocks := eh.addPendingBlockAndExtractFinal(newPendingBlock, cb.highestFinalBlockNonce)
+	for _, finalBlock := range finalBlocks {
+		eh.dispatchPendingBlock(subscribers, finalBlock)
+	}
+}
+
+func (eh *eventsHub) createSubscription(eventsSubscription api.EventsSubscription) (*subscription, error) {
+	newSubscription := &subscription{
+		EventsSubscription: eventsSubscription,
+	}
+
+	switch eventsSubscription.Topic {
+	case api.EventsTopicBlocks:
+		return newSubscription, nil
+	case api.EventsTopicTransactions:
+		if len(eventsSubscription.Address) == 0 {
+			return nil, ErrMissingAddress
+		}
+	case api.EventsTopicLogs:
+		if len(eventsSubscription.Address) == 0 {
+			return newSubscription, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTopic, eventsSubscription.Topic)
+	}
+
+	addressBytes, err := eh.pubkeyConverter.Decode(eventsSubscription.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	newSubscription.addressBytes = addressBytes
+
+	return newSubscription, nil
+}
+
+func (eh *eventsHub) getSubscribers() []*subscriber {
+	eh.mutSubscribers.RLock()
+	defer eh.mutSubscribers.RUnlock()
+
+	subscribers := make([]*subscriber, 0, len(eh.subscribers))
+	for _, s := range eh.subscribers {
+		subscribers = append(subscribers, s)
+	}
+
+	return subscribers
+}
+
+// removeSubscriber unregisters the subscriber. The pending blocks are discarded once the last subscriber is gone, as
+// the committed blocks are no longer tracked without subscribers
+func (eh *eventsHub) removeSubscriber(id uint64) {
+	eh.mutSubscribers.Lock()
+	delete(eh.subscribers, id)
+	hasSubscribers := len(eh.subscribers) > 0
+	eh.mutSubscribers.Unlock()
+
+	if !hasSubscribers {
+		eh.resetPendingBlocks()
+	}
+}
+
+func (eh *eventsHub) dropAllSubscribers(err error) {
+	for _, s := range eh.getSubscribers() {
+		eh.removeSubscriber(s.id)
+		s.closeWithError(err)
+	}
+}
+
+func (eh *eventsHub) resetPendingBlocks() {
+	eh.mutPendingBlocks.Lock()
+	eh.pendingBlocks = make([]*pendingBlock, 0)
+	eh.mutPendingBlocks.Unlock()
+}
+
+func (eh *eventsHub) addPendingBlockAndExtractFinal(newPendingBlock *pendingBlock, highestFinalBlockNonce uint64) []*pendingBlock {
+	eh.mutPendingBlocks.Lock()
+	defer eh.mutPendingBlocks.Unlock()
+
+	notFinal := make([]*pendingBlock, 0, len(eh.pendingBlocks)+1)
+	final := make([]*pendingBlock, 0)
+	for _, pb := range append(eh.pendingBlocks, newPendingBlock) {
+		isReverted := pb != newPendingBlock && pb.nonce >= newPendingBlock.nonce
+		if isReverted {
+			continue
+		}
+
+		if pb.nonce <= highestFinalBlockNonce {
+			final = append(final, pb)
+			continue
+		}
+
+		notFinal = append(notFinal, pb)
+	}
+
+	eh.pendingBlocks = notFinal
+
+	return final
+}
+
+func (eh *eventsHub) createPendingBlock(
+	subscribers []*subscriber,
+	headerHash []byte,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+	transactions map[string]data.TransactionHandler,
+) *pendingBlock {
+	newPendingBlock := &pendingBlock{
+		nonce:        header.GetNonce(),
+		transactions: make([]*pendingTransaction, 0),
+		logEvents:    make([]*pendingLogEvent, 0),
+	}
+
+	blockBody, ok := body.(*block.Body)
+	if !ok {
+		return newPendingBlock
+	}
+
+	blockHash := hex.EncodeToString(headerHash)
+	if anySubscriberHasTopic(subscribers, api.EventsTopicTransactions) {
+		newPendingBlock.transactions = eh.createTransactionsEvents(blockBody, transactions, header.GetNonce(), blockHash)
+	}
+	if anySubscriberHasTopic(subscribers, api.EventsTopicLogs) {
+		newPendingBlock.logEvents = eh.createLogEvents(blockBody, header, blockHash)
+	}
+
+	return newPendingBlock
+}
+
+func (eh *eventsHub) createTransactionsEvents(
+	blockBody *block.Body,
+	transactions map[string]data.TransactionHandler,
+	blockNonce uint64,
+	blockHash string,
+) []*pendingTransaction {
+	pendingTransactions := make([]*pendingTransaction, 0)
+	for _, miniBlock := range blockBody.MiniBlocks {
+		for _, txHash := range miniBlock.TxHashes {
+			tx, ok := transactions[string(txHash)]
+			if !ok {
+				continue
+			}
+
+			pendingTransactions = append(pendingTransactions, &pendingTransaction{
+				event: &api.TransactionEvent{
+					Hash:             hex.EncodeToString(txHash),
+					Type:             miniBlock.Type.String(),
+					Sender:           eh.pubkeyConverter.Encode(tx.GetSndAddr()),
+					Receiver:         eh.pubkeyConverter.Encode(tx.GetRcvAddr()),
+					SourceShard:      miniBlock.SenderShardID,
+					DestinationShard: miniBlock.ReceiverShardID,
+					BlockNonce:       blockNonce,
+					BlockHash:        blockHash,
+				},
+				sender:   tx.GetSndAddr(),
+				receiver: tx.GetRcvAddr(),
+			})
+		}
+	}
+
+	return pendingTransactions
+}
+
+// createLogEvents fetches the logs of the transactions and smart contract results executed in the block's shard
+func (eh *eventsHub) createLogEvents(blockBody *block.Body, header data.HeaderHandler, blockHash string) []*pendingLogEvent {
+	logEvents := make([]*pendingLogEvent, 0)
+	processedHashes := make(map[string]struct{})
+	for _, miniBlock := range blockBody.MiniBlocks {
+		isExecutedInShard := miniBlock.ReceiverShardID == header.GetShardID()
+		hasLogs := miniBlock.Type == block.TxBlock || miniBlock.Type == block.SmartContractResultBlock
+		if !isExecutedInShard || !hasLogs {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			_, processed := processedHashes[string(txHash)]
+			if processed {
+				continue
+			}
+			processedHashes[string(txHash)] = struct{}{}
+
+			txLog, err := eh.txLogsGetter.GetLog(txHash)
+			if err != nil || check.IfNil(txLog) {
+				continue
+			}
+
+			for _, event := range txLog.GetLogEvents() {
+				if check.IfNil(event) {
+					continue
+				}
+
+				logEvents = append(logEvents, &pendingLogEvent{
+					event: &api.LogEvent{
+						TxHash:     hex.EncodeToString(txHash),
+						Address:    eh.pubkeyConverter.Encode(event.GetAddress()),
+						Identifier: string(event.GetIdentifier()),
+						Topics:     event.GetTopics(),
+						Data:       event.GetData(),
+						BlockNonce: header.GetNonce(),
+						BlockHash:  blockHash,
+					},
+					address: event.GetAddress(),
+				})
+			}
+		}
+	}
+
+	return logEvents
+}
+
+func (eh *eventsHub) dispatchBlockEvent(subscribers []*subscriber, headerHash []byte, header data.HeaderHandler) {
+	blockEvent := &api.BlockEvent{
+		Nonce:         header.GetNonce(),
+		Round:         header.GetRound(),
+		Epoch:         header.GetEpoch(),
+		Shard:         header.GetShardID(),
+		Hash:          hex.EncodeToString(headerHash),
+		PrevBlockHash: hex.EncodeToString(header.GetPrevHash()),
+		NumTxs:        header.GetTxCount(),
+		Timestamp:     time.Duration(header.GetTimeStamp()),
+	}
+
+	for _, s := range subscribers {
+		ids := s.matchingSubscriptions(api.EventsTopicBlocks, func(sub *subscription) bool {
+			return sub.ShardID == nil || *sub.ShardID == blockEvent.Shard
+		})
+		for _, id := range ids {
+			eh.push(s, &api.Event{SubscriptionID: id, Topic: api.EventsTopicBlocks, Block: blockEvent})
+		}
+	}
+}
+
+func (eh *eventsHub) dispatchPendingBlock(subscribers []*subscriber, finalBlock *pendingBlock) {
+	for _, s := range subscribers {
+		for _, tx := range finalBlock.transactions {
+			ids := s.matchingSubscriptions(api.EventsTopicTransactions, func(sub *subscription) bool {
+				return bytes.Equal(sub.addressBytes, tx.sender) || bytes.Equal(sub.addressBytes, tx.receiver)
+			})
+			for _, id := range ids {
+				eh.push(s, &api.Event{SubscriptionID: id, Topic: api.EventsTopicTransactions, Transaction: tx.event})
+			}
+		}
+
+		for _, logEvent := range finalBlock.logEvents {
+			ids := s.matchingSubscriptions(api.EventsTopicLogs, func(sub *subscription) bool {
+				isAddressMatching := len(sub.addressBytes) == 0 || bytes.Equal(sub.addressBytes, logEvent.address)
+				isIdentifierMatching := len(sub.Identifier) == 0 || sub.Identifier == logEvent.event.Identifier
+				return isAddressMatching && isIdentifierMatching
+			})
+			for _, id := range ids {
+				eh.push(s, &api.Event{SubscriptionID: id, Topic: api.EventsTopicLogs, Log: logEvent.event})
+			}
+		}
+	}
+}
+
+func (eh *eventsHub) push(s *subscriber, event *api.Event) {
+	if s.push(event) {
+		return
+	}
+
+	eh.removeSubscriber(s.id)
+	log.Debug("eventsHub: subscriber dropped", "subscriber", s.id, "reason", s.Err())
+}
+
+func anySubscriberHasTopic(subscribers []*subscriber, topic string) bool {
+	for _, s := range subscribers {
+		if s.hasTopic(topic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Close stops the committed blocks processing and drops all the subscribers
+func (eh *eventsHub) Close() error {
+	eh.cancelFunc()
+	eh.dropAllSubscribers(ErrSubscriberClosed)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eh *eventsHub) IsInterfaceNil() bool {
+	return eh == nil
+}
@@ -0,0 +1,371 @@
+package subscriptions_test
+
+import (
+	"bytes"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/mock"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	alice    = bytes.Repeat([]byte{1}, 32)
+	bob      = bytes.Repeat([]byte{2}, 32)
+	contract = bytes.Repeat([]byte{3}, 32)
+)
+
+func createMockArgsEventsHub() subscriptions.ArgsEventsHub {
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(32)
+
+	return subscriptions.ArgsEventsHub{
+		PubkeyConverter:               converter,
+		TxLogsGetter:                  &mock.TxLogsGetterStub{},
+		MaxSubscriptionsPerConnection: 5,
+		EventsQueueSize:               10,
+	}
+}
+
+func createBlockWithTransfer(nonce uint64) (*block.Header, *block.Body, map[string]data.TransactionHandler) {
+	header := &block.Header{Nonce: nonce, ShardID: 0, TxCount: 1}
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txHash")}, SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock},
+		},
+	}
+	transactions := map[string]data.TransactionHandler{
+		"txHash": &transaction.Transaction{SndAddr: alice, RcvAddr: contract},
+	}
+
+	return header, body, transactions
+}
+
+const waitForEventsTimeout = time.Millisecond * 200
+
+// readEvents collects the events until the subscriber is closed or no event is received for a while, as the
+// committed blocks are processed on the hub's go routine
+func readEvents(subscriber api.EventsSubscriber) []*api.Event {
+	events := make([]*api.Event, 0)
+	for {
+		select {
+		case event, ok := <-subscriber.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		case <-time.After(waitForEventsTimeout):
+			return events
+		}
+	}
+}
+
+func TestNewEventsHub_InvalidArgsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.PubkeyConverter = nil
+	hub, err := subscriptions.NewEventsHub(args)
+	assert.True(t, check.IfNil(hub))
+	assert.Equal(t, subscriptions.ErrNilPubkeyConverter, err)
+
+	args = createMockArgsEventsHub()
+	args.TxLogsGetter = nil
+	hub, err = subscriptions.NewEventsHub(args)
+	assert.True(t, check.IfNil(hub))
+	assert.Equal(t, subscriptions.ErrNilTransactionLogsGetter, err)
+
+	args = createMockArgsEventsHub()
+	args.MaxSubscriptionsPerConnection = 0
+	hub, err = subscriptions.NewEventsHub(args)
+	assert.True(t, check.IfNil(hub))
+	assert.True(t, errors.Is(err, subscriptions.ErrInvalidMaxSubscriptionsPerConnection))
+
+	args = createMockArgsEventsHub()
+	args.EventsQueueSize = 0
+	hub, err = subscriptions.NewEventsHub(args)
+	assert.True(t, check.IfNil(hub))
+	assert.True(t, errors.Is(err, subscriptions.ErrInvalidEventsQueueSize))
+}
+
+func TestEventsHub_SubscribeInvalidSubscriptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.MaxSubscriptionsPerConnection = 1
+	hub, _ := subscriptions.NewEventsHub(args)
+	subscriber, _ := hub.CreateSubscriber()
+
+	_, err := subscriber.Subscribe(api.EventsSubscription{Topic: "unknown"})
+	assert.True(t, errors.Is(err, subscriptions.ErrUnknownTopic))
+
+	_, err = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicTransactions})
+	assert.Equal(t, subscriptions.ErrMissingAddress, err)
+
+	_, err = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicTransactions, Address: "not hex"})
+	assert.NotNil(t, err)
+
+	_, err = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+	assert.Nil(t, err)
+
+	_, err = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+	assert.True(t, errors.Is(err, subscriptions.ErrTooManySubscriptions))
+
+	err = subscriber.Unsubscribe(37)
+	assert.True(t, errors.Is(err, subscriptions.ErrSubscriptionNotFound))
+}
+
+func TestEventsHub_NotifyCommittedBlockShouldPushBlockEventsFilteredByShard(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewEventsHub(createMockArgsEventsHub())
+	subscriber, _ := hub.CreateSubscriber()
+
+	shard0 := uint32(0)
+	shard1 := uint32(1)
+	idAllShards, _ := subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+	idShard0, _ := subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks, ShardID: &shard0})
+	_, _ = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks, ShardID: &shard1})
+
+	header, body, transactions := createBlockWithTransfer(7)
+	hub.NotifyCommittedBlock([]byte("hash"), header, body, transactions, 0)
+
+	events := readEvents(subscriber)
+	require.Equal(t, 2, len(events))
+	ids := map[uint64]bool{events[0].SubscriptionID: true, events[1].SubscriptionID: true}
+	assert.Equal(t, map[uint64]bool{idAllShards: true, idShard0: true}, ids)
+	assert.Equal(t, uint64(7), events[0].Block.Nonce)
+	assert.Equal(t, "68617368", events[0].Block.Hash)
+}
+
+func TestEventsHub_TransactionsShouldBePushedWhenFinal(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	hub, _ := subscriptions.NewEventsHub(args)
+	subscriber, _ := hub.CreateSubscriber()
+
+	id, err := subscriber.Subscribe(api.EventsSubscription{
+		Topic:   api.EventsTopicTransactions,
+		Address: args.PubkeyConverter.Encode(alice),
+	})
+	require.Nil(t, err)
+	_, _ = subscriber.Subscribe(api.EventsSubscription{
+		Topic:   api.EventsTopicTransactions,
+		Address: args.PubkeyConverter.Encode(bob),
+	})
+
+	header, body, transactions := createBlockWithTransfer(10)
+	hub.NotifyCommittedBlock([]byte("hash10"), header, body, transactions, 9)
+	assert.Equal(t, 0, len(readEvents(subscriber)))
+
+	hub.NotifyCommittedBlock([]byte("hash11"), &block.Header{Nonce: 11}, &block.Body{}, nil, 10)
+	events := readEvents(subscriber)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, id, events[0].SubscriptionID)
+	assert.Equal(t, api.EventsTopicTransactions, events[0].Topic)
+	assert.Equal(t, "747848617368", events[0].Transaction.Hash)
+	assert.Equal(t, args.PubkeyConverter.Encode(contract), events[0].Transaction.Receiver)
+	assert.Equal(t, uint64(10), events[0].Transaction.BlockNonce)
+	assert.Equal(t, block.TxBlock.String(), events[0].Transaction.Type)
+}
+
+func TestEventsHub_RevertedBlocksShouldNotBePushed(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	hub, _ := subscriptions.NewEventsHub(args)
+	subscriber, _ := hub.CreateSubscriber()
+	_, _ = subscriber.Subscribe(api.EventsSubscription{
+		Topic:   api.EventsTopicTransactions,
+		Address: args.PubkeyConverter.Encode(alice),
+	})
+
+	header, body, transactions := createBlockWithTransfer(10)
+	hub.NotifyCommittedBlock([]byte("hash10"), header, body, transactions, 9)
+	// a different block with the same nonce is committed after a rollback
+	hub.NotifyCommittedBlock([]byte("hash10bis"), &block.Header{Nonce: 10}, &block.Body{}, nil, 9)
+	hub.NotifyCommittedBlock([]byte("hash11"), &block.Header{Nonce: 11}, &block.Body{}, nil, 11)
+
+	assert.Equal(t, 0, len(readEvents(subscriber)))
+}
+
+func TestEventsHub_LogsShouldBeFilteredByAddressAndIdentifier(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	numGetLogCalls := int32(0)
+	args.TxLogsGetter = &mock.TxLogsGetterStub{
+		GetLogCalled: func(txHash []byte) (data.LogHandler, error) {
+			atomic.AddInt32(&numGetLogCalls, 1)
+			return &transaction.Log{
+				Address: contract,
+				Events: []*transaction.Event{
+					{Address: contract, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+					{Address: contract, Identifier: []byte("burn")},
+					{Address: bob, Identifier: []byte("transfer")},
+				},
+			}, nil
+		},
+	}
+	hub, _ := subscriptions.NewEventsHub(args)
+
+	header, body, transactions := createBlockWithTransfer(10)
+	hub.NotifyCommittedBlock([]byte("hash10"), header, body, transactions, 10)
+	time.Sleep(waitForEventsTimeout)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numGetLogCalls), "logs should not be fetched without log subscriptions")
+
+	subscriber, _ := hub.CreateSubscriber()
+	idContractTransfers, _ := subscriber.Subscribe(api.EventsSubscription{
+		Topic:      api.EventsTopicLogs,
+		Address:    args.PubkeyConverter.Encode(contract),
+		Identifier: "transfer",
+	})
+	idAllTransfers, _ := subscriber.Subscribe(api.EventsSubscription{
+		Topic:      api.EventsTopicLogs,
+		Identifier: "transfer",
+	})
+
+	header, body, transactions = createBlockWithTransfer(11)
+	hub.NotifyCommittedBlock([]byte("hash11"), header, body, transactions, 11)
+
+	events := readEvents(subscriber)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&numGetLogCalls))
+	require.Equal(t, 3, len(events))
+	numEventsPerSubscription := make(map[uint64]int)
+	for _, event := range events {
+		numEventsPerSubscription[event.SubscriptionID]++
+		assert.Equal(t, "transfer", event.Log.Identifier)
+		assert.Equal(t, "747848617368", event.Log.TxHash)
+	}
+	assert.Equal(t, map[uint64]int{idContractTransfers: 1, idAllTransfers: 2}, numEventsPerSubscription)
+}
+
+func TestEventsHub_SlowSubscriberShouldBeDropped(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	args.EventsQueueSize = 2
+	hub, _ := subscriptions.NewEventsHub(args)
+	subscriber, _ := hub.CreateSubscriber()
+	_, _ = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+
+	for nonce := uint64(1); nonce <= 3; nonce++ {
+		hub.NotifyCommittedBlock([]byte("hash"), &block.Header{Nonce: nonce}, &block.Body{}, nil, 0)
+	}
+	time.Sleep(waitForEventsTimeout)
+
+	events := readEvents(subscriber)
+	assert.Equal(t, 2, len(events))
+	_, isOpen := <-subscriber.Events()
+	assert.False(t, isOpen)
+	assert.Equal(t, subscriptions.ErrSubscriberTooSlow, subscriber.Err())
+
+	_, err := subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+	assert.Equal(t, subscriptions.ErrSubscriberClosed, err)
+}
+
+func TestEventsHub_ClosedSubscriberShouldNotReceiveEvents(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewEventsHub(createMockArgsEventsHub())
+	subscriber, _ := hub.CreateSubscriber()
+	_, _ = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+
+	err := subscriber.Close()
+	assert.Nil(t, err)
+	assert.Nil(t, subscriber.Err())
+
+	hub.NotifyCommittedBlock([]byte("hash"), &block.Header{Nonce: 1}, &block.Body{}, nil, 0)
+
+	_, isOpen := <-subscriber.Events()
+	assert.False(t, isOpen)
+}
+
+func TestEventsHub_HasSubscribers(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewEventsHub(createMockArgsEventsHub())
+	assert.False(t, hub.HasSubscribers())
+
+	subscriber, _ := hub.CreateSubscriber()
+	assert.True(t, hub.HasSubscribers())
+
+	_ = subscriber.Close()
+	assert.False(t, hub.HasSubscribers())
+}
+
+func TestEventsHub_NotifyCommittedBlockShouldNotWaitForTheLogs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEventsHub()
+	chanReleaseGetLog := make(chan struct{})
+	args.TxLogsGetter = &mock.TxLogsGetterStub{
+		GetLogCalled: func(_ []byte) (data.LogHandler, error) {
+			<-chanReleaseGetLog
+			return nil, errors.New("no log")
+		},
+	}
+	hub, _ := subscriptions.NewEventsHub(args)
+	subscriber, _ := hub.CreateSubscriber()
+	_, _ = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicLogs})
+
+	chanNotified := make(chan struct{})
+	go func() {
+		for nonce := uint64(1); nonce <= 1000; nonce++ {
+			header, body, transactions := createBlockWithTransfer(nonce)
+			hub.NotifyCommittedBlock([]byte("hash"), header, body, transactions, nonce)
+		}
+		close(chanNotified)
+	}()
+
+	select {
+	case <-chanNotified:
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "notifying the committed blocks should not have blocked")
+	}
+	close(chanReleaseGetLog)
+
+	_ = readEvents(subscriber)
+	assert.Equal(t, subscriptions.ErrEventsHubOverloaded, subscriber.Err())
+	assert.False(t, hub.HasSubscribers())
+}
+
+func TestEventsHub_CloseShouldDropTheSubscribers(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewEventsHub(createMockArgsEventsHub())
+	subscriber, _ := hub.CreateSubscriber()
+	_, _ = subscriber.Subscribe(api.EventsSubscription{Topic: api.EventsTopicBlocks})
+
+	err := hub.Close()
+	assert.Nil(t, err)
+	assert.False(t, hub.HasSubscribers())
+
+	_, isOpen := <-subscriber.Events()
+	assert.False(t, isOpen)
+	assert.Equal(t, subscriptions.ErrSubscriberClosed, subscriber.Err())
+}
+
+func TestDisabledEventsHub_CreateSubscriberShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hub := subscriptions.NewDisabledEventsHub()
+	assert.False(t, check.IfNil(hub))
+	assert.False(t, hub.HasSubscribers())
+
+	hub.NotifyCommittedBlock(nil, nil, nil, nil, 0)
+	subscriber, err := hub.CreateSubscriber()
+	assert.Nil(t, subscriber)
+	assert.Equal(t, subscriptions.ErrEventsSubscriptionsDisabled, err)
+	assert.Nil(t, hub.Close())
+}
@@ -0,0 +1,28 @@
+package subscriptions
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// EventsHub defines the operations of the component that dispatches the events of the committed blocks to the
+// connected subscribers
+type EventsHub interface {
+	NotifyCommittedBlock(
+		headerHash []byte,
+		header data.HeaderHandler,
+		body data.BodyHandler,
+		transactions map[string]data.TransactionHandler,
+		highestFinalBlockNonce uint64,
+	)
+	HasSubscribers() bool
+	CreateSubscriber() (api.EventsSubscriber, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TransactionLogsGetter defines the component able to fetch the log generated by a transaction
+type TransactionLogsGetter interface {
+	GetLog(txHash []byte) (data.LogHandler, error)
+	IsInterfaceNil() bool
+}
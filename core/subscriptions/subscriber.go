@@ -0,0 +1,159 @@
+package subscriptions
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+type subscription struct {
+	api.EventsSubscription
+	addressBytes []byte
+}
+
+type subscriber struct {
+	id                 uint64
+	hub                *eventsHub
+	mut                sync.RWMutex
+	subscriptions      map[uint64]*subscription
+	lastSubscriptionID uint64
+	events             chan *api.Event
+	closed             bool
+	err                error
+}
+
+func newSubscriber(id uint64, hub *eventsHub) *subscriber {
+	return &subscriber{
+		id:            id,
+		hub:           hub,
+		subscriptions: make(map[uint64]*subscription),
+		events:        make(chan *api.Event, hub.eventsQueueSize),
+	}
+}
+
+// Subscribe validates and registers the provided subscription, returning its ID
+func (s *subscriber) Subscribe(eventsSubscription api.EventsSubscription) (uint64, error) {
+	newSubscription, err := s.hub.createSubscription(eventsSubscription)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.closed {
+		return 0, ErrSubscriberClosed
+	}
+	if len(s.subscriptions) >= s.hub.maxSubscriptionsPerConnection {
+		return 0, fmt.Errorf("%w, maximum allowed: %d", ErrTooManySubscriptions, s.hub.maxSubscriptionsPerConnection)
+	}
+
+	s.lastSubscriptionID++
+	s.subscriptions[s.lastSubscriptionID] = newSubscription
+
+	return s.lastSubscriptionID, nil
+}
+
+// Unsubscribe removes the subscription with the provided ID
+func (s *subscriber) Unsubscribe(subscriptionID uint64) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	_, ok := s.subscriptions[subscriptionID]
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrSubscriptionNotFound, subscriptionID)
+	}
+
+	delete(s.subscriptions, subscriptionID)
+
+	return nil
+}
+
+// Events returns the channel on which the matching events are pushed
+func (s *subscriber) Events() <-chan *api.Event {
+	return s.events
+}
+
+// Err returns the reason for which the subscriber was dropped by the hub, if any
+func (s *subscriber) Err() error {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	return s.err
+}
+
+// Close unregisters the subscriber from the hub and closes its events channel
+func (s *subscriber) Close() error {
+	s.hub.removeSubscriber(s.id)
+	s.closeWithError(nil)
+
+	return nil
+}
+
+func (s *subscriber) closeWithError(err error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	s.err = err
+	close(s.events)
+}
+
+func (s *subscriber) hasTopic(topic string) bool {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	for _, sub := range s.subscriptions {
+		if sub.Topic == topic {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchingSubscriptions returns the IDs of the subscriptions on the given topic accepted by the provided filter
+func (s *subscriber) matchingSubscriptions(topic string, filter func(sub *subscription) bool) []uint64 {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+
+	ids := make([]uint64, 0)
+	for id, sub := range s.subscriptions {
+		if sub.Topic == topic && filter(sub) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// push enqueues the event without blocking. A subscriber whose queue is full is dropped, so that a slow client can
+// not stall the block commit path
+func (s *subscriber) push(event *api.Event) bool {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.closed {
+		return false
+	}
+
+	select {
+	case s.events <- event:
+		return true
+	default:
+		s.closed = true
+		s.err = ErrSubscriberTooSlow
+		close(s.events)
+		return false
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *subscriber) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,14 @@
+package api
+
+// AccountQueryOptions holds the options used when fetching the state of an account. If neither a block nonce
+// nor a block hash is provided, the current state is used
+type AccountQueryOptions struct {
+	OnBlockNonce bool
+	BlockNonce   uint64
+	BlockHash    []byte
+}
+
+// IsHistorical returns true if the state should be fetched as of a past block
+func (options AccountQueryOptions) IsHistorical() bool {
+	return options.OnBlockNonce || len(options.BlockHash) > 0
+}
@@ -26,6 +26,28 @@ type Block struct {
 	Status                 string            `json:"status,omitempty"`
 }
 
+// Hyperblock represents a metachain block together with the shard blocks it notarizes and all the transactions
+// of both the metachain block and the notarized shard blocks. A hyperblock is incomplete when the node does not
+// store some of the shard miniblocks, in which case their hashes are listed and their transactions are missing
+type Hyperblock struct {
+	Nonce                  uint64                              `json:"nonce"`
+	Round                  uint64                              `json:"round"`
+	Hash                   string                              `json:"hash"`
+	PrevBlockHash          string                              `json:"prevBlockHash"`
+	Epoch                  uint32                              `json:"epoch"`
+	NumTxs                 uint32                              `json:"numTxs"`
+	ShardBlocks            []*NotarizedBlock                   `json:"shardBlocks"`
+	Transactions           []*transaction.ApiTransactionResult `json:"transactions"`
+	Timestamp              time.Duration                       `json:"timestamp,omitempty"`
+	AccumulatedFees        string                              `json:"accumulatedFees,omitempty"`
+	DeveloperFees          string                              `json:"developerFees,omitempty"`
+	AccumulatedFeesInEpoch string                              `json:"accumulatedFeesInEpoch,omitempty"`
+	DeveloperFeesInEpoch   string                              `json:"developerFeesInEpoch,omitempty"`
+	Status                 string                              `json:"status,omitempty"`
+	Incomplete             bool                                `json:"incomplete"`
+	MissingMiniBlocks      []string                            `json:"missingMiniBlocks,omitempty"`
+}
+
 // NotarizedBlock represents a notarized block
 type NotarizedBlock struct {
 	Hash  string `json:"hash"`
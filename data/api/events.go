@@ -0,0 +1,79 @@
+package api
+
+import "time"
+
+const (
+	// EventsTopicBlocks is the topic on which the committed blocks are pushed
+	EventsTopicBlocks = "blocks"
+	// EventsTopicTransactions is the topic on which the finalized transactions touching an address are pushed
+	EventsTopicTransactions = "transactions"
+	// EventsTopicLogs is the topic on which the finalized transaction log events are pushed
+	EventsTopicLogs = "logs"
+)
+
+// EventsSubscription holds the topic and the filters of a subscription
+// ShardID filters the blocks topic, Address is mandatory for the transactions topic and, alongside Identifier,
+// optionally filters the logs topic
+type EventsSubscription struct {
+	Topic      string  `json:"topic"`
+	ShardID    *uint32 `json:"shardId,omitempty"`
+	Address    string  `json:"address,omitempty"`
+	Identifier string  `json:"identifier,omitempty"`
+}
+
+// Event represents the structure pushed to a subscriber when one of its subscriptions matches
+type Event struct {
+	SubscriptionID uint64            `json:"subscriptionId"`
+	Topic          string            `json:"topic"`
+	Block          *BlockEvent       `json:"block,omitempty"`
+	Transaction    *TransactionEvent `json:"transaction,omitempty"`
+	Log            *LogEvent         `json:"log,omitempty"`
+}
+
+// BlockEvent holds the details of a committed block
+type BlockEvent struct {
+	Nonce         uint64        `json:"nonce"`
+	Round         uint64        `json:"round"`
+	Epoch         uint32        `json:"epoch"`
+	Shard         uint32        `json:"shard"`
+	Hash          string        `json:"hash"`
+	PrevBlockHash string        `json:"prevBlockHash"`
+	NumTxs        uint32        `json:"numTxs"`
+	Timestamp     time.Duration `json:"timestamp"`
+}
+
+// TransactionEvent holds the details of a finalized transaction
+type TransactionEvent struct {
+	Hash             string `json:"hash"`
+	Type             string `json:"type"`
+	Sender           string `json:"sender"`
+	Receiver         string `json:"receiver"`
+	SourceShard      uint32 `json:"sourceShard"`
+	DestinationShard uint32 `json:"destinationShard"`
+	BlockNonce       uint64 `json:"blockNonce"`
+	BlockHash        string `json:"blockHash"`
+}
+
+// LogEvent holds the details of an event contained in the log of a finalized transaction
+type LogEvent struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+}
+
+// EventsSubscriber defines the operations of a connection subscribed to the node's events
+type EventsSubscriber interface {
+	Subscribe(subscription EventsSubscription) (uint64, error)
+	Unsubscribe(subscriptionID uint64) error
+	// Events returns the channel on which the matching events are pushed. The channel is closed when the subscriber
+	// is closed, either explicitly or because it did not keep up with the pushed events
+	Events() <-chan *Event
+	// Err returns the reason for which the subscriber was closed by the node, if any
+	Err() error
+	Close() error
+	IsInterfaceNil() bool
+}
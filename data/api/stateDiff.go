@@ -0,0 +1,25 @@
+package api
+
+// StateDiff represents the structure of the changes between two state root hashes, as returned by the API
+type StateDiff struct {
+	FromRootHash string         `json:"fromRootHash"`
+	ToRootHash   string         `json:"toRootHash"`
+	Accounts     []*AccountDiff `json:"accounts"`
+	Truncated    bool           `json:"truncated"`
+}
+
+// AccountDiff holds a changed leaf of the accounts trie, alongside the changed leaves of the account's data trie.
+// DataTrieTruncated is set when only some of the data trie changes are provided
+type AccountDiff struct {
+	LeafDiff
+	DataTrieChanges   []*LeafDiff `json:"dataTrieChanges,omitempty"`
+	DataTrieTruncated bool        `json:"dataTrieTruncated,omitempty"`
+}
+
+// LeafDiff holds the change type and the hex encoded key and values of a changed trie leaf
+type LeafDiff struct {
+	Change   string `json:"change"`
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue,omitempty"`
+	NewValue string `json:"newValue,omitempty"`
+}
@@ -0,0 +1,25 @@
+package api
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// TrieAnalysis represents the structure of the accounts trie found at a root hash and of all the data tries it
+// references, alongside the accounts holding the biggest data tries, as returned by the API
+type TrieAnalysis struct {
+	RootHash                string                  `json:"rootHash"`
+	NumAccounts             uint64                  `json:"numAccounts"`
+	NumAccountsWithDataTrie uint64                  `json:"numAccountsWithDataTrie"`
+	AccountsTrie            *data.TrieStatisticsDTO `json:"accountsTrie"`
+	DataTries               *data.TrieStatisticsDTO `json:"dataTries"`
+	TopAccountsBySize       []*AccountTrieSize      `json:"topAccountsBySize"`
+	TopAccountsByLeaves     []*AccountTrieSize      `json:"topAccountsByLeaves"`
+}
+
+// AccountTrieSize holds the size of the data trie of an account
+type AccountTrieSize struct {
+	Address   string `json:"address"`
+	RootHash  string `json:"rootHash"`
+	NumLeaves uint64 `json:"numLeaves"`
+	NumNodes  uint64 `json:"numNodes"`
+	MaxDepth  int    `json:"maxDepth"`
+	Size      uint64 `json:"size"`
+}
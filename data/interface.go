@@ -172,13 +172,17 @@ type Trie interface {
 	SetNewHashes(ModifiedHashes)
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetSerializedNode([]byte) ([]byte, error)
+	GetSerializedStateChunk(rootHash []byte, startAfterKey []byte, maxBuffToSend uint64) ([]byte, error)
 	GetNumNodes() NumNodesDTO
 	GetAllLeavesOnChannel(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error)
+	IterateLeavesFrom(rootHash []byte, startKey []byte, handler func(key []byte, value []byte) bool) error
 	GetAllHashes() ([][]byte, error)
 	IsInterfaceNil() bool
 	ClosePersister() error
 	GetProof(key []byte) ([][]byte, error)
 	VerifyProof(key []byte, proof [][]byte) (bool, error)
+	Diff(fromRootHash []byte, toRootHash []byte, handler func(TrieLeafDiff) bool) error
+	GetStatistics(rootHash []byte, leafHandler func(key []byte, value []byte)) (*TrieStatisticsDTO, error)
 	GetStorageManager() StorageManager
 }
 
@@ -277,7 +281,11 @@ type SyncStatisticsHandler interface {
 	Reset()
 	AddNumReceived(value int)
 	SetNumMissing(rootHash []byte, value int)
+	AddNumResumed(value int)
+	AddNumSkipped(value int)
 	NumReceived() int
 	NumMissing() int
+	NumResumed() int
+	NumSkipped() int
 	IsInterfaceNil() bool
 }
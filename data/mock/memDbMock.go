@@ -4,7 +4,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 // MemDbMock represents the memory database storage. It holds a map of key value pairs
@@ -113,6 +116,43 @@ func (s *MemDbMock) RangeKeys(handler func(key []byte, value []byte) bool) {
 	}
 }
 
+// RangeKeysWithPrefix will iterate over the (key, value) pairs whose keys start with the prefix, in the keys order
+// or in the reversed keys order
+func (s *MemDbMock) RangeKeysWithPrefix(prefix []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	s.RangeKeysFrom(prefix, storage.PrefixUpperBound(prefix), reverse, handler)
+}
+
+// RangeKeysFrom will iterate over the (key, value) pairs whose keys are in the [start, end) interval, in the keys
+// order or in the reversed keys order
+func (s *MemDbMock) RangeKeysFrom(start []byte, end []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutx.RLock()
+	defer s.mutx.RUnlock()
+
+	keys := make([]string, 0, len(s.db))
+	for k := range s.db {
+		if storage.IsKeyInRange([]byte(k), start, end) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for i := range keys {
+		k := keys[i]
+		if reverse {
+			k = keys[len(keys)-1-i]
+		}
+
+		shouldContinue := handler([]byte(k), s.db[k])
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s *MemDbMock) IsInterfaceNil() bool {
 	return s == nil
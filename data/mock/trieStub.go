@@ -12,23 +12,27 @@ var errNotImplemented = errors.New("not implemented")
 
 // TrieStub -
 type TrieStub struct {
-	GetCalled                   func(key []byte) ([]byte, error)
-	UpdateCalled                func(key, value []byte) error
-	DeleteCalled                func(key []byte) error
-	RootCalled                  func() ([]byte, error)
-	CommitCalled                func() error
-	RecreateCalled              func(root []byte) (data.Trie, error)
-	ResetOldHashesCalled        func() [][]byte
-	AppendToOldHashesCalled     func([][]byte)
-	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
-	GetSerializedNodeCalled     func(hash []byte) ([]byte, error)
-	GetAllLeavesOnChannelCalled func(rootHash []byte) (chan core.KeyValueHolder, error)
-	GetAllHashesCalled          func() ([][]byte, error)
-	ClosePersisterCalled        func() error
-	GetProofCalled              func(key []byte) ([][]byte, error)
-	VerifyProofCalled           func(key []byte, proof [][]byte) (bool, error)
-	GetStorageManagerCalled     func() data.StorageManager
-	GetNumNodesCalled           func() data.NumNodesDTO
+	GetCalled                     func(key []byte) ([]byte, error)
+	UpdateCalled                  func(key, value []byte) error
+	DeleteCalled                  func(key []byte) error
+	RootCalled                    func() ([]byte, error)
+	CommitCalled                  func() error
+	RecreateCalled                func(root []byte) (data.Trie, error)
+	ResetOldHashesCalled          func() [][]byte
+	AppendToOldHashesCalled       func([][]byte)
+	GetSerializedNodesCalled      func([]byte, uint64) ([][]byte, uint64, error)
+	GetSerializedStateChunkCalled func(rootHash []byte, startAfterKey []byte, maxBuffToSend uint64) ([]byte, error)
+	GetSerializedNodeCalled       func(hash []byte) ([]byte, error)
+	GetAllLeavesOnChannelCalled   func(rootHash []byte) (chan core.KeyValueHolder, error)
+	IterateLeavesFromCalled       func(rootHash []byte, startKey []byte, handler func(key []byte, value []byte) bool) error
+	GetAllHashesCalled            func() ([][]byte, error)
+	ClosePersisterCalled          func() error
+	GetProofCalled                func(key []byte) ([][]byte, error)
+	VerifyProofCalled             func(key []byte, proof [][]byte) (bool, error)
+	DiffCalled                    func(fromRootHash []byte, toRootHash []byte, handler func(data.TrieLeafDiff) bool) error
+	GetStatisticsCalled           func(rootHash []byte, leafHandler func(key []byte, value []byte)) (*data.TrieStatisticsDTO, error)
+	GetStorageManagerCalled       func() data.StorageManager
+	GetNumNodesCalled             func() data.NumNodesDTO
 }
 
 // GetStorageManager -
@@ -58,6 +62,24 @@ func (ts *TrieStub) VerifyProof(key []byte, proof [][]byte) (bool, error) {
 	return false, nil
 }
 
+// Diff -
+func (ts *TrieStub) Diff(fromRootHash []byte, toRootHash []byte, handler func(data.TrieLeafDiff) bool) error {
+	if ts.DiffCalled != nil {
+		return ts.DiffCalled(fromRootHash, toRootHash, handler)
+	}
+
+	return nil
+}
+
+// GetStatistics -
+func (ts *TrieStub) GetStatistics(rootHash []byte, leafHandler func(key []byte, value []byte)) (*data.TrieStatisticsDTO, error) {
+	if ts.GetStatisticsCalled != nil {
+		return ts.GetStatisticsCalled(rootHash, leafHandler)
+	}
+
+	return data.NewTrieStatisticsDTO(), nil
+}
+
 // ClosePersister -
 func (ts *TrieStub) ClosePersister() error {
 	if ts.ClosePersisterCalled != nil {
@@ -138,6 +160,15 @@ func (ts *TrieStub) GetAllLeavesOnChannel(rootHash []byte, _ context.Context) (c
 	return ch, nil
 }
 
+// IterateLeavesFrom -
+func (ts *TrieStub) IterateLeavesFrom(rootHash []byte, startKey []byte, handler func(key []byte, value []byte) bool) error {
+	if ts.IterateLeavesFromCalled != nil {
+		return ts.IterateLeavesFromCalled(rootHash, startKey, handler)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ts *TrieStub) IsInterfaceNil() bool {
 	return ts == nil
@@ -167,6 +198,15 @@ func (ts *TrieStub) GetSerializedNodes(hash []byte, maxBuffToSend uint64) ([][]b
 	return nil, 0, nil
 }
 
+// GetSerializedStateChunk -
+func (ts *TrieStub) GetSerializedStateChunk(rootHash []byte, startAfterKey []byte, maxBuffToSend uint64) ([]byte, error) {
+	if ts.GetSerializedStateChunkCalled != nil {
+		return ts.GetSerializedStateChunkCalled(rootHash, startAfterKey, maxBuffToSend)
+	}
+
+	return nil, nil
+}
+
 // GetSerializedNode -
 func (ts *TrieStub) GetSerializedNode(hash []byte) ([]byte, error) {
 	if ts.GetSerializedNodeCalled != nil {
@@ -0,0 +1,12 @@
+package state
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// AccountDiff holds an account leaf that differs between two root hashes, alongside the leaves that differ in the
+// data trie of the account. The data trie values are provided without the key and address suffix. DataTrieTruncated
+// is set when only some of the data trie changes are provided
+type AccountDiff struct {
+	data.TrieLeafDiff
+	DataTrieChanges   []data.TrieLeafDiff
+	DataTrieTruncated bool
+}
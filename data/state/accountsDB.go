@@ -871,6 +871,138 @@ func (adb *AccountsDB) RecreateAllTries(rootHash []byte, ctx context.Context) (m
 	return allTries, nil
 }
 
+// GetTrie returns the trie that has the given rootHash. The main trie of the accounts DB is not altered
+func (adb *AccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	return adb.mainTrie.Recreate(rootHash)
+}
+
+// GetStateDiff calls the handler for each account that was added, modified or deleted between the two root hashes.
+// For the accounts whose data trie changed, the changed data trie leaves are provided as well. At most maxLeaves
+// leaves, counting both the accounts and their data trie leaves, are provided. When the limit is reached the walk
+// stops and true is returned. An account whose data trie changes did not fit is still provided, with the
+// DataTrieTruncated flag set. The walk also stops early if the handler returns false
+func (adb *AccountsDB) GetStateDiff(fromRootHash []byte, toRootHash []byte, maxLeaves int, handler func(AccountDiff) bool) (bool, error) {
+	if handler == nil {
+		return false, ErrNilStateDiffHandler
+	}
+
+	mainTrie := adb.getMainTrie()
+
+	remainingLeaves := maxLeaves
+	isTruncated := false
+	var errDataTrie error
+	err := mainTrie.Diff(fromRootHash, toRootHash, func(leafDiff data.TrieLeafDiff) bool {
+		if remainingLeaves <= 0 {
+			isTruncated = true
+			return false
+		}
+		remainingLeaves--
+
+		accountDiff := AccountDiff{
+			TrieLeafDiff: leafDiff,
+		}
+
+		accountDiff.DataTrieChanges, accountDiff.DataTrieTruncated, errDataTrie = adb.getDataTrieDiff(mainTrie, leafDiff, &remainingLeaves)
+		if errDataTrie != nil {
+			return false
+		}
+
+		shouldContinue := handler(accountDiff)
+		if accountDiff.DataTrieTruncated {
+			isTruncated = true
+			return false
+		}
+
+		return shouldContinue
+	})
+	if err != nil {
+		return false, err
+	}
+	if errDataTrie != nil {
+		return false, errDataTrie
+	}
+
+	return isTruncated, nil
+}
+
+func (adb *AccountsDB) getMainTrie() data.Trie {
+	adb.mutOp.Lock()
+	defer adb.mutOp.Unlock()
+
+	return adb.mainTrie
+}
+
+// getDataTrieDiff returns the changed leaves of the account's data trie, consuming the remaining leaves budget. The
+// returned flag is true if the budget ran out before all the changed leaves were gathered. The data trie is not
+// walked at all if there is no budget left
+func (adb *AccountsDB) getDataTrieDiff(
+	mainTrie data.Trie,
+	accountLeafDiff data.TrieLeafDiff,
+	remainingLeaves *int,
+) ([]data.TrieLeafDiff, bool, error) {
+	oldRootHash := adb.getDataTrieRootHash(accountLeafDiff.OldValue)
+	newRootHash := adb.getDataTrieRootHash(accountLeafDiff.NewValue)
+	if bytes.Equal(oldRootHash, newRootHash) {
+		return nil, false, nil
+	}
+	if *remainingLeaves <= 0 {
+		return nil, true, nil
+	}
+
+	isTruncated := false
+	dataTrieChanges := make([]data.TrieLeafDiff, 0)
+	err := mainTrie.Diff(oldRootHash, newRootHash, func(leafDiff data.TrieLeafDiff) bool {
+		if *remainingLeaves <= 0 {
+			isTruncated = true
+			return false
+		}
+		*remainingLeaves--
+
+		tailLength := len(leafDiff.Key) + len(accountLeafDiff.Key)
+		leafDiff.OldValue = trimDataTrieValue(leafDiff.OldValue, tailLength)
+		leafDiff.NewValue = trimDataTrieValue(leafDiff.NewValue, tailLength)
+
+		dataTrieChanges = append(dataTrieChanges, leafDiff)
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return dataTrieChanges, isTruncated, nil
+}
+
+func (adb *AccountsDB) getDataTrieRootHash(accountValue []byte) []byte {
+	if len(accountValue) == 0 {
+		return nil
+	}
+
+	account := &userAccount{}
+	err := adb.marshalizer.Unmarshal(account, accountValue)
+	if err != nil {
+		log.Trace("this must be a leaf with code", "err", err)
+		return nil
+	}
+
+	return account.RootHash
+}
+
+func trimDataTrieValue(value []byte, tailLength int) []byte {
+	if value == nil {
+		return nil
+	}
+
+	trimmedValue, err := trimValue(value, tailLength)
+	if err != nil {
+		return value
+	}
+
+	return trimmedValue
+}
+
 // Journalize adds a new object to entries list.
 func (adb *AccountsDB) journalize(entry JournalEntry) {
 	if check.IfNil(entry) {
@@ -1718,3 +1718,135 @@ func BenchmarkAccountsDb_GetCodeEntry(b *testing.B) {
 		assert.Equal(b, code, entry.Code)
 	}
 }
+
+func TestAccountsDB_GetTrieShouldNotAlterTheMainTrie(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	hsh := mock.HasherMock{}
+	accFactory := factory.NewAccountCreator()
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(mock.NewMemDbMock())
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := trie.NewTrie(storageManager, marshalizer, hsh, maxTrieLevelInMemory)
+	adb, _ := state.NewAccountsDB(tr, hsh, marshalizer, accFactory)
+
+	address := make([]byte, 32)
+	acc, _ := adb.LoadAccount(address)
+	_ = adb.SaveAccount(acc)
+	oldRootHash, _ := adb.Commit()
+
+	acc, _ = adb.LoadAccount(address)
+	acc.(state.UserAccountHandler).IncreaseNonce(1)
+	_ = adb.SaveAccount(acc)
+	newRootHash, _ := adb.Commit()
+
+	oldTrie, err := adb.GetTrie(oldRootHash)
+	assert.Nil(t, err)
+	oldTrieRootHash, _ := oldTrie.RootHash()
+	assert.Equal(t, oldRootHash, oldTrieRootHash)
+
+	rootHash, _ := adb.RootHash()
+	assert.Equal(t, newRootHash, rootHash)
+}
+
+func TestAccountsDB_GetStateDiffNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+
+	isTruncated, err := adb.GetStateDiff(nil, nil, 1, nil)
+	assert.False(t, isTruncated)
+	assert.Equal(t, state.ErrNilStateDiffHandler, err)
+}
+
+// createAccountsDBWithStateDiff creates two states differing by three accounts, one of them having three changed data
+// trie leaves, so six leaves in total
+func createAccountsDBWithStateDiff() (*state.AccountsDB, []byte, []byte, [][]byte) {
+	adb, _ := getTestAccountsDbAndTrie(&mock.MarshalizerMock{}, mock.HasherMock{})
+
+	saveAccount := func(address []byte, nonce uint64, keyValues map[string]string) {
+		acc, _ := adb.LoadAccount(address)
+		userAcc := acc.(state.UserAccountHandler)
+		userAcc.IncreaseNonce(nonce)
+		for key, value := range keyValues {
+			_ = userAcc.DataTrieTracker().SaveKeyValue([]byte(key), []byte(value))
+		}
+		_ = adb.SaveAccount(userAcc)
+	}
+
+	addressWithData := bytes.Repeat([]byte{1}, 32)
+	removedAddress := bytes.Repeat([]byte{2}, 32)
+	unchangedAddress := bytes.Repeat([]byte{3}, 32)
+	addedAddress := bytes.Repeat([]byte{4}, 32)
+
+	saveAccount(addressWithData, 1, map[string]string{"key1": "value1", "key2": "value2"})
+	saveAccount(removedAddress, 1, nil)
+	saveAccount(unchangedAddress, 1, map[string]string{"key": "value"})
+	fromRootHash, _ := adb.Commit()
+
+	saveAccount(addressWithData, 0, map[string]string{"key1": "new value1", "key2": "", "key3": "value3"})
+	_ = adb.RemoveAccount(removedAddress)
+	saveAccount(addedAddress, 1, nil)
+	toRootHash, _ := adb.Commit()
+
+	return adb, fromRootHash, toRootHash, [][]byte{addressWithData, removedAddress, addedAddress}
+}
+
+func TestAccountsDB_GetStateDiffShouldDescendIntoTheChangedDataTries(t *testing.T) {
+	t.Parallel()
+
+	adb, fromRootHash, toRootHash, addresses := createAccountsDBWithStateDiff()
+	addressWithData, removedAddress, addedAddress := addresses[0], addresses[1], addresses[2]
+
+	accountDiffs := make(map[string]state.AccountDiff)
+	isTruncated, err := adb.GetStateDiff(fromRootHash, toRootHash, 100, func(accountDiff state.AccountDiff) bool {
+		accountDiffs[string(accountDiff.Key)] = accountDiff
+		return true
+	})
+	require.Nil(t, err)
+	assert.False(t, isTruncated)
+	require.Equal(t, 3, len(accountDiffs))
+
+	assert.Equal(t, data.LeafAdded, accountDiffs[string(addedAddress)].Change)
+	assert.Equal(t, 0, len(accountDiffs[string(addedAddress)].DataTrieChanges))
+	assert.Equal(t, data.LeafDeleted, accountDiffs[string(removedAddress)].Change)
+
+	accountDiff := accountDiffs[string(addressWithData)]
+	assert.Equal(t, data.LeafModified, accountDiff.Change)
+	dataTrieChanges := make(map[string]data.TrieLeafDiff)
+	for _, leafDiff := range accountDiff.DataTrieChanges {
+		dataTrieChanges[string(leafDiff.Key)] = leafDiff
+	}
+	assert.Equal(t, map[string]data.TrieLeafDiff{
+		"key1": {Change: data.LeafModified, Key: []byte("key1"), OldValue: []byte("value1"), NewValue: []byte("new value1")},
+		"key2": {Change: data.LeafDeleted, Key: []byte("key2"), OldValue: []byte("value2")},
+		"key3": {Change: data.LeafAdded, Key: []byte("key3"), NewValue: []byte("value3")},
+	}, dataTrieChanges)
+	assert.False(t, accountDiff.DataTrieTruncated)
+}
+
+func TestAccountsDB_GetStateDiffShouldNotExceedTheLeavesBudget(t *testing.T) {
+	t.Parallel()
+
+	adb, fromRootHash, toRootHash, _ := createAccountsDBWithStateDiff()
+	numChangedLeaves := 6
+
+	for maxLeaves := 0; maxLeaves <= numChangedLeaves; maxLeaves++ {
+		numLeaves := 0
+		accountDiffs := make([]state.AccountDiff, 0)
+		isTruncated, err := adb.GetStateDiff(fromRootHash, toRootHash, maxLeaves, func(accountDiff state.AccountDiff) bool {
+			numLeaves += 1 + len(accountDiff.DataTrieChanges)
+			accountDiffs = append(accountDiffs, accountDiff)
+			return true
+		})
+		require.Nil(t, err)
+
+		assert.True(t, numLeaves <= maxLeaves, "max leaves %d", maxLeaves)
+		assert.Equal(t, maxLeaves < numChangedLeaves, isTruncated, "max leaves %d", maxLeaves)
+		for i, accountDiff := range accountDiffs {
+			if accountDiff.DataTrieTruncated {
+				assert.Equal(t, len(accountDiffs)-1, i, "only the last account can have truncated data trie changes")
+			}
+		}
+	}
+}
@@ -115,3 +115,6 @@ var ErrInvalidRootHash = errors.New("invalid root hash")
 
 // ErrInvalidMaxHardCapForMissingNodes signals that the maximum hardcap value for missing nodes is invalid
 var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for missing nodes")
+
+// ErrNilStateDiffHandler signals that a nil state diff handler was provided
+var ErrNilStateDiffHandler = errors.New("nil state diff handler")
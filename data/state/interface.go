@@ -141,6 +141,8 @@ type AccountsAdapter interface {
 	IsPruningEnabled() bool
 	GetAllLeaves(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error)
 	RecreateAllTries(rootHash []byte, ctx context.Context) (map[string]data.Trie, error)
+	GetTrie(rootHash []byte) (data.Trie, error)
+	GetStateDiff(fromRootHash []byte, toRootHash []byte, maxLeaves int, handler func(AccountDiff) bool) (bool, error)
 	IsInterfaceNil() bool
 }
 
@@ -90,6 +90,32 @@ func (adb *PeerAccountsDB) RecreateAllTries(rootHash []byte, _ context.Context)
 	return allTries, nil
 }
 
+// GetStateDiff calls the handler for at most maxLeaves peer accounts that were added, modified or deleted between the
+// two root hashes and returns true if there were more changed peer accounts. The peer accounts do not have data
+// tries, so only the main trie is walked
+func (adb *PeerAccountsDB) GetStateDiff(fromRootHash []byte, toRootHash []byte, maxLeaves int, handler func(AccountDiff) bool) (bool, error) {
+	if handler == nil {
+		return false, ErrNilStateDiffHandler
+	}
+
+	remainingLeaves := maxLeaves
+	isTruncated := false
+	err := adb.getMainTrie().Diff(fromRootHash, toRootHash, func(leafDiff data.TrieLeafDiff) bool {
+		if remainingLeaves <= 0 {
+			isTruncated = true
+			return false
+		}
+		remainingLeaves--
+
+		return handler(AccountDiff{TrieLeafDiff: leafDiff})
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return isTruncated, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (adb *PeerAccountsDB) IsInterfaceNil() bool {
 	return adb == nil
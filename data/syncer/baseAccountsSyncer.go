@@ -31,6 +31,7 @@ type baseAccountsSyncer struct {
 	name                      string
 	maxHardCapForMissingNodes int
 	trieSyncerVersion         int
+	syncProgressStorer        trie.SyncProgressStorer
 }
 
 const timeBetweenStatisticsPrints = time.Second * 2
@@ -46,6 +47,7 @@ type ArgsNewBaseAccountsSyncer struct {
 	MaxTrieLevelInMemory      uint
 	MaxHardCapForMissingNodes int
 	TrieSyncerVersion         int
+	SyncProgressStorer        trie.SyncProgressStorer
 }
 
 func checkArgs(args ArgsNewBaseAccountsSyncer) error {
@@ -67,6 +69,9 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 	if args.MaxHardCapForMissingNodes < 1 {
 		return state.ErrInvalidMaxHardCapForMissingNodes
 	}
+	if check.IfNil(args.SyncProgressStorer) {
+		return trie.ErrNilSyncProgressStorer
+	}
 
 	return trie.CheckTrieSyncerVersion(args.TrieSyncerVersion)
 }
@@ -74,6 +79,7 @@ func checkArgs(args ArgsNewBaseAccountsSyncer) error {
 func (b *baseAccountsSyncer) syncMainTrie(
 	rootHash []byte,
 	trieTopic string,
+	stateChunksTopic string,
 	ssh data.SyncStatisticsHandler,
 	ctx context.Context,
 ) (data.Trie, error) {
@@ -93,9 +99,11 @@ func (b *baseAccountsSyncer) syncMainTrie(
 		Hasher:                         b.hasher,
 		ShardId:                        b.shardId,
 		Topic:                          trieTopic,
+		StateChunksTopic:               stateChunksTopic,
 		TrieSyncStatistics:             ssh,
 		TimeoutBetweenTrieNodesCommits: b.timeout,
 		MaxHardCapForMissingNodes:      b.maxHardCapForMissingNodes,
+		SyncProgressStorer:             b.syncProgressStorer,
 	}
 	trieSyncer, err := trie.CreateTrieSyncer(arg, b.trieSyncerVersion)
 	if err != nil {
@@ -114,18 +122,35 @@ func (b *baseAccountsSyncer) printStatistics(ssh data.SyncStatisticsHandler, ctx
 	for {
 		select {
 		case <-ctx.Done():
-			log.Info("finished trie sync", "name", b.name, "num received", ssh.NumReceived(), "num missing", ssh.NumMissing())
+			log.Info("finished trie sync",
+				"name", b.name,
+				"num received", ssh.NumReceived(),
+				"num missing", ssh.NumMissing(),
+				"num resumed", ssh.NumResumed(),
+				"num skipped", ssh.NumSkipped())
 			return
 		case <-time.After(timeBetweenStatisticsPrints):
 			log.Info("trie sync in progress",
 				"name", b.name,
 				"num received", ssh.NumReceived(),
 				"num missing", ssh.NumMissing(),
+				"num resumed", ssh.NumResumed(),
+				"num skipped", ssh.NumSkipped(),
 				"intercepted trie nodes cache", fmt.Sprintf("len: %d, size: %s", b.cacher.Len(), core.ConvertBytes(b.cacher.SizeInBytesContained())))
 		}
 	}
 }
 
+// removeSyncProgress removes the progress saved for all the synced tries, once the whole accounts sync is complete
+func (b *baseAccountsSyncer) removeSyncProgress() {
+	for rootHash := range b.dataTries {
+		err := trie.RemoveSyncProgress(b.syncProgressStorer, []byte(rootHash))
+		if err != nil {
+			log.Debug("cannot remove the trie sync progress", "name", b.name, "root hash", []byte(rootHash), "error", err)
+		}
+	}
+}
+
 // Deprecated: GetSyncedTries returns the synced map of data trie. This is likely to case OOM exceptions
 //TODO remove this function after fixing the hardfork sync state mechanism
 func (b *baseAccountsSyncer) GetSyncedTries() map[string]data.Trie {
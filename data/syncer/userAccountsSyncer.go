@@ -61,6 +61,7 @@ func NewUserAccountsSyncer(args ArgsNewUserAccountsSyncer) (*userAccountsSyncer,
 		name:                      fmt.Sprintf("user accounts for shard %s", core.GetShardIDString(args.ShardId)),
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		syncProgressStorer:        args.SyncProgressStorer,
 	}
 
 	u := &userAccountsSyncer{
@@ -82,7 +83,7 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte) error {
 	tss := statistics.NewTrieSyncStatistics()
 	go u.printStatistics(tss, ctx)
 
-	mainTrie, err := u.syncMainTrie(rootHash, factory.AccountTrieNodesTopic, tss, ctx)
+	mainTrie, err := u.syncMainTrie(rootHash, factory.AccountTrieNodesTopic, factory.AccountStateChunksTopic, tss, ctx)
 	if err != nil {
 		return err
 	}
@@ -99,6 +100,8 @@ func (u *userAccountsSyncer) SyncAccounts(rootHash []byte) error {
 		return err
 	}
 
+	u.removeSyncProgress()
+
 	return nil
 }
 
@@ -164,9 +167,11 @@ func (u *userAccountsSyncer) syncDataTrie(rootHash []byte, ssh data.SyncStatisti
 		Hasher:                         u.hasher,
 		ShardId:                        u.shardId,
 		Topic:                          factory.AccountTrieNodesTopic,
+		StateChunksTopic:               factory.AccountStateChunksTopic,
 		TrieSyncStatistics:             ssh,
 		TimeoutBetweenTrieNodesCommits: u.timeout,
 		MaxHardCapForMissingNodes:      u.maxHardCapForMissingNodes,
+		SyncProgressStorer:             u.syncProgressStorer,
 	}
 	trieSyncer, err := trie.CreateTrieSyncer(arg, u.trieSyncerVersion)
 	if err != nil {
@@ -41,6 +41,7 @@ func NewValidatorAccountsSyncer(args ArgsNewValidatorAccountsSyncer) (*validator
 		name:                      "peer accounts",
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
 		trieSyncerVersion:         args.TrieSyncerVersion,
+		syncProgressStorer:        args.SyncProgressStorer,
 	}
 
 	u := &validatorAccountsSyncer{
@@ -61,7 +62,12 @@ func (v *validatorAccountsSyncer) SyncAccounts(rootHash []byte) error {
 	tss := statistics.NewTrieSyncStatistics()
 	go v.printStatistics(tss, ctx)
 
-	_, err := v.syncMainTrie(rootHash, factory.ValidatorTrieNodesTopic, tss, ctx)
+	_, err := v.syncMainTrie(rootHash, factory.ValidatorTrieNodesTopic, factory.ValidatorStateChunksTopic, tss, ctx)
+	if err != nil {
+		return err
+	}
+
+	v.removeSyncProgress()
 
-	return err
+	return nil
 }
@@ -0,0 +1,9 @@
+package transaction
+
+// ApiTransactionByAddress is the data transfer object which will be returned, for each transaction, on the get
+// transactions by address endpoint
+type ApiTransactionByAddress struct {
+	Hash  string `json:"hash"`
+	Epoch uint32 `json:"epoch"`
+	Role  string `json:"role"`
+}
@@ -42,9 +42,31 @@ type ApiTransactionResult struct {
 	MiniBlockHash                     string                    `json:"miniblockHash,omitempty"`
 	Receipt                           *ReceiptApi               `json:"receipt,omitempty"`
 	SmartContractResults              []*ApiSmartContractResult `json:"smartContractResults,omitempty"`
+	Hops                              []*ApiTransactionHop      `json:"hops,omitempty"`
+	HopsTruncated                     bool                      `json:"hopsTruncated,omitempty"`
 	Status                            TxStatus                  `json:"status,omitempty"`
 }
 
+// ApiTransactionHop represents a smart contract result resulted from the execution of a transaction, together with the
+// place where it was executed. A hop is finalized once it is notarized on its destination shard
+type ApiTransactionHop struct {
+	Hash                              string   `json:"hash"`
+	Epoch                             uint32   `json:"epoch"`
+	SourceShard                       uint32   `json:"sourceShard"`
+	DestinationShard                  uint32   `json:"destinationShard"`
+	MiniBlockHash                     string   `json:"miniblockHash,omitempty"`
+	BlockNonce                        uint64   `json:"blockNonce,omitempty"`
+	NotarizedAtDestinationInMetaNonce uint64   `json:"notarizedAtDestinationInMetaNonce,omitempty"`
+	Status                            TxStatus `json:"status"`
+}
+
+// ApiTransactionsBatch is the data transfer object which will be returned on the batch transactions lookup endpoints.
+// The transactions are keyed by their hex encoded hash, the hashes that could not be found being listed separately
+type ApiTransactionsBatch struct {
+	Transactions map[string]*ApiTransactionResult `json:"transactions"`
+	NotFound     []string                         `json:"notFound"`
+}
+
 // SimulationResults is the data transfer object which will hold results for simulation a transaction's execution
 type SimulationResults struct {
 	Status     TxStatus                           `json:"status,omitempty"`
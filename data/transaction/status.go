@@ -26,6 +26,8 @@ const (
 	TxStatusInvalid TxStatus = "invalid"
 	// TxStatusRewardReverted represents the identifier for a reverted reward transaction
 	TxStatusRewardReverted TxStatus = "reward-reverted"
+	// TxStatusFinalized = executed and notarized on destination shard, together with all the resulting smart contract results
+	TxStatusFinalized TxStatus = "finalized"
 )
 
 // String returns the string representation of the status
@@ -0,0 +1,12 @@
+package analyzer
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilPubkeyConverter signals that a nil public key converter has been provided
+var ErrNilPubkeyConverter = errors.New("nil public key converter")
+
+// ErrNilTrie signals that a nil trie has been provided
+var ErrNilTrie = errors.New("nil trie")
@@ -0,0 +1,155 @@
+package analyzer
+
+import (
+	"encoding/hex"
+	"sort"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+var log = logger.GetOrCreate("trie/analyzer")
+
+// ArgsTrieAnalyzer defines the arguments needed for the trie analyzer
+type ArgsTrieAnalyzer struct {
+	Marshalizer            marshal.Marshalizer
+	AddressPubkeyConverter core.PubkeyConverter
+}
+
+type trieAnalyzer struct {
+	marshalizer            marshal.Marshalizer
+	addressPubkeyConverter core.PubkeyConverter
+}
+
+// NewTrieAnalyzer creates a component able to report the structure of an accounts trie and of its data tries
+func NewTrieAnalyzer(args ArgsTrieAnalyzer) (*trieAnalyzer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.AddressPubkeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &trieAnalyzer{
+		marshalizer:            args.Marshalizer,
+		addressPubkeyConverter: args.AddressPubkeyConverter,
+	}, nil
+}
+
+// Analyze walks the accounts trie found at the given root hash and all the data tries of its accounts. It returns the
+// aggregated statistics of the accounts trie and of the data tries, alongside the numTopAccounts accounts having the
+// biggest data tries, both by serialized size and by number of leaves. The provided trie is only used to access the
+// trie storage, so it can be any trie using the accounts trie storage
+func (ta *trieAnalyzer) Analyze(accountsTrie data.Trie, rootHash []byte, numTopAccounts uint32) (*api.TrieAnalysis, error) {
+	if check.IfNil(accountsTrie) {
+		return nil, ErrNilTrie
+	}
+
+	analysis := &api.TrieAnalysis{
+		RootHash:            hex.EncodeToString(rootHash),
+		DataTries:           data.NewTrieStatisticsDTO(),
+		TopAccountsBySize:   make([]*api.AccountTrieSize, 0),
+		TopAccountsByLeaves: make([]*api.AccountTrieSize, 0),
+	}
+
+	var dataTrieErr error
+	accountsTrieStats, err := accountsTrie.GetStatistics(rootHash, func(key []byte, value []byte) {
+		if dataTrieErr != nil {
+			return
+		}
+
+		account := state.NewEmptyUserAccount()
+		errUnmarshal := ta.marshalizer.Unmarshal(account, value)
+		if errUnmarshal != nil {
+			log.Trace("this must be a leaf with code", "err", errUnmarshal)
+			return
+		}
+
+		analysis.NumAccounts++
+		if len(account.RootHash) == 0 {
+			return
+		}
+
+		dataTrieStats, errStats := accountsTrie.GetStatistics(account.RootHash, nil)
+		if errStats != nil {
+			dataTrieErr = errStats
+			return
+		}
+
+		analysis.NumAccountsWithDataTrie++
+		analysis.DataTries.Merge(dataTrieStats)
+
+		accountTrieSize := &api.AccountTrieSize{
+			Address:   ta.addressPubkeyConverter.Encode(key),
+			RootHash:  hex.EncodeToString(account.RootHash),
+			NumLeaves: dataTrieStats.NumLeaves,
+			NumNodes:  dataTrieStats.NumBranches + dataTrieStats.NumExtensions + dataTrieStats.NumLeaves,
+			MaxDepth:  dataTrieStats.MaxDepth,
+			Size:      dataTrieStats.TotalSize,
+		}
+		analysis.TopAccountsBySize = insertInTop(analysis.TopAccountsBySize, accountTrieSize, numTopAccounts, isBiggerBySize)
+		analysis.TopAccountsByLeaves = insertInTop(analysis.TopAccountsByLeaves, accountTrieSize, numTopAccounts, isBiggerByLeaves)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if dataTrieErr != nil {
+		return nil, dataTrieErr
+	}
+
+	analysis.AccountsTrie = accountsTrieStats
+
+	return analysis, nil
+}
+
+func isBiggerBySize(first *api.AccountTrieSize, second *api.AccountTrieSize) bool {
+	if first.Size != second.Size {
+		return first.Size > second.Size
+	}
+
+	return first.NumLeaves > second.NumLeaves
+}
+
+func isBiggerByLeaves(first *api.AccountTrieSize, second *api.AccountTrieSize) bool {
+	if first.NumLeaves != second.NumLeaves {
+		return first.NumLeaves > second.NumLeaves
+	}
+
+	return first.Size > second.Size
+}
+
+// insertInTop inserts the account in the top, which is kept sorted in decreasing order and holds at most maxSize
+// accounts. An account equal to the last one of a full top is not inserted, so the first visited accounts are kept
+func insertInTop(
+	top []*api.AccountTrieSize,
+	accountTrieSize *api.AccountTrieSize,
+	maxSize uint32,
+	isBigger func(first *api.AccountTrieSize, second *api.AccountTrieSize) bool,
+) []*api.AccountTrieSize {
+	position := sort.Search(len(top), func(i int) bool {
+		return isBigger(accountTrieSize, top[i])
+	})
+	if position >= int(maxSize) {
+		return top
+	}
+
+	top = append(top, nil)
+	copy(top[position+1:], top[position:])
+	top[position] = accountTrieSize
+
+	if len(top) > int(maxSize) {
+		top = top[:maxSize]
+	}
+
+	return top
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ta *trieAnalyzer) IsInterfaceNil() bool {
+	return ta == nil
+}
@@ -0,0 +1,173 @@
+package analyzer_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/analyzer"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const addressLen = 32
+
+func createArgsTrieAnalyzer() analyzer.ArgsTrieAnalyzer {
+	converter, _ := pubkeyConverter.NewHexPubkeyConverter(addressLen)
+
+	return analyzer.ArgsTrieAnalyzer{
+		Marshalizer:            &marshal.GogoProtoMarshalizer{},
+		AddressPubkeyConverter: converter,
+	}
+}
+
+func createAccountsDB(t *testing.T) (state.AccountsAdapter, data.Trie) {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	require.Nil(t, err)
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := &blake2b.Blake2b{}
+	tr, err := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	require.Nil(t, err)
+
+	adb, err := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	return adb, tr
+}
+
+func createAddress(index int) []byte {
+	address := make([]byte, addressLen)
+	address[addressLen-1] = byte(index)
+
+	return address
+}
+
+func saveAccount(t *testing.T, adb state.AccountsAdapter, address []byte, numDataTrieKeys int, code []byte) {
+	account, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	userAccount := account.(state.UserAccountHandler)
+	for i := 0; i < numDataTrieKeys; i++ {
+		err = userAccount.DataTrieTracker().SaveKeyValue([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		require.Nil(t, err)
+	}
+	if len(code) > 0 {
+		userAccount.SetCode(code)
+	}
+
+	err = adb.SaveAccount(userAccount)
+	require.Nil(t, err)
+}
+
+func TestNewTrieAnalyzer_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsTrieAnalyzer()
+	args.Marshalizer = nil
+
+	ta, err := analyzer.NewTrieAnalyzer(args)
+	assert.True(t, check.IfNil(ta))
+	assert.Equal(t, analyzer.ErrNilMarshalizer, err)
+}
+
+func TestNewTrieAnalyzer_NilPubkeyConverterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsTrieAnalyzer()
+	args.AddressPubkeyConverter = nil
+
+	ta, err := analyzer.NewTrieAnalyzer(args)
+	assert.True(t, check.IfNil(ta))
+	assert.Equal(t, analyzer.ErrNilPubkeyConverter, err)
+}
+
+func TestNewTrieAnalyzer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ta, err := analyzer.NewTrieAnalyzer(createArgsTrieAnalyzer())
+	assert.False(t, check.IfNil(ta))
+	assert.Nil(t, err)
+}
+
+func TestTrieAnalyzer_AnalyzeNilTrieShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ta, _ := analyzer.NewTrieAnalyzer(createArgsTrieAnalyzer())
+
+	analysis, err := ta.Analyze(nil, []byte("root hash"), 10)
+	assert.Nil(t, analysis)
+	assert.Equal(t, analyzer.ErrNilTrie, err)
+}
+
+func TestTrieAnalyzer_AnalyzeMissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, tr := createAccountsDB(t)
+	ta, _ := analyzer.NewTrieAnalyzer(createArgsTrieAnalyzer())
+
+	analysis, err := ta.Analyze(tr, []byte("missing root hash"), 10)
+	assert.Nil(t, analysis)
+	assert.NotNil(t, err)
+}
+
+func TestTrieAnalyzer_AnalyzeShouldReportTheBiggestDataTries(t *testing.T) {
+	t.Parallel()
+
+	adb, tr := createAccountsDB(t)
+	saveAccount(t, adb, createAddress(1), 0, nil)
+	saveAccount(t, adb, createAddress(2), 5, nil)
+	saveAccount(t, adb, createAddress(3), 50, []byte("contract code"))
+	saveAccount(t, adb, createAddress(4), 20, nil)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	ta, _ := analyzer.NewTrieAnalyzer(createArgsTrieAnalyzer())
+
+	analysis, err := ta.Analyze(tr, rootHash, 2)
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString(rootHash), analysis.RootHash)
+	assert.Equal(t, uint64(4), analysis.NumAccounts)
+	assert.Equal(t, uint64(3), analysis.NumAccountsWithDataTrie)
+	assert.Equal(t, uint64(5), analysis.AccountsTrie.NumLeaves)
+	assert.Equal(t, uint64(75), analysis.DataTries.NumLeaves)
+
+	require.Equal(t, 2, len(analysis.TopAccountsBySize))
+	assert.Equal(t, hex.EncodeToString(createAddress(3)), analysis.TopAccountsBySize[0].Address)
+	assert.Equal(t, uint64(50), analysis.TopAccountsBySize[0].NumLeaves)
+	assert.Equal(t, hex.EncodeToString(createAddress(4)), analysis.TopAccountsBySize[1].Address)
+	assert.True(t, analysis.TopAccountsBySize[0].Size > analysis.TopAccountsBySize[1].Size)
+
+	require.Equal(t, 2, len(analysis.TopAccountsByLeaves))
+	assert.Equal(t, hex.EncodeToString(createAddress(3)), analysis.TopAccountsByLeaves[0].Address)
+	assert.Equal(t, hex.EncodeToString(createAddress(4)), analysis.TopAccountsByLeaves[1].Address)
+	assert.Equal(t, uint64(20), analysis.TopAccountsByLeaves[1].NumLeaves)
+}
+
+func TestTrieAnalyzer_AnalyzeZeroTopAccountsShouldOnlyReportStatistics(t *testing.T) {
+	t.Parallel()
+
+	adb, tr := createAccountsDB(t)
+	saveAccount(t, adb, createAddress(1), 10, nil)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	ta, _ := analyzer.NewTrieAnalyzer(createArgsTrieAnalyzer())
+
+	analysis, err := ta.Analyze(tr, rootHash, 0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), analysis.NumAccountsWithDataTrie)
+	assert.Equal(t, uint64(10), analysis.DataTries.NumLeaves)
+	assert.Equal(t, 0, len(analysis.TopAccountsBySize))
+	assert.Equal(t, 0, len(analysis.TopAccountsByLeaves))
+}
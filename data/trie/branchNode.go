@@ -5,7 +5,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
-	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -159,57 +158,13 @@ func (bn *branchNode) setRootHash() error {
 		return nil
 	}
 
-	var wg sync.WaitGroup
-	errc := make(chan error, nrOfChildren)
-
-	for i := 0; i < nrOfChildren; i++ {
-		if bn.children[i] != nil {
-			wg.Add(1)
-			go bn.children[i].setHashConcurrent(&wg, errc)
-		}
-	}
-	wg.Wait()
-	if len(errc) != 0 {
-		for err = range errc {
-			return err
-		}
-	}
-
-	hashed, err := bn.hashNode()
+	hash, err := hashChildrenAndNode(bn)
 	if err != nil {
 		return err
 	}
 
-	bn.hash = hashed
-	return nil
-}
-
-func (bn *branchNode) setHashConcurrent(wg *sync.WaitGroup, c chan error) {
-	defer wg.Done()
-	err := bn.isEmptyOrNil()
-	if err != nil {
-		c <- fmt.Errorf("setHashConcurrent error %w", err)
-		return
-	}
-	if bn.getHash() != nil {
-		return
-	}
-	if bn.isCollapsed() {
-		var hash []byte
-		hash, err = encodeNodeAndGetHash(bn)
-		if err != nil {
-			c <- err
-			return
-		}
-		bn.hash = hash
-		return
-	}
-	hash, err := hashChildrenAndNode(bn)
-	if err != nil {
-		c <- err
-		return
-	}
 	bn.hash = hash
+	return nil
 }
 
 func (bn *branchNode) hashChildren() error {
@@ -217,15 +172,17 @@ func (bn *branchNode) hashChildren() error {
 	if err != nil {
 		return fmt.Errorf("hashChildren error %w", err)
 	}
+
+	positions := make([]int, 0, nrOfChildren)
 	for i := 0; i < nrOfChildren; i++ {
-		if bn.children[i] != nil {
-			err = bn.children[i].setHash()
-			if err != nil {
-				return err
-			}
+		if bn.children[i] != nil && bn.children[i].getHash() == nil {
+			positions = append(positions, i)
 		}
 	}
-	return nil
+
+	return getBranchChildrenWorkers().process(positions, func(pos int) error {
+		return bn.children[pos].setHash()
+	})
 }
 
 func (bn *branchNode) hashNode() ([]byte, error) {
@@ -258,6 +215,7 @@ func (bn *branchNode) commit(force bool, level byte, maxTrieLevelInMemory uint,
 		return nil
 	}
 
+	positions := make([]int, 0, nrOfChildren)
 	for i := range bn.children {
 		if force {
 			err = resolveIfCollapsed(bn, byte(i), originDb)
@@ -269,11 +227,18 @@ func (bn *branchNode) commit(force bool, level byte, maxTrieLevelInMemory uint,
 		if bn.children[i] == nil {
 			continue
 		}
-
-		err = bn.children[i].commit(force, level, maxTrieLevelInMemory, originDb, targetDb)
-		if err != nil {
-			return err
+		if !force && !bn.children[i].isDirty() {
+			continue
 		}
+
+		positions = append(positions, i)
+	}
+
+	err = getBranchChildrenWorkers().process(positions, func(pos int) error {
+		return bn.children[pos].commit(force, level, maxTrieLevelInMemory, originDb, targetDb)
+	})
+	if err != nil {
+		return err
 	}
 	bn.dirty = false
 	err = encodeNodeAndCommitToDB(bn, targetDb)
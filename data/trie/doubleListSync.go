@@ -34,6 +34,9 @@ type doubleListTrieSyncer struct {
 	maxHardCapForMissingNodes int
 	existingNodes             map[string]node
 	missingHashes             map[string]struct{}
+	syncProgressStorer        SyncProgressStorer
+	syncProgress              *syncProgress
+	timeBetweenProgressSaves  time.Duration
 }
 
 // NewDoubleListTrieSyncer creates a new instance of trieSyncer that uses 2 list for keeping the "margin" nodes.
@@ -58,6 +61,8 @@ func NewDoubleListTrieSyncer(arg ArgTrieSyncer) (*doubleListTrieSyncer, error) {
 		trieSyncStatistics:        arg.TrieSyncStatistics,
 		timeoutBetweenCommits:     arg.TimeoutBetweenTrieNodesCommits,
 		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
+		syncProgressStorer:        arg.SyncProgressStorer,
+		timeBetweenProgressSaves:  timeBetweenSyncProgressSaves,
 	}
 
 	return d, nil
@@ -65,7 +70,7 @@ func NewDoubleListTrieSyncer(arg ArgTrieSyncer) (*doubleListTrieSyncer, error) {
 
 // StartSyncing completes the trie, asking for missing trie nodes on the network. All concurrent calls will be serialized
 // so this function is treated as a large critical section. This was done so the inner processing can be done without using
-// other mutexes.
+// other mutexes. If a previous sync of the same root hash was interrupted, it resumes from the saved progress.
 func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
 		return nil
@@ -79,6 +84,12 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 		d.mutOperation.Unlock()
 	}()
 
+	d.syncProgress = newSyncProgress(d.syncProgressStorer, d.marshalizer, rootHash, d.timeBetweenProgressSaves)
+	if d.syncProgress.isCompleted(d.db) {
+		d.trieSyncStatistics.AddNumSkipped(1)
+		return nil
+	}
+
 	d.lastSyncedTrieNode = time.Now()
 	d.existingNodes = make(map[string]node)
 	d.missingHashes = make(map[string]struct{})
@@ -86,26 +97,53 @@ func (d *doubleListTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context
 	d.rootFound = false
 	d.rootHash = rootHash
 
-	d.missingHashes[string(rootHash)] = struct{}{}
+	pendingHashes := d.syncProgress.loadPending()
+	for _, hash := range pendingHashes {
+		d.missingHashes[string(hash)] = struct{}{}
+	}
+	if len(d.missingHashes) == 0 {
+		d.missingHashes[string(rootHash)] = struct{}{}
+	}
+	d.trieSyncStatistics.AddNumResumed(len(pendingHashes))
 
 	for {
 		isSynced, err := d.checkIsSyncedWhileProcessingMissingAndExisting()
 		if err != nil {
+			d.saveProgress(true)
 			return err
 		}
 		if isSynced {
+			d.syncProgress.markCompleted()
 			return nil
 		}
+		d.saveProgress(false)
 
 		select {
 		case <-time.After(d.waitTimeBetweenChecks):
 			continue
 		case <-ctx.Done():
+			d.saveProgress(true)
 			return ErrContextClosing
 		}
 	}
 }
 
+func (d *doubleListTrieSyncer) saveProgress(force bool) {
+	if !d.syncProgress.shouldSave(force) {
+		return
+	}
+
+	hashes := make([][]byte, 0, len(d.missingHashes)+len(d.existingNodes))
+	for hash := range d.missingHashes {
+		hashes = append(hashes, []byte(hash))
+	}
+	for hash := range d.existingNodes {
+		hashes = append(hashes, []byte(hash))
+	}
+
+	d.syncProgress.savePending(hashes)
+}
+
 func (d *doubleListTrieSyncer) checkIsSyncedWhileProcessingMissingAndExisting() (bool, error) {
 	err := d.processMissingAndExisting()
 	if err != nil {
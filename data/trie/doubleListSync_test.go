@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/mock"
 	"github.com/ElrondNetwork/elrond-go/data/trie/evictionWaitingList"
+	"github.com/ElrondNetwork/elrond-go/data/trie/statistics"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -263,3 +264,91 @@ func TestDoubleListTrieSyncer_StartSyncingPartiallyFilledTrieShouldWork(t *testi
 		require.Equal(t, keyVal, val)
 	}
 }
+
+const timeBetweenProgressSavesInTests = time.Millisecond * 300
+
+func testStartSyncingShouldResumeFromTheSavedProgress(t *testing.T, createSyncer func(arg ArgTrieSyncer) (data.TrieSyncer, error)) {
+	numKeysValues := 100
+	trSource, memUnitSource := createInMemoryTrie()
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	roothash, _ := trSource.RootHash()
+
+	arg := createMockArgument()
+	arg.TimeoutBetweenTrieNodesCommits = time.Second * 10
+	progressStorer := arg.SyncProgressStorer
+
+	exceptionHashes := make([][]byte, 0)
+	memUnitSource.RangeKeys(func(key []byte, val []byte) bool {
+		if !bytes.Equal(key, roothash) && len(exceptionHashes) < numKeysValues/2 {
+			exceptionHashes = append(exceptionHashes, key)
+		}
+		return true
+	})
+	arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, exceptionHashes)
+
+	syncer, _ := createSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*2)
+	err := syncer.StartSyncing(roothash, ctx)
+	cancelFunc()
+	require.Equal(t, ErrContextClosing, err)
+
+	_, err = progressStorer.Get(pendingSyncProgressKey(roothash))
+	require.Nil(t, err)
+
+	arg.RequestHandler = createRequesterResolver(trSource, arg.InterceptedNodes, nil)
+	tss := statistics.NewTrieSyncStatistics()
+	arg.TrieSyncStatistics = tss
+	syncer, _ = createSyncer(arg)
+	ctx, cancelFunc = context.WithTimeout(context.Background(), time.Second*30)
+	err = syncer.StartSyncing(roothash, ctx)
+	cancelFunc()
+	require.Nil(t, err)
+	assert.True(t, tss.NumResumed() > 0)
+
+	_, err = progressStorer.Get(pendingSyncProgressKey(roothash))
+	assert.NotNil(t, err)
+	_, err = progressStorer.Get(completedSyncProgressKey(roothash))
+	assert.Nil(t, err)
+
+	trie, _ := createInMemoryTrieFromDB(arg.DB.(*mock.MemDbMock))
+	trie, _ = trie.Recreate(roothash)
+	require.False(t, check.IfNil(trie))
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasher.Compute(fmt.Sprintf("%d", i))
+		val, errGet := trie.Get(keyVal)
+		require.Nil(t, errGet)
+		require.Equal(t, keyVal, val)
+	}
+
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, topic string) {
+			assert.Fail(t, "should not have requested trie nodes")
+		},
+	}
+	syncer, _ = createSyncer(arg)
+	err = syncer.StartSyncing(roothash, context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, tss.NumSkipped())
+
+	err = RemoveSyncProgress(progressStorer, roothash)
+	assert.Nil(t, err)
+	_, err = progressStorer.Get(completedSyncProgressKey(roothash))
+	assert.NotNil(t, err)
+}
+
+func TestDoubleListTrieSyncer_StartSyncingShouldResumeFromTheSavedProgress(t *testing.T) {
+	testStartSyncingShouldResumeFromTheSavedProgress(t, func(arg ArgTrieSyncer) (data.TrieSyncer, error) {
+		d, err := NewDoubleListTrieSyncer(arg)
+		d.timeBetweenProgressSaves = timeBetweenProgressSavesInTests
+		return d, err
+	})
+}
+
+func TestTrieSyncer_StartSyncingShouldResumeFromTheSavedProgress(t *testing.T) {
+	testStartSyncingShouldResumeFromTheSavedProgress(t, func(arg ArgTrieSyncer) (data.TrieSyncer, error) {
+		ts, err := NewTrieSyncer(arg)
+		ts.timeBetweenProgressSaves = timeBetweenProgressSavesInTests
+		return ts, err
+	})
+}
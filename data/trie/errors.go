@@ -91,6 +91,9 @@ var ErrInvalidLevelValue = errors.New("invalid trie level in memory value")
 // ErrNilTrieSyncStatistics signals that a nil trie sync statistics handler was provided
 var ErrNilTrieSyncStatistics = errors.New("nil trie sync statistics handler")
 
+// ErrNilSyncProgressStorer signals that a nil trie sync progress storer was provided
+var ErrNilSyncProgressStorer = errors.New("nil trie sync progress storer")
+
 // ErrContextClosing signals that the parent context requested the closing of its children
 var ErrContextClosing = errors.New("context closing")
 
@@ -102,3 +105,15 @@ var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for mi
 
 // ErrInvalidTrieSyncerVersion signals that an invalid trie syncer version was provided
 var ErrInvalidTrieSyncerVersion = errors.New("invalid trie syncer version")
+
+// ErrNilTrieDiffHandler signals that a nil trie diff handler was provided
+var ErrNilTrieDiffHandler = errors.New("nil trie diff handler")
+
+// ErrNilLeavesHandler signals that a nil trie leaves handler was provided
+var ErrNilLeavesHandler = errors.New("nil trie leaves handler")
+
+// ErrInvalidStateChunk signals that a state chunk does not match the trie it claims to be part of
+var ErrInvalidStateChunk = errors.New("invalid state chunk")
+
+// ErrSnapshotNotFound signals that no completed snapshot contains the given root hash
+var ErrSnapshotNotFound = errors.New("no snapshot contains the root hash")
@@ -6,7 +6,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
-	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -120,13 +119,6 @@ func (en *extensionNode) setHash() error {
 	return nil
 }
 
-func (en *extensionNode) setHashConcurrent(wg *sync.WaitGroup, c chan error) {
-	err := en.setHash()
-	if err != nil {
-		c <- err
-	}
-	wg.Done()
-}
 func (en *extensionNode) setRootHash() error {
 	return en.setHash()
 }
@@ -93,6 +93,7 @@ func (tc *trieCreator) Create(
 		BatchDelaySeconds: tc.evictionWaitingListCfg.DB.BatchDelaySeconds,
 		MaxBatchSize:      tc.evictionWaitingListCfg.DB.MaxBatchSize,
 		MaxOpenFiles:      tc.evictionWaitingListCfg.DB.MaxOpenFiles,
+		Compression:       storageUnit.CompressionType(tc.evictionWaitingListCfg.DB.Compression),
 	}
 	evictionDb, err := storageUnit.NewDB(arg)
 	if err != nil {
@@ -0,0 +1,131 @@
+package trie
+
+import (
+	"fmt"
+	"math/big"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.TxValidatorHandler = (*InterceptedStateChunk)(nil)
+var _ process.InterceptedData = (*InterceptedStateChunk)(nil)
+
+// InterceptedStateChunk implements intercepted data interface and is used when state chunks are intercepted
+type InterceptedStateChunk struct {
+	chunk       *StateChunk
+	buff        []byte
+	identifier  []byte
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewInterceptedStateChunk creates a new instance of InterceptedStateChunk
+func NewInterceptedStateChunk(
+	buff []byte,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*InterceptedStateChunk, error) {
+	if len(buff) == 0 {
+		return nil, ErrValueTooShort
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	chunk := &StateChunk{}
+	err := marshalizer.Unmarshal(chunk, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InterceptedStateChunk{
+		chunk:       chunk,
+		buff:        buff,
+		identifier:  stateChunkIdentifier(hasher, chunk.RootHash, chunk.StartAfterKey),
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}, nil
+}
+
+// CheckValidity checks the chunk leaves against the chunk root hash
+func (inSc *InterceptedStateChunk) CheckValidity() error {
+	return verifyStateChunk(inSc.chunk, inSc.marshalizer, inSc.hasher)
+}
+
+// IsForCurrentShard checks if the intercepted data is for the current shard
+func (inSc *InterceptedStateChunk) IsForCurrentShard() bool {
+	return true
+}
+
+// Hash returns the identifier of the chunk, computed from its root hash and its start key
+func (inSc *InterceptedStateChunk) Hash() []byte {
+	return inSc.identifier
+}
+
+// RootHash returns the root hash of the trie the chunk is part of
+func (inSc *InterceptedStateChunk) RootHash() []byte {
+	return inSc.chunk.RootHash
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (inSc *InterceptedStateChunk) IsInterfaceNil() bool {
+	return inSc == nil
+}
+
+// Type returns the type of this intercepted data
+func (inSc *InterceptedStateChunk) Type() string {
+	return "intercepted state chunk"
+}
+
+// String returns the state chunk's most important fields as string
+func (inSc *InterceptedStateChunk) String() string {
+	return fmt.Sprintf("root hash=%s, start after=%s, leaves=%d, has more=%v",
+		logger.DisplayByteSlice(inSc.chunk.RootHash),
+		logger.DisplayByteSlice(inSc.chunk.StartAfterKey),
+		len(inSc.chunk.Keys),
+		inSc.chunk.HasMore,
+	)
+}
+
+// SenderShardId returns 0
+func (inSc *InterceptedStateChunk) SenderShardId() uint32 {
+	return 0
+}
+
+// ReceiverShardId returns 0
+func (inSc *InterceptedStateChunk) ReceiverShardId() uint32 {
+	return 0
+}
+
+// Nonce return 0
+func (inSc *InterceptedStateChunk) Nonce() uint64 {
+	return 0
+}
+
+// SenderAddress returns nil
+func (inSc *InterceptedStateChunk) SenderAddress() []byte {
+	return nil
+}
+
+// Fee returns big.NewInt(0)
+func (inSc *InterceptedStateChunk) Fee() *big.Int {
+	return big.NewInt(0)
+}
+
+// SizeInBytes returns the size in bytes held by this instance
+func (inSc *InterceptedStateChunk) SizeInBytes() int {
+	return len(inSc.identifier) + 2*len(inSc.buff)
+}
+
+// Identifiers returns the identifiers used in requests. The root hash is used, as it is the one white listed for
+// all the chunks of a trie
+func (inSc *InterceptedStateChunk) Identifiers() [][]byte {
+	return [][]byte{inSc.chunk.RootHash}
+}
@@ -0,0 +1,77 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getSerializedStateChunk(t *testing.T, maxSize uint64) ([]byte, *StateChunk) {
+	tr, rootHash := newCommittedTrieForStateChunks(t, 100)
+	chunk, err := createStateChunk(rootHash, nil, maxSize, tr.trieStorage.Database(), tr.marshalizer, tr.hasher)
+	require.Nil(t, err)
+
+	buff, err := tr.marshalizer.Marshal(chunk)
+	require.Nil(t, err)
+
+	return buff, chunk
+}
+
+func TestNewInterceptedStateChunk_EmptyBufferShouldFail(t *testing.T) {
+	t.Parallel()
+
+	marshalizer, hasher := getTestMarshalizerAndHasher()
+	interceptedChunk, err := NewInterceptedStateChunk([]byte{}, marshalizer, hasher)
+	assert.True(t, check.IfNil(interceptedChunk))
+	assert.Equal(t, ErrValueTooShort, err)
+}
+
+func TestNewInterceptedStateChunk_NilMarshalizerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	_, hasher := getTestMarshalizerAndHasher()
+	interceptedChunk, err := NewInterceptedStateChunk([]byte("chunk"), nil, hasher)
+	assert.True(t, check.IfNil(interceptedChunk))
+	assert.Equal(t, ErrNilMarshalizer, err)
+}
+
+func TestNewInterceptedStateChunk_NilHasherShouldFail(t *testing.T) {
+	t.Parallel()
+
+	marshalizer, _ := getTestMarshalizerAndHasher()
+	interceptedChunk, err := NewInterceptedStateChunk([]byte("chunk"), marshalizer, nil)
+	assert.True(t, check.IfNil(interceptedChunk))
+	assert.Equal(t, ErrNilHasher, err)
+}
+
+func TestNewInterceptedStateChunk_OkParametersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	buff, chunk := getSerializedStateChunk(t, 200)
+	marshalizer, hasher := getTestMarshalizerAndHasher()
+
+	interceptedChunk, err := NewInterceptedStateChunk(buff, marshalizer, hasher)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(interceptedChunk))
+	assert.Nil(t, interceptedChunk.CheckValidity())
+	assert.Equal(t, stateChunkIdentifier(hasher, chunk.RootHash, nil), interceptedChunk.Hash())
+	assert.Equal(t, [][]byte{chunk.RootHash}, interceptedChunk.Identifiers())
+	assert.Equal(t, chunk.RootHash, interceptedChunk.RootHash())
+	assert.True(t, interceptedChunk.IsForCurrentShard())
+	assert.True(t, interceptedChunk.SizeInBytes() > len(buff))
+}
+
+func TestInterceptedStateChunk_CheckValidityOfTamperedChunkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, chunk := getSerializedStateChunk(t, 200)
+	marshalizer, hasher := getTestMarshalizerAndHasher()
+	chunk.Values[0] = []byte("tampered")
+	buff, _ := marshalizer.Marshal(chunk)
+
+	interceptedChunk, err := NewInterceptedStateChunk(buff, marshalizer, hasher)
+	require.Nil(t, err)
+	assert.NotNil(t, interceptedChunk.CheckValidity())
+}
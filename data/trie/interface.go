@@ -3,7 +3,6 @@ package trie
 import (
 	"context"
 	"io"
-	"sync"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -16,7 +15,6 @@ type node interface {
 	getHash() []byte
 	setHash() error
 	setGivenHash([]byte)
-	setHashConcurrent(wg *sync.WaitGroup, c chan error)
 	setRootHash() error
 	getCollapsed() (node, error) // a collapsed node is a node that instead of the children holds the children hashes
 	isCollapsed() bool
@@ -67,6 +65,15 @@ type snapshotNode interface {
 // RequestHandler defines the methods through which request to data can be made
 type RequestHandler interface {
 	RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string)
+	RequestStateChunk(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string)
 	RequestInterval() time.Duration
 	IsInterfaceNil() bool
 }
+
+// SyncProgressStorer defines the storage operations needed for persisting the progress of a trie sync
+type SyncProgressStorer interface {
+	Put(key, data []byte) error
+	Get(key []byte) ([]byte, error)
+	Remove(key []byte) error
+	IsInterfaceNil() bool
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
-	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -87,14 +86,6 @@ func (ln *leafNode) setHash() error {
 	return nil
 }
 
-func (ln *leafNode) setHashConcurrent(wg *sync.WaitGroup, c chan error) {
-	err := ln.setHash()
-	if err != nil {
-		c <- err
-	}
-	wg.Done()
-}
-
 func (ln *leafNode) setRootHash() error {
 	return ln.setHash()
 }
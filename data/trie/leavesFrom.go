@@ -0,0 +1,73 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// iterateLeavesFrom calls the handler, in iteration order, for each leaf found below the given node whose hex key is
+// not lower than the given one. The subtries placed entirely before the lower key are skipped without being loaded
+// from the database. The returned flag is false if the handler stopped the iteration
+func iterateLeavesFrom(
+	n node,
+	position []byte,
+	lower []byte,
+	db data.DBWriteCacher,
+	handler func(key []byte, value []byte) bool,
+) (bool, error) {
+	err := n.isEmptyOrNil()
+	if err != nil {
+		return false, err
+	}
+
+	switch currentNode := n.(type) {
+	case *branchNode:
+		for i := range currentNode.children {
+			childPosition := concat(position, byte(i))
+			if positionInChunkRange(childPosition, lower, nil) == outsideChunkRange {
+				continue
+			}
+
+			err = resolveIfCollapsed(currentNode, byte(i), db)
+			if err != nil {
+				return false, err
+			}
+			if currentNode.children[i] == nil {
+				continue
+			}
+
+			shouldContinue, errIterate := iterateLeavesFrom(currentNode.children[i], childPosition, lower, db, handler)
+			if errIterate != nil || !shouldContinue {
+				return shouldContinue, errIterate
+			}
+
+			currentNode.children[i] = nil
+		}
+		return true, nil
+	case *extensionNode:
+		childPosition := concat(position, currentNode.Key...)
+		if positionInChunkRange(childPosition, lower, nil) == outsideChunkRange {
+			return true, nil
+		}
+
+		err = resolveIfCollapsed(currentNode, 0, db)
+		if err != nil {
+			return false, err
+		}
+
+		return iterateLeavesFrom(currentNode.child, childPosition, lower, db, handler)
+	case *leafNode:
+		hexKey := concat(position, currentNode.Key...)
+		if !isKeyInChunkRange(hexKey, lower, nil) {
+			return true, nil
+		}
+
+		key, errConvert := hexToKeyBytes(hexKey)
+		if errConvert != nil {
+			return false, errConvert
+		}
+
+		return handler(key, currentNode.Value), nil
+	default:
+		return false, ErrInvalidNode
+	}
+}
@@ -0,0 +1,61 @@
+package trie
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type getCounterDB struct {
+	data.DBWriteCacher
+	numGets int
+}
+
+func (db *getCounterDB) Get(key []byte) ([]byte, error) {
+	db.numGets++
+	return db.DBWriteCacher.Get(key)
+}
+
+func countLoadedNodes(t *testing.T, trSource *patriciaMerkleTrie, rootHash []byte, startKey []byte) (int, int) {
+	db := &getCounterDB{DBWriteCacher: trSource.trieStorage.Database()}
+	root, err := getNodeFromDBAndDecode(rootHash, db, marshalizer, hasher)
+	require.Nil(t, err)
+
+	var lower []byte
+	if len(startKey) > 0 {
+		lower = keyBytesToHex(startKey)
+	}
+
+	numLeaves := 0
+	_, err = iterateLeavesFrom(root, []byte{}, lower, db, func(_ []byte, _ []byte) bool {
+		numLeaves++
+		return true
+	})
+	require.Nil(t, err)
+
+	return db.numGets, numLeaves
+}
+
+func TestIterateLeavesFrom_ShouldNotLoadTheNodesBeforeTheStartKey(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 1000
+	trSource, rootHash := createSourceTrieForStateChunks(numKeysValues)
+
+	keys := make([][]byte, 0, numKeysValues)
+	err := trSource.IterateLeavesFrom(rootHash, nil, func(key []byte, _ []byte) bool {
+		keys = append(keys, key)
+		return true
+	})
+	require.Nil(t, err)
+	require.Equal(t, numKeysValues, len(keys))
+
+	numLoadedForAll, numLeavesForAll := countLoadedNodes(t, trSource, rootHash, nil)
+	assert.Equal(t, numKeysValues, numLeavesForAll)
+
+	numLoadedForLast, numLeavesForLast := countLoadedNodes(t, trSource, rootHash, keys[numKeysValues-1])
+	assert.Equal(t, 1, numLeavesForLast)
+	assert.True(t, numLoadedForLast*10 < numLoadedForAll)
+}
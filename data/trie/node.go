@@ -2,6 +2,7 @@
 package trie
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
@@ -188,6 +189,12 @@ func childPosOutOfRange(pos byte) bool {
 	return pos >= nrOfChildren
 }
 
+// CompareKeysInIterationOrder compares two keys by the order in which their leaves are reached when the trie is
+// iterated. The result is 0 if a == b, -1 if a comes before b and +1 if a comes after b
+func CompareKeysInIterationOrder(a []byte, b []byte) int {
+	return bytes.Compare(keyBytesToHex(a), keyBytesToHex(b))
+}
+
 // keyBytesToHex transforms key bytes into hex nibbles. The key nibbles are reversed, meaning that the
 // last key nibble will be the first in the hex key. A hex terminator is added at the end of the hex key.
 func keyBytesToHex(str []byte) []byte {
@@ -558,6 +558,29 @@ func (tr *patriciaMerkleTrie) GetSerializedNodes(rootHash []byte, maxBuffToSend
 	return nodes, remainingSpace, nil
 }
 
+// GetSerializedStateChunk returns the serialized chunk of leaves that come after the given key in the trie with the
+// given root hash. Chunks are only served from a completed snapshot, so that the leaves do not change while a peer
+// syncs the trie chunk by chunk
+func (tr *patriciaMerkleTrie) GetSerializedStateChunk(rootHash []byte, startAfterKey []byte, maxBuffToSend uint64) ([]byte, error) {
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	log.Trace("GetSerializedStateChunk", "rootHash", rootHash, "startAfterKey", startAfterKey)
+
+	db := tr.trieStorage.GetSnapshotThatContainsHash(rootHash)
+	if db == nil {
+		return nil, ErrSnapshotNotFound
+	}
+	defer db.DecreaseNumReferences()
+
+	chunk, err := createStateChunk(rootHash, startAfterKey, maxBuffToSend, db, tr.marshalizer, tr.hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return tr.marshalizer.Marshal(chunk)
+}
+
 // GetAllLeavesOnChannel adds all the trie leaves to the given channel
 func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(rootHash []byte, ctx context.Context) (chan core.KeyValueHolder, error) {
 	leavesChannel := make(chan core.KeyValueHolder, 100)
@@ -596,6 +619,113 @@ func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(rootHash []byte, ctx context
 	return leavesChannel, nil
 }
 
+// IterateLeavesFrom calls the handler, in iteration order, for each leaf of the trie found at the given root hash,
+// starting with the leaf having the provided key or with the first one after it. The nodes placed before the start
+// key are not loaded, so resuming an iteration does not walk the already visited leaves again. An empty start key
+// means that all the leaves are iterated. The iteration stops early if the handler returns false
+func (tr *patriciaMerkleTrie) IterateLeavesFrom(rootHash []byte, startKey []byte, handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return ErrNilLeavesHandler
+	}
+
+	tr.mutOperation.RLock()
+
+	newTrie, err := tr.recreate(rootHash)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return err
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.RLock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.RUnlock()
+	}()
+
+	if check.IfNil(newTrie) || newTrie.root == nil {
+		return nil
+	}
+
+	var lower []byte
+	if len(startKey) > 0 {
+		lower = keyBytesToHex(startKey)
+	}
+
+	_, err = iterateLeavesFrom(newTrie.root, []byte{}, lower, tr.trieStorage.Database(), handler)
+
+	return err
+}
+
+// Diff walks the tries with the given root hashes at the same time and calls the handler for each leaf that was
+// added, modified or deleted. The subtries having the same hash under both root hashes are skipped. The walk stops
+// early if the handler returns false
+func (tr *patriciaMerkleTrie) Diff(fromRootHash []byte, toRootHash []byte, handler func(data.TrieLeafDiff) bool) error {
+	if handler == nil {
+		return ErrNilTrieDiffHandler
+	}
+
+	tr.mutOperation.RLock()
+
+	fromTrie, err := tr.recreate(fromRootHash)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return err
+	}
+
+	toTrie, err := tr.recreate(toRootHash)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return err
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.RLock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.RUnlock()
+	}()
+
+	return diffTries(fromTrie.root, toTrie.root, tr.trieStorage.Database(), handler)
+}
+
+// GetStatistics walks the trie found at the given root hash and returns its node counts per type and depth, the
+// fan-out of its branch nodes and its serialized size. The leaf handler, if provided, is called for each leaf
+func (tr *patriciaMerkleTrie) GetStatistics(rootHash []byte, leafHandler func(key []byte, value []byte)) (*data.TrieStatisticsDTO, error) {
+	tr.mutOperation.RLock()
+
+	newTrie, err := tr.recreate(rootHash)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return nil, err
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.RLock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.RUnlock()
+	}()
+
+	stats := data.NewTrieStatisticsDTO()
+	if newTrie.root == nil {
+		return stats, nil
+	}
+
+	err = collectTrieStatistics(newTrie.root, 0, []byte{}, tr.trieStorage.Database(), stats, leafHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // GetAllHashes returns all the hashes from the trie
 func (tr *patriciaMerkleTrie) GetAllHashes() ([][]byte, error) {
 	tr.mutOperation.Lock()
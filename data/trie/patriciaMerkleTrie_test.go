@@ -483,6 +483,40 @@ func TestPatriciaMerkleTrie_GetSerializedNodesGetFromSnapshot(t *testing.T) {
 	assert.Equal(t, expectedNodes, len(serializedNodes))
 }
 
+func TestPatriciaMerkleTrie_GetSerializedStateChunkWithoutSnapshotShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	serializedChunk, err := tr.GetSerializedStateChunk(rootHash, nil, 500)
+	assert.Nil(t, serializedChunk)
+	assert.Equal(t, trie.ErrSnapshotNotFound, err)
+}
+
+func TestPatriciaMerkleTrie_GetSerializedStateChunkGetFromSnapshot(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	storageManager := tr.GetStorageManager()
+	storageManager.TakeSnapshot(rootHash)
+	time.Sleep(time.Second)
+
+	serializedChunk, err := tr.GetSerializedStateChunk(rootHash, nil, 500)
+	require.Nil(t, err)
+
+	chunk := &trie.StateChunk{}
+	err = (&mock.ProtobufMarshalizerMock{}).Unmarshal(chunk, serializedChunk)
+	require.Nil(t, err)
+	assert.Equal(t, rootHash, chunk.RootHash)
+	assert.Equal(t, 3, len(chunk.Keys))
+	assert.False(t, chunk.HasMore)
+}
+
 func TestPatriciaMerkleTrie_String(t *testing.T) {
 	t.Parallel()
 
@@ -664,6 +698,117 @@ func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	assert.Equal(t, leaves, recovered)
 }
 
+func TestPatriciaMerkleTrie_GetAllLeavesOnChannelIsOrderedByCompareKeysInIterationOrder(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	numLeaves := 200
+	for i := 0; i < numLeaves; i++ {
+		key := []byte(strconv.Itoa(i * 7919))
+		_ = tr.Update(key, key)
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash, context.Background())
+	assert.Nil(t, err)
+
+	var previousKey []byte
+	numRecovered := 0
+	for leaf := range leavesChannel {
+		if previousKey != nil {
+			assert.Equal(t, -1, trie.CompareKeysInIterationOrder(previousKey, leaf.Key()))
+		}
+		previousKey = leaf.Key()
+		numRecovered++
+	}
+	assert.Equal(t, numLeaves, numRecovered)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesFromNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.RootHash()
+
+	err := tr.IterateLeavesFrom(rootHash, nil, nil)
+	assert.Equal(t, trie.ErrNilLeavesHandler, err)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesFromShouldResumeFromTheStartKey(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+	numLeaves := 200
+	for i := 0; i < numLeaves; i++ {
+		key := []byte(strconv.Itoa(i * 7919))
+		_ = tr.Update(key, key)
+	}
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	allKeys := make([][]byte, 0, numLeaves)
+	err := tr.IterateLeavesFrom(rootHash, nil, func(key []byte, value []byte) bool {
+		assert.Equal(t, key, value)
+		allKeys = append(allKeys, key)
+		return true
+	})
+	require.Nil(t, err)
+	require.Equal(t, numLeaves, len(allKeys))
+	for i := 1; i < numLeaves; i++ {
+		assert.Equal(t, -1, trie.CompareKeysInIterationOrder(allKeys[i-1], allKeys[i]))
+	}
+
+	startIndex := 120
+	resumedKeys := make([][]byte, 0)
+	err = tr.IterateLeavesFrom(rootHash, allKeys[startIndex], func(key []byte, _ []byte) bool {
+		resumedKeys = append(resumedKeys, key)
+		return true
+	})
+	require.Nil(t, err)
+	assert.Equal(t, allKeys[startIndex:], resumedKeys)
+
+	numCalls := 0
+	err = tr.IterateLeavesFrom(rootHash, allKeys[startIndex], func(_ []byte, _ []byte) bool {
+		numCalls++
+		return numCalls < 5
+	})
+	require.Nil(t, err)
+	assert.Equal(t, 5, numCalls)
+}
+
+func TestPatriciaMerkleTrie_IterateLeavesFromMissingStartKeyShouldStartWithTheNextLeaf(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	allKeys := make([][]byte, 0)
+	_ = tr.IterateLeavesFrom(rootHash, nil, func(key []byte, _ []byte) bool {
+		allKeys = append(allKeys, key)
+		return true
+	})
+
+	missingKey := append(append([]byte{}, allKeys[0]...), 0)
+	resumedKeys := make([][]byte, 0)
+	err := tr.IterateLeavesFrom(rootHash, missingKey, func(key []byte, _ []byte) bool {
+		resumedKeys = append(resumedKeys, key)
+		return true
+	})
+	require.Nil(t, err)
+	for _, key := range resumedKeys {
+		assert.Equal(t, 1, trie.CompareKeysInIterationOrder(key, missingKey))
+	}
+	expectedKeys := make([][]byte, 0)
+	for _, key := range allKeys {
+		if trie.CompareKeysInIterationOrder(key, missingKey) > 0 {
+			expectedKeys = append(expectedKeys, key)
+		}
+	}
+	assert.Equal(t, expectedKeys, resumedKeys)
+}
+
 func TestPatriciaMerkleTree_Prove(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,382 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. stateChunk.proto
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+type chunkRangePosition int
+
+const (
+	outsideChunkRange chunkRangePosition = iota
+	insideChunkRange
+	onChunkRangeBoundary
+)
+
+// stateChunkIdentifier returns the key under which the chunk that starts after the given key is kept in the pool
+func stateChunkIdentifier(hasher hashing.Hasher, rootHash []byte, startAfterKey []byte) []byte {
+	return hasher.Compute(string(rootHash) + string(startAfterKey))
+}
+
+// lowerBoundOfChunk returns the smallest hex key that can be contained by the chunk that starts after the given key
+func lowerBoundOfChunk(startAfterKey []byte) []byte {
+	if len(startAfterKey) == 0 {
+		return nil
+	}
+
+	return append(keyBytesToHex(startAfterKey), 0)
+}
+
+// positionInChunkRange tells if all the leaves below the given trie position are between the lower and the upper
+// hex keys (both inclusive), if none of them are, or if the position is on the path of one of the bounds. A nil
+// upper bound means that the range has no end
+func positionInChunkRange(position []byte, lower []byte, upper []byte) chunkRangePosition {
+	isBeforeLower := bytes.Compare(position, lower) < 0
+	if isBeforeLower && !bytes.HasPrefix(lower, position) {
+		return outsideChunkRange
+	}
+	if upper == nil {
+		if isBeforeLower {
+			return onChunkRangeBoundary
+		}
+		return insideChunkRange
+	}
+
+	comparedToUpper := bytes.Compare(position, upper)
+	if comparedToUpper > 0 {
+		return outsideChunkRange
+	}
+	if !isBeforeLower && comparedToUpper < 0 && !bytes.HasPrefix(upper, position) {
+		return insideChunkRange
+	}
+
+	return onChunkRangeBoundary
+}
+
+func isKeyInChunkRange(hexKey []byte, lower []byte, upper []byte) bool {
+	if bytes.Compare(hexKey, lower) < 0 {
+		return false
+	}
+
+	return upper == nil || bytes.Compare(hexKey, upper) <= 0
+}
+
+type stateChunkBuilder struct {
+	db          data.DBWriteCacher
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	maxSize     uint64
+	size        uint64
+	lower       []byte
+	upper       []byte
+	chunk       *StateChunk
+}
+
+// createStateChunk gathers, in iteration order, the leaves that come after the given key until the chunk reaches the
+// maximum size, together with the nodes on the paths of the first and the last leaf. Those are the only nodes a
+// receiver can not rebuild from the leaves themselves when checking the chunk against the root hash. At least one
+// leaf is added to a chunk, regardless of its size
+func createStateChunk(
+	rootHash []byte,
+	startAfterKey []byte,
+	maxSize uint64,
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*StateChunk, error) {
+	builder := &stateChunkBuilder{
+		db:          db,
+		marshalizer: marshalizer,
+		hasher:      hasher,
+		maxSize:     maxSize,
+		lower:       lowerBoundOfChunk(startAfterKey),
+		chunk: &StateChunk{
+			RootHash:      rootHash,
+			StartAfterKey: startAfterKey,
+			Keys:          make([][]byte, 0),
+			Values:        make([][]byte, 0),
+			Proof:         make([][]byte, 0),
+		},
+	}
+
+	_, err := builder.addLeaves(rootHash, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if builder.chunk.HasMore {
+		lastKey := builder.chunk.Keys[len(builder.chunk.Keys)-1]
+		builder.upper = keyBytesToHex(lastKey)
+	}
+
+	err = builder.addProof(rootHash, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.chunk, nil
+}
+
+func (scb *stateChunkBuilder) getNode(hash []byte) (node, error) {
+	return getNodeFromDBAndDecode(hash, scb.db, scb.marshalizer, scb.hasher)
+}
+
+// addLeaves returns true when the chunk is full
+func (scb *stateChunkBuilder) addLeaves(hash []byte, position []byte) (bool, error) {
+	if positionInChunkRange(position, scb.lower, nil) == outsideChunkRange {
+		return false, nil
+	}
+
+	n, err := scb.getNode(hash)
+	if err != nil {
+		return false, err
+	}
+
+	switch currentNode := n.(type) {
+	case *branchNode:
+		for i, childHash := range currentNode.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+
+			isFull, errAdd := scb.addLeaves(childHash, concat(position, byte(i)))
+			if errAdd != nil || isFull {
+				return isFull, errAdd
+			}
+		}
+		return false, nil
+	case *extensionNode:
+		return scb.addLeaves(currentNode.EncodedChild, concat(position, currentNode.Key...))
+	case *leafNode:
+		return scb.addLeaf(concat(position, currentNode.Key...), currentNode.Value)
+	default:
+		return false, ErrInvalidNode
+	}
+}
+
+func (scb *stateChunkBuilder) addLeaf(hexKey []byte, value []byte) (bool, error) {
+	if !isKeyInChunkRange(hexKey, scb.lower, nil) {
+		return false, nil
+	}
+
+	leafSize := uint64(len(hexKey)/2 + len(value))
+	if len(scb.chunk.Keys) > 0 && scb.size+leafSize > scb.maxSize {
+		scb.chunk.HasMore = true
+		return true, nil
+	}
+
+	key, err := hexToKeyBytes(hexKey)
+	if err != nil {
+		return false, err
+	}
+
+	scb.chunk.Keys = append(scb.chunk.Keys, key)
+	scb.chunk.Values = append(scb.chunk.Values, value)
+	scb.size += leafSize
+
+	return false, nil
+}
+
+func (scb *stateChunkBuilder) addProof(hash []byte, position []byte) error {
+	if positionInChunkRange(position, scb.lower, scb.upper) != onChunkRangeBoundary {
+		return nil
+	}
+
+	encNode, err := scb.db.Get(hash)
+	if err != nil {
+		return fmt.Errorf("%w for state chunk proof node %x", ErrNodeNotFound, hash)
+	}
+	scb.chunk.Proof = append(scb.chunk.Proof, encNode)
+
+	n, err := decodeNode(encNode, scb.marshalizer, scb.hasher)
+	if err != nil {
+		return err
+	}
+
+	switch currentNode := n.(type) {
+	case *branchNode:
+		for i, childHash := range currentNode.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+
+			err = scb.addProof(childHash, concat(position, byte(i)))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case *extensionNode:
+		return scb.addProof(currentNode.EncodedChild, concat(position, currentNode.Key...))
+	default:
+		return nil
+	}
+}
+
+type stateChunkVerifier struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+	chunk       *StateChunk
+	hexKeys     [][]byte
+	lower       []byte
+	upper       []byte
+	proof       map[string][]byte
+	numVerified int
+}
+
+// verifyStateChunk checks that the chunk leaves are exactly the leaves of the trie with the chunk's root hash that
+// come after the start key, up to the last leaf of the chunk. If the chunk has no more leaves after it, the range
+// extends to the end of the trie. The nodes of the trie that are entirely covered by the chunk are rebuilt from its
+// leaves, while the ones on the range boundaries must be provided in the chunk proof
+func verifyStateChunk(chunk *StateChunk, marshalizer marshal.Marshalizer, hasher hashing.Hasher) error {
+	if len(chunk.RootHash) == 0 {
+		return fmt.Errorf("%w: empty root hash", ErrInvalidStateChunk)
+	}
+	if len(chunk.Keys) != len(chunk.Values) {
+		return fmt.Errorf("%w: %d keys and %d values", ErrInvalidStateChunk, len(chunk.Keys), len(chunk.Values))
+	}
+	if chunk.HasMore && len(chunk.Keys) == 0 {
+		return fmt.Errorf("%w: no leaves in a chunk that has more after it", ErrInvalidStateChunk)
+	}
+
+	v := &stateChunkVerifier{
+		marshalizer: marshalizer,
+		hasher:      hasher,
+		chunk:       chunk,
+		hexKeys:     make([][]byte, len(chunk.Keys)),
+		lower:       lowerBoundOfChunk(chunk.StartAfterKey),
+		proof:       make(map[string][]byte, len(chunk.Proof)),
+	}
+
+	previousKey := v.lower
+	for i, key := range chunk.Keys {
+		v.hexKeys[i] = keyBytesToHex(key)
+		if bytes.Compare(v.hexKeys[i], previousKey) < 0 || (i > 0 && bytes.Equal(v.hexKeys[i], previousKey)) {
+			return fmt.Errorf("%w: keys are not in iteration order", ErrInvalidStateChunk)
+		}
+		if len(chunk.Values[i]) == 0 {
+			return fmt.Errorf("%w: empty value", ErrInvalidStateChunk)
+		}
+		previousKey = v.hexKeys[i]
+	}
+	if chunk.HasMore {
+		v.upper = v.hexKeys[len(v.hexKeys)-1]
+	}
+
+	for _, encNode := range chunk.Proof {
+		v.proof[string(hasher.Compute(string(encNode)))] = encNode
+	}
+
+	err := v.verify(chunk.RootHash, nil)
+	if err != nil {
+		return err
+	}
+	if v.numVerified != len(chunk.Keys) {
+		return fmt.Errorf("%w: %d leaves are not in the trie", ErrInvalidStateChunk, len(chunk.Keys)-v.numVerified)
+	}
+
+	return nil
+}
+
+func (v *stateChunkVerifier) verify(hash []byte, position []byte) error {
+	switch positionInChunkRange(position, v.lower, v.upper) {
+	case outsideChunkRange:
+		return nil
+	case insideChunkRange:
+		return v.verifyRebuiltSubtrie(hash, position)
+	}
+
+	encNode, ok := v.proof[string(hash)]
+	if !ok {
+		return fmt.Errorf("%w: missing proof node %x", ErrInvalidStateChunk, hash)
+	}
+
+	n, err := decodeNode(encNode, v.marshalizer, v.hasher)
+	if err != nil {
+		return err
+	}
+
+	switch currentNode := n.(type) {
+	case *branchNode:
+		if len(currentNode.EncodedChildren) != nrOfChildren {
+			return ErrInvalidNode
+		}
+		for i, childHash := range currentNode.EncodedChildren {
+			if len(childHash) == 0 {
+				continue
+			}
+
+			err = v.verify(childHash, concat(position, byte(i)))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case *extensionNode:
+		return v.verify(currentNode.EncodedChild, concat(position, currentNode.Key...))
+	case *leafNode:
+		return v.verifyLeaf(concat(position, currentNode.Key...), currentNode.Value)
+	default:
+		return ErrInvalidNode
+	}
+}
+
+func (v *stateChunkVerifier) verifyLeaf(hexKey []byte, value []byte) error {
+	if !isKeyInChunkRange(hexKey, v.lower, v.upper) {
+		return nil
+	}
+
+	isExpectedLeaf := v.numVerified < len(v.hexKeys) &&
+		bytes.Equal(v.hexKeys[v.numVerified], hexKey) &&
+		bytes.Equal(v.chunk.Values[v.numVerified], value)
+	if !isExpectedLeaf {
+		return fmt.Errorf("%w: leaf %x differs from the trie", ErrInvalidStateChunk, hexKey)
+	}
+	v.numVerified++
+
+	return nil
+}
+
+func (v *stateChunkVerifier) verifyRebuiltSubtrie(hash []byte, position []byte) error {
+	first := v.numVerified
+	last := first
+	for last < len(v.hexKeys) && bytes.HasPrefix(v.hexKeys[last], position) {
+		last++
+	}
+	if first == last {
+		return fmt.Errorf("%w: missing leaves for trie node %x", ErrInvalidStateChunk, hash)
+	}
+
+	var subtrieRoot node
+	for i := first; i < last; i++ {
+		ln, err := newLeafNode(v.hexKeys[i][len(position):], v.chunk.Values[i], v.marshalizer, v.hasher)
+		if err != nil {
+			return err
+		}
+		if subtrieRoot == nil {
+			subtrieRoot = ln
+			continue
+		}
+
+		_, subtrieRoot, _, err = subtrieRoot.insert(ln, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := subtrieRoot.setRootHash()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(subtrieRoot.getHash(), hash) {
+		return fmt.Errorf("%w: leaves do not match trie node %x", ErrInvalidStateChunk, hash)
+	}
+	v.numVerified = last
+
+	return nil
+}
@@ -0,0 +1,685 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stateChunk.proto
+
+package trie
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StateChunk holds a range of consecutive trie leaves, in iteration order, together with the proof of the range
+type StateChunk struct {
+	RootHash      []byte   `protobuf:"bytes,1,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	StartAfterKey []byte   `protobuf:"bytes,2,opt,name=StartAfterKey,proto3" json:"StartAfterKey,omitempty"`
+	Keys          [][]byte `protobuf:"bytes,3,rep,name=Keys,proto3" json:"Keys,omitempty"`
+	Values        [][]byte `protobuf:"bytes,4,rep,name=Values,proto3" json:"Values,omitempty"`
+	Proof         [][]byte `protobuf:"bytes,5,rep,name=Proof,proto3" json:"Proof,omitempty"`
+	HasMore       bool     `protobuf:"varint,6,opt,name=HasMore,proto3" json:"HasMore,omitempty"`
+}
+
+func (m *StateChunk) Reset()      { *m = StateChunk{} }
+func (*StateChunk) ProtoMessage() {}
+func (*StateChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81761071aee5f8a4, []int{0}
+}
+func (m *StateChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StateChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChunk.Merge(m, src)
+}
+func (m *StateChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChunk proto.InternalMessageInfo
+
+func (m *StateChunk) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *StateChunk) GetStartAfterKey() []byte {
+	if m != nil {
+		return m.StartAfterKey
+	}
+	return nil
+}
+
+func (m *StateChunk) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *StateChunk) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *StateChunk) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *StateChunk) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*StateChunk)(nil), "proto.StateChunk")
+}
+
+func init() { proto.RegisterFile("stateChunk.proto", fileDescriptor_81761071aee5f8a4) }
+
+var fileDescriptor_81761071aee5f8a4 = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xbf, 0x4a, 0xc4, 0x40,
+	0x10, 0xc6, 0x77, 0xbc, 0x24, 0x1e, 0xcb, 0x09, 0xb2, 0x88, 0x2c, 0x57, 0x0c, 0x41, 0x2c, 0xd2,
+	0x78, 0x57, 0xd8, 0x0b, 0x6a, 0x73, 0x70, 0x08, 0x92, 0x03, 0x0b, 0xbb, 0x44, 0x36, 0xc9, 0xe1,
+	0x9f, 0x95, 0xdd, 0x4d, 0x71, 0x9d, 0x8f, 0xe0, 0x63, 0x88, 0x4f, 0x62, 0x99, 0x32, 0xa5, 0xd9,
+	0x34, 0x96, 0xf7, 0x08, 0xc2, 0x9c, 0x1e, 0x5c, 0x35, 0xdf, 0xef, 0xc7, 0xcc, 0xc0, 0xc7, 0x0f,
+	0xad, 0xcb, 0x9c, 0xba, 0xae, 0xea, 0x97, 0xc7, 0xc9, 0xab, 0xd1, 0x4e, 0x8b, 0x90, 0xc6, 0xf8,
+	0xac, 0x5c, 0xba, 0xaa, 0xce, 0x27, 0x0f, 0xfa, 0x79, 0x5a, 0xea, 0x52, 0x4f, 0x49, 0xe7, 0x75,
+	0x41, 0x44, 0x40, 0x69, 0x73, 0x75, 0xf2, 0x09, 0x9c, 0x2f, 0xb6, 0xaf, 0xc4, 0x98, 0x0f, 0x53,
+	0xad, 0xdd, 0x2c, 0xb3, 0x95, 0x84, 0x18, 0x92, 0x51, 0xba, 0x65, 0x71, 0xca, 0x0f, 0x16, 0x2e,
+	0x33, 0xee, 0xb2, 0x70, 0xca, 0xcc, 0xd5, 0x4a, 0xee, 0xd1, 0xc2, 0xae, 0x14, 0x82, 0x07, 0x73,
+	0xb5, 0xb2, 0x72, 0x10, 0x0f, 0x92, 0x51, 0x4a, 0x59, 0x1c, 0xf3, 0xe8, 0x2e, 0x7b, 0xaa, 0x95,
+	0x95, 0x01, 0xd9, 0x3f, 0x12, 0x47, 0x3c, 0xbc, 0x35, 0x5a, 0x17, 0x32, 0x24, 0xbd, 0x01, 0x21,
+	0xf9, 0xfe, 0x2c, 0xb3, 0x37, 0xda, 0x28, 0x19, 0xc5, 0x90, 0x0c, 0xd3, 0x7f, 0xbc, 0xba, 0x68,
+	0x3a, 0x64, 0x6d, 0x87, 0x6c, 0xdd, 0x21, 0xbc, 0x79, 0x84, 0x0f, 0x8f, 0xf0, 0xe5, 0x11, 0x1a,
+	0x8f, 0xd0, 0x7a, 0x84, 0x6f, 0x8f, 0xf0, 0xe3, 0x91, 0xad, 0x3d, 0xc2, 0x7b, 0x8f, 0xac, 0xe9,
+	0x91, 0xb5, 0x3d, 0xb2, 0xfb, 0xc0, 0x99, 0xa5, 0xca, 0x23, 0xea, 0x7c, 0xfe, 0x3b, 0x00, 0x53,
+	0x8e, 0x46, 0xc2, 0x3d, 0x01, 0x00, 0x00,
+}
+
+func (this *StateChunk) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StateChunk)
+	if !ok {
+		that2, ok := that.(StateChunk)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if !bytes.Equal(this.StartAfterKey, that1.StartAfterKey) {
+		return false
+	}
+	if len(this.Keys) != len(that1.Keys) {
+		return false
+	}
+	for i := range this.Keys {
+		if !bytes.Equal(this.Keys[i], that1.Keys[i]) {
+			return false
+		}
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if !bytes.Equal(this.Values[i], that1.Values[i]) {
+			return false
+		}
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return false
+		}
+	}
+	if this.HasMore != that1.HasMore {
+		return false
+	}
+	return true
+}
+func (this *StateChunk) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&trie.StateChunk{")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "StartAfterKey: "+fmt.Sprintf("%#v", this.StartAfterKey)+",\n")
+	s = append(s, "Keys: "+fmt.Sprintf("%#v", this.Keys)+",\n")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "Proof: "+fmt.Sprintf("%#v", this.Proof)+",\n")
+	s = append(s, "HasMore: "+fmt.Sprintf("%#v", this.HasMore)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringStateChunk(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *StateChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintStateChunk(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintStateChunk(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintStateChunk(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StartAfterKey) > 0 {
+		i -= len(m.StartAfterKey)
+		copy(dAtA[i:], m.StartAfterKey)
+		i = encodeVarintStateChunk(dAtA, i, uint64(len(m.StartAfterKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintStateChunk(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStateChunk(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStateChunk(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StateChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovStateChunk(uint64(l))
+	}
+	l = len(m.StartAfterKey)
+	if l > 0 {
+		n += 1 + l + sovStateChunk(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovStateChunk(uint64(l))
+		}
+	}
+	if len(m.Values) > 0 {
+		for _, b := range m.Values {
+			l = len(b)
+			n += 1 + l + sovStateChunk(uint64(l))
+		}
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovStateChunk(uint64(l))
+		}
+	}
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func sovStateChunk(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStateChunk(x uint64) (n int) {
+	return sovStateChunk(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *StateChunk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StateChunk{`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`StartAfterKey:` + fmt.Sprintf("%v", this.StartAfterKey) + `,`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`Proof:` + fmt.Sprintf("%v", this.Proof) + `,`,
+		`HasMore:` + fmt.Sprintf("%v", this.HasMore) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStateChunk(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *StateChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateChunk
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAfterKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartAfterKey = append(m.StartAfterKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartAfterKey == nil {
+				m.StartAfterKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, make([]byte, postIndex-iNdEx))
+			copy(m.Values[len(m.Values)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateChunk
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateChunk(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStateChunk
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStateChunk(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStateChunk
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateChunk
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateChunk
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStateChunk
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStateChunk
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStateChunk
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStateChunk        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStateChunk          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStateChunk = fmt.Errorf("proto: unexpected end of group")
+)
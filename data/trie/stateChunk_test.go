@@ -0,0 +1,200 @@
+package trie
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCommittedTrieForStateChunks(t *testing.T, numLeaves int) (*patriciaMerkleTrie, []byte) {
+	marshalizer, hasher := getTestMarshalizerAndHasher()
+	tsm, _ := NewTrieStorageManagerWithoutPruning(memorydb.New())
+	tr, _ := NewTrie(tsm, marshalizer, hasher, 5)
+
+	for i := 0; i < numLeaves; i++ {
+		key := []byte(strconv.Itoa(i))
+		require.Nil(t, tr.Update(key, append([]byte("value"), key...)))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.RootHash()
+
+	return tr, rootHash
+}
+
+func createAndVerifyAllStateChunks(t *testing.T, tr *patriciaMerkleTrie, rootHash []byte, maxSize uint64) []*StateChunk {
+	chunks := make([]*StateChunk, 0)
+	startAfterKey := []byte(nil)
+	for {
+		chunk, err := createStateChunk(rootHash, startAfterKey, maxSize, tr.trieStorage.Database(), tr.marshalizer, tr.hasher)
+		require.Nil(t, err)
+		require.Nil(t, verifyStateChunk(chunk, tr.marshalizer, tr.hasher))
+
+		chunks = append(chunks, chunk)
+		if !chunk.HasMore {
+			return chunks
+		}
+		startAfterKey = chunk.Keys[len(chunk.Keys)-1]
+	}
+}
+
+func TestStateChunk_AllChunksCoverTheTrieInIterationOrder(t *testing.T) {
+	t.Parallel()
+
+	numLeaves := 300
+	tr, rootHash := newCommittedTrieForStateChunks(t, numLeaves)
+
+	chunks := createAndVerifyAllStateChunks(t, tr, rootHash, 200)
+	assert.True(t, len(chunks) > 1)
+
+	leaves := make(map[string][]byte)
+	var previousKey []byte
+	for _, chunk := range chunks {
+		for i, key := range chunk.Keys {
+			if previousKey != nil {
+				assert.Equal(t, -1, CompareKeysInIterationOrder(previousKey, key))
+			}
+			previousKey = key
+			leaves[string(key)] = chunk.Values[i]
+		}
+	}
+
+	assert.Equal(t, numLeaves, len(leaves))
+	for key, value := range leaves {
+		trieValue, _ := tr.Get([]byte(key))
+		assert.Equal(t, trieValue, value)
+	}
+}
+
+func TestStateChunk_WholeTrieInOneChunkNeedsNoProof(t *testing.T) {
+	t.Parallel()
+
+	tr, rootHash := newCommittedTrieForStateChunks(t, 50)
+
+	chunks := createAndVerifyAllStateChunks(t, tr, rootHash, 1<<20)
+	require.Equal(t, 1, len(chunks))
+	assert.Equal(t, 50, len(chunks[0].Keys))
+	assert.Equal(t, 0, len(chunks[0].Proof))
+	assert.False(t, chunks[0].HasMore)
+}
+
+func TestStateChunk_OversizedLeafIsStillSent(t *testing.T) {
+	t.Parallel()
+
+	tr, rootHash := newCommittedTrieForStateChunks(t, 20)
+
+	chunks := createAndVerifyAllStateChunks(t, tr, rootHash, 1)
+	assert.Equal(t, 20, len(chunks))
+	for _, chunk := range chunks {
+		assert.Equal(t, 1, len(chunk.Keys))
+	}
+}
+
+func TestStateChunk_StartAfterTheLastKeyReturnsAnEmptyChunk(t *testing.T) {
+	t.Parallel()
+
+	tr, rootHash := newCommittedTrieForStateChunks(t, 100)
+	chunks := createAndVerifyAllStateChunks(t, tr, rootHash, 1<<20)
+	lastKey := chunks[0].Keys[len(chunks[0].Keys)-1]
+
+	chunk, err := createStateChunk(rootHash, lastKey, 1<<20, tr.trieStorage.Database(), tr.marshalizer, tr.hasher)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(chunk.Keys))
+	assert.False(t, chunk.HasMore)
+	assert.Nil(t, verifyStateChunk(chunk, tr.marshalizer, tr.hasher))
+}
+
+func TestStateChunk_VerifyShouldDetectTampering(t *testing.T) {
+	t.Parallel()
+
+	tr, rootHash := newCommittedTrieForStateChunks(t, 300)
+	chunks := createAndVerifyAllStateChunks(t, tr, rootHash, 200)
+	require.True(t, len(chunks) > 3)
+	middle := chunks[len(chunks)/2]
+	last := chunks[len(chunks)-1]
+
+	tamperings := map[string]func() *StateChunk{
+		"changed value": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.Values[1] = []byte("changed")
+			return c
+		},
+		"removed leaf": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.Keys = append(c.Keys[:1], c.Keys[2:]...)
+			c.Values = append(c.Values[:1], c.Values[2:]...)
+			return c
+		},
+		"removed last leaf of the trie": func() *StateChunk {
+			c := cloneStateChunk(last)
+			c.Keys = c.Keys[:len(c.Keys)-1]
+			c.Values = c.Values[:len(c.Values)-1]
+			return c
+		},
+		"added leaf": func() *StateChunk {
+			c := cloneStateChunk(last)
+			c.Keys = append(c.Keys, []byte("not in trie"))
+			c.Values = append(c.Values, []byte("value"))
+			return c
+		},
+		"claims the end of the trie": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.HasMore = false
+			return c
+		},
+		"removed proof node": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.Proof = c.Proof[1:]
+			return c
+		},
+		"other root hash": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.RootHash = tr.hasher.Compute("other root")
+			return c
+		},
+		"keys not in order": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.Keys[0], c.Keys[1] = c.Keys[1], c.Keys[0]
+			c.Values[0], c.Values[1] = c.Values[1], c.Values[0]
+			return c
+		},
+		"leaf before the start key": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.StartAfterKey = c.Keys[0]
+			return c
+		},
+		"missing values": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.Values = c.Values[1:]
+			return c
+		},
+		"has more without leaves": func() *StateChunk {
+			c := cloneStateChunk(middle)
+			c.Keys = nil
+			c.Values = nil
+			return c
+		},
+	}
+
+	for name, tamper := range tamperings {
+		err := verifyStateChunk(tamper(), tr.marshalizer, tr.hasher)
+		assert.NotNil(t, err, name)
+		if err != nil && !errors.Is(err, ErrInvalidStateChunk) {
+			assert.Fail(t, "unexpected error", "%s: %v", name, err)
+		}
+	}
+}
+
+func cloneStateChunk(chunk *StateChunk) *StateChunk {
+	return &StateChunk{
+		RootHash:      chunk.RootHash,
+		StartAfterKey: chunk.StartAfterKey,
+		Keys:          append([][]byte{}, chunk.Keys...),
+		Values:        append([][]byte{}, chunk.Values...),
+		Proof:         append([][]byte{}, chunk.Proof...),
+		HasMore:       chunk.HasMore,
+	}
+}
@@ -0,0 +1,234 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ data.TrieSyncer = (*stateChunksTrieSyncer)(nil)
+
+const maxTrieLevelInMemoryWhileSyncingChunks = uint(5)
+
+// stateChunksTrieSyncer syncs a trie by asking the peers, one after the other, for the chunks of leaves held by their
+// trie snapshots. The trie is rebuilt locally from the leaves, each chunk being checked against the root hash when
+// intercepted. If no peer answers with the first chunk, the trie is synced node by node
+type stateChunksTrieSyncer struct {
+	shardId                  uint32
+	topic                    string
+	marshalizer              marshal.Marshalizer
+	hasher                   hashing.Hasher
+	db                       data.DBWriteCacher
+	requestHandler           RequestHandler
+	interceptedNodes         storage.Cacher
+	mutOperation             sync.Mutex
+	trieSyncStatistics       data.SyncStatisticsHandler
+	timeoutBetweenCommits    time.Duration
+	waitTimeBetweenChecks    time.Duration
+	waitTimeBetweenRequests  time.Duration
+	syncProgressStorer       SyncProgressStorer
+	fallbackSyncer           data.TrieSyncer
+	timeBetweenProgressSaves time.Duration
+}
+
+// NewStateChunksTrieSyncer creates a new instance of stateChunksTrieSyncer
+func NewStateChunksTrieSyncer(arg ArgTrieSyncer) (*stateChunksTrieSyncer, error) {
+	if len(arg.StateChunksTopic) == 0 {
+		return nil, fmt.Errorf("%w for the state chunks", ErrInvalidTrieTopic)
+	}
+
+	fallbackSyncer, err := NewDoubleListTrieSyncer(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateChunksTrieSyncer{
+		shardId:                  arg.ShardId,
+		topic:                    arg.StateChunksTopic,
+		marshalizer:              arg.Marshalizer,
+		hasher:                   arg.Hasher,
+		db:                       arg.DB,
+		requestHandler:           arg.RequestHandler,
+		interceptedNodes:         arg.InterceptedNodes,
+		trieSyncStatistics:       arg.TrieSyncStatistics,
+		timeoutBetweenCommits:    arg.TimeoutBetweenTrieNodesCommits,
+		waitTimeBetweenChecks:    time.Millisecond * 100,
+		waitTimeBetweenRequests:  time.Second,
+		syncProgressStorer:       arg.SyncProgressStorer,
+		fallbackSyncer:           fallbackSyncer,
+		timeBetweenProgressSaves: timeBetweenSyncProgressSaves,
+	}, nil
+}
+
+// StartSyncing completes the trie, asking for the state chunks on the network. If a previous sync of the same root
+// hash was interrupted, it resumes after the last received leaf
+func (s *stateChunksTrieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
+	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
+		return nil
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+
+	s.mutOperation.Lock()
+	defer s.mutOperation.Unlock()
+
+	progress := newSyncProgress(s.syncProgressStorer, s.marshalizer, rootHash, s.timeBetweenProgressSaves)
+	if progress.isCompleted(s.db) {
+		s.trieSyncStatistics.AddNumSkipped(1)
+		return nil
+	}
+
+	tr, startAfterKey, err := s.loadPartialTrie(progress)
+	if err != nil {
+		return err
+	}
+	isResumed := len(startAfterKey) > 0
+
+	for {
+		chunk, errGet := s.getStateChunk(rootHash, startAfterKey, ctx)
+		if errGet == ErrTimeIsOut && len(startAfterKey) == 0 && !isResumed {
+			log.Debug("no state chunk received, syncing the trie node by node", "root hash", rootHash)
+			return s.fallbackSyncer.StartSyncing(rootHash, ctx)
+		}
+		if errGet != nil {
+			s.saveProgress(tr, progress, startAfterKey, true)
+			return errGet
+		}
+
+		for i := range chunk.Keys {
+			err = tr.Update(chunk.Keys[i], chunk.Values[i])
+			if err != nil {
+				return err
+			}
+		}
+		s.trieSyncStatistics.AddNumReceived(len(chunk.Keys))
+
+		if !chunk.HasMore {
+			return s.finishSync(tr, progress, rootHash)
+		}
+
+		startAfterKey = chunk.Keys[len(chunk.Keys)-1]
+		s.saveProgress(tr, progress, startAfterKey, false)
+	}
+}
+
+func (s *stateChunksTrieSyncer) loadPartialTrie(progress *syncProgress) (*patriciaMerkleTrie, []byte, error) {
+	tsm, err := NewTrieStorageManagerWithoutPruning(s.db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tr, err := NewTrie(tsm, s.marshalizer, s.hasher, maxTrieLevelInMemoryWhileSyncingChunks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	partialRootHash, lastKey, ok := progress.loadChunksProgress()
+	if !ok {
+		return tr, nil, nil
+	}
+
+	partialTrie, err := tr.recreate(partialRootHash)
+	if err != nil {
+		log.Debug("cannot recreate the partially synced trie", "root hash", progress.rootHash, "error", err)
+		return tr, nil, nil
+	}
+	s.trieSyncStatistics.AddNumResumed(1)
+
+	return partialTrie, lastKey, nil
+}
+
+func (s *stateChunksTrieSyncer) getStateChunk(rootHash []byte, startAfterKey []byte, ctx context.Context) (*StateChunk, error) {
+	identifier := stateChunkIdentifier(s.hasher, rootHash, startAfterKey)
+	s.trieSyncStatistics.SetNumMissing(rootHash, 1)
+
+	requestTime := time.Time{}
+	startTime := time.Now()
+	for {
+		chunk, ok := s.getInterceptedStateChunk(identifier)
+		if ok {
+			s.trieSyncStatistics.SetNumMissing(rootHash, 0)
+			return chunk, nil
+		}
+
+		if time.Since(startTime) > s.timeoutBetweenCommits {
+			return nil, ErrTimeIsOut
+		}
+		if time.Since(requestTime) >= s.waitTimeBetweenRequests {
+			s.requestHandler.RequestStateChunk(s.shardId, rootHash, startAfterKey, s.topic)
+			requestTime = time.Now()
+		}
+
+		select {
+		case <-time.After(s.waitTimeBetweenChecks):
+			continue
+		case <-ctx.Done():
+			return nil, ErrContextClosing
+		}
+	}
+}
+
+func (s *stateChunksTrieSyncer) getInterceptedStateChunk(identifier []byte) (*StateChunk, bool) {
+	val, ok := s.interceptedNodes.Get(identifier)
+	if !ok {
+		return nil, false
+	}
+	s.interceptedNodes.Remove(identifier)
+
+	interceptedChunk, ok := val.(*InterceptedStateChunk)
+	if !ok {
+		return nil, false
+	}
+
+	return interceptedChunk.chunk, true
+}
+
+func (s *stateChunksTrieSyncer) saveProgress(tr *patriciaMerkleTrie, progress *syncProgress, lastKey []byte, force bool) {
+	if len(lastKey) == 0 || !progress.shouldSave(force) {
+		return
+	}
+
+	partialRootHash, err := s.commit(tr)
+	if err != nil {
+		log.Debug("cannot commit the partially synced trie", "root hash", progress.rootHash, "error", err)
+		return
+	}
+
+	progress.saveChunksProgress(partialRootHash, lastKey)
+}
+
+func (s *stateChunksTrieSyncer) finishSync(tr *patriciaMerkleTrie, progress *syncProgress, rootHash []byte) error {
+	syncedRootHash, err := s.commit(tr)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(syncedRootHash, rootHash) {
+		return fmt.Errorf("%w: synced root hash %x, expected %x", ErrInvalidStateChunk, syncedRootHash, rootHash)
+	}
+
+	progress.markCompleted()
+
+	return nil
+}
+
+func (s *stateChunksTrieSyncer) commit(tr *patriciaMerkleTrie) ([]byte, error) {
+	err := tr.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return tr.RootHash()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *stateChunksTrieSyncer) IsInterfaceNil() bool {
+	return s == nil
+}
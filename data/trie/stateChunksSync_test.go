@@ -0,0 +1,242 @@
+package trie
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie/statistics"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStateChunkMaxSize = 640
+
+func createStateChunksArgument() ArgTrieSyncer {
+	arg := createMockArgument()
+	arg.StateChunksTopic = "chunks topic"
+
+	return arg
+}
+
+func createStateChunksRequester(
+	sourceTrie *patriciaMerkleTrie,
+	interceptedNodes storage.Cacher,
+	maxNumAnsweredRequests int,
+) *mock.RequestHandlerStub {
+	numAnsweredRequests := 0
+	return &mock.RequestHandlerStub{
+		RequestStateChunkCalled: func(_ uint32, rootHash []byte, startAfterKey []byte, _ string) {
+			if numAnsweredRequests >= maxNumAnsweredRequests {
+				return
+			}
+			numAnsweredRequests++
+
+			chunk, err := createStateChunk(rootHash, startAfterKey, testStateChunkMaxSize, sourceTrie.trieStorage.Database(), marshalizer, hasher)
+			if err != nil {
+				return
+			}
+
+			buff, _ := marshalizer.Marshal(chunk)
+			interceptedChunk, err := NewInterceptedStateChunk(buff, marshalizer, hasher)
+			if err != nil {
+				return
+			}
+
+			interceptedNodes.Put(interceptedChunk.Hash(), interceptedChunk, interceptedChunk.SizeInBytes())
+		},
+	}
+}
+
+func createSourceTrieForStateChunks(numKeysValues int) (*patriciaMerkleTrie, []byte) {
+	trSource, _ := createInMemoryTrie()
+	addDataToTrie(numKeysValues, trSource)
+	_ = trSource.Commit()
+	rootHash, _ := trSource.RootHash()
+
+	return trSource.(*patriciaMerkleTrie), rootHash
+}
+
+func checkSyncedTrie(t *testing.T, arg ArgTrieSyncer, rootHash []byte, numKeysValues int) {
+	tr, _ := createInMemoryTrieFromDB(arg.DB.(*mock.MemDbMock))
+	tr, _ = tr.Recreate(rootHash)
+	require.False(t, check.IfNil(tr))
+
+	for i := 0; i < numKeysValues; i++ {
+		keyVal := hasher.Compute(fmt.Sprintf("%d", i))
+		val, err := tr.Get(keyVal)
+		require.Nil(t, err)
+		require.Equal(t, keyVal, val)
+	}
+}
+
+func TestNewStateChunksTrieSyncer_EmptyStateChunksTopicShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	s, err := NewStateChunksTrieSyncer(arg)
+	assert.True(t, check.IfNil(s))
+	assert.True(t, errors.Is(err, ErrInvalidTrieTopic))
+}
+
+func TestNewStateChunksTrieSyncer_InvalidFallbackArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createStateChunksArgument()
+	arg.RequestHandler = nil
+	s, err := NewStateChunksTrieSyncer(arg)
+	assert.True(t, check.IfNil(s))
+	assert.Equal(t, ErrNilRequestHandler, err)
+}
+
+func TestNewStateChunksTrieSyncer(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewStateChunksTrieSyncer(createStateChunksArgument())
+	assert.False(t, check.IfNil(s))
+	assert.Nil(t, err)
+}
+
+func TestStateChunksTrieSyncer_StartSyncingNilContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewStateChunksTrieSyncer(createStateChunksArgument())
+	err := s.StartSyncing([]byte("root hash"), nil)
+	assert.Equal(t, ErrNilContext, err)
+}
+
+func TestStateChunksTrieSyncer_StartSyncingEmptyRootHashShouldNotRequest(t *testing.T) {
+	t.Parallel()
+
+	arg := createStateChunksArgument()
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestStateChunkCalled: func(_ uint32, _ []byte, _ []byte, _ string) {
+			assert.Fail(t, "should not have requested state chunks")
+		},
+	}
+	s, _ := NewStateChunksTrieSyncer(arg)
+
+	assert.Nil(t, s.StartSyncing(nil, context.Background()))
+	assert.Nil(t, s.StartSyncing(EmptyTrieHash, context.Background()))
+}
+
+func TestStateChunksTrieSyncer_StartSyncingShouldRebuildTheTrieFromChunks(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	trSource, rootHash := createSourceTrieForStateChunks(numKeysValues)
+
+	arg := createStateChunksArgument()
+	arg.TimeoutBetweenTrieNodesCommits = time.Second * 10
+	requester := createStateChunksRequester(trSource, arg.InterceptedNodes, numKeysValues)
+	requester.RequestTrieNodesCalled = func(_ uint32, _ [][]byte, _ string) {
+		assert.Fail(t, "should not have requested trie nodes")
+	}
+	arg.RequestHandler = requester
+	tss := statistics.NewTrieSyncStatistics()
+	arg.TrieSyncStatistics = tss
+
+	s, _ := NewStateChunksTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	err := s.StartSyncing(rootHash, ctx)
+	cancelFunc()
+	require.Nil(t, err)
+	assert.Equal(t, numKeysValues, tss.NumReceived())
+
+	checkSyncedTrie(t, arg, rootHash, numKeysValues)
+	_, err = arg.SyncProgressStorer.Get(completedSyncProgressKey(rootHash))
+	assert.Nil(t, err)
+}
+
+func TestStateChunksTrieSyncer_StartSyncingShouldResumeFromTheSavedProgress(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	trSource, rootHash := createSourceTrieForStateChunks(numKeysValues)
+
+	arg := createStateChunksArgument()
+	arg.TimeoutBetweenTrieNodesCommits = time.Second * 10
+	slowRequester := createStateChunksRequester(trSource, arg.InterceptedNodes, 3)
+	requestStateChunkSlowly := slowRequester.RequestStateChunkCalled
+	slowRequester.RequestStateChunkCalled = func(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string) {
+		time.Sleep(timeBetweenProgressSavesInTests)
+		requestStateChunkSlowly(destShardID, rootHash, startAfterKey, topic)
+	}
+	arg.RequestHandler = slowRequester
+
+	s, _ := NewStateChunksTrieSyncer(arg)
+	s.timeBetweenProgressSaves = timeBetweenProgressSavesInTests
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*2)
+	err := s.StartSyncing(rootHash, ctx)
+	cancelFunc()
+	require.Equal(t, ErrContextClosing, err)
+
+	_, err = arg.SyncProgressStorer.Get(chunksSyncProgressKey(rootHash))
+	require.Nil(t, err)
+
+	resumedRequests := 0
+	requester := createStateChunksRequester(trSource, arg.InterceptedNodes, numKeysValues)
+	requestStateChunk := requester.RequestStateChunkCalled
+	requester.RequestStateChunkCalled = func(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string) {
+		if len(startAfterKey) == 0 {
+			assert.Fail(t, "should have resumed after the last received key")
+		}
+		resumedRequests++
+		requestStateChunk(destShardID, rootHash, startAfterKey, topic)
+	}
+	arg.RequestHandler = requester
+	tss := statistics.NewTrieSyncStatistics()
+	arg.TrieSyncStatistics = tss
+
+	s, _ = NewStateChunksTrieSyncer(arg)
+	ctx, cancelFunc = context.WithTimeout(context.Background(), time.Second*30)
+	err = s.StartSyncing(rootHash, ctx)
+	cancelFunc()
+	require.Nil(t, err)
+	assert.Equal(t, 1, tss.NumResumed())
+	assert.True(t, resumedRequests > 0)
+	assert.True(t, tss.NumReceived() < numKeysValues)
+
+	checkSyncedTrie(t, arg, rootHash, numKeysValues)
+	_, err = arg.SyncProgressStorer.Get(chunksSyncProgressKey(rootHash))
+	assert.NotNil(t, err)
+
+	arg.RequestHandler = &mock.RequestHandlerStub{
+		RequestStateChunkCalled: func(_ uint32, _ []byte, _ []byte, _ string) {
+			assert.Fail(t, "should not have requested state chunks")
+		},
+	}
+	s, _ = NewStateChunksTrieSyncer(arg)
+	err = s.StartSyncing(rootHash, context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, tss.NumSkipped())
+}
+
+func TestStateChunksTrieSyncer_StartSyncingWithoutChunksShouldSyncNodeByNode(t *testing.T) {
+	t.Parallel()
+
+	numKeysValues := 100
+	trSource, rootHash := createSourceTrieForStateChunks(numKeysValues)
+
+	arg := createStateChunksArgument()
+	requester := createRequesterResolver(trSource, arg.InterceptedNodes, nil).(*mock.RequestHandlerStub)
+	numChunkRequests := 0
+	requester.RequestStateChunkCalled = func(_ uint32, _ []byte, _ []byte, _ string) {
+		numChunkRequests++
+	}
+	arg.RequestHandler = requester
+
+	s, _ := NewStateChunksTrieSyncer(arg)
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Second*30)
+	err := s.StartSyncing(rootHash, ctx)
+	cancelFunc()
+	require.Nil(t, err)
+	assert.True(t, numChunkRequests > 0)
+
+	checkSyncedTrie(t, arg, rootHash, numKeysValues)
+}
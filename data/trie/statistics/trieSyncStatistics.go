@@ -6,6 +6,8 @@ type trieSyncStatistics struct {
 	sync.RWMutex
 	numReceived int
 	numMissing  int
+	numResumed  int
+	numSkipped  int
 	missingMap  map[string]int
 }
 
@@ -21,6 +23,8 @@ func (tss *trieSyncStatistics) Reset() {
 	tss.Lock()
 	tss.numReceived = 0
 	tss.numMissing = 0
+	tss.numResumed = 0
+	tss.numSkipped = 0
 	tss.Unlock()
 }
 
@@ -51,6 +55,20 @@ func (tss *trieSyncStatistics) SetNumMissing(rootHash []byte, value int) {
 	tss.missingMap[string(rootHash)] = value
 }
 
+// AddNumResumed will add the provided value to the number of pending nodes loaded from a saved sync progress
+func (tss *trieSyncStatistics) AddNumResumed(value int) {
+	tss.Lock()
+	tss.numResumed += value
+	tss.Unlock()
+}
+
+// AddNumSkipped will add the provided value to the number of tries found as already synced
+func (tss *trieSyncStatistics) AddNumSkipped(value int) {
+	tss.Lock()
+	tss.numSkipped += value
+	tss.Unlock()
+}
+
 // NumReceived returns the received nodes
 func (tss *trieSyncStatistics) NumReceived() int {
 	tss.RLock()
@@ -67,6 +85,22 @@ func (tss *trieSyncStatistics) NumMissing() int {
 	return tss.numMissing
 }
 
+// NumResumed returns the number of pending nodes loaded from a saved sync progress
+func (tss *trieSyncStatistics) NumResumed() int {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return tss.numResumed
+}
+
+// NumSkipped returns the number of tries found as already synced
+func (tss *trieSyncStatistics) NumSkipped() int {
+	tss.RLock()
+	defer tss.RUnlock()
+
+	return tss.numSkipped
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tss *trieSyncStatistics) IsInterfaceNil() bool {
 	return tss == nil
@@ -63,3 +63,22 @@ func TestTrieSyncStatistics_Missing(t *testing.T) {
 	tss.Reset()
 	assert.Equal(t, 0, tss.NumMissing())
 }
+
+func TestTrieSyncStatistics_ResumedAndSkipped(t *testing.T) {
+	t.Parallel()
+
+	tss := NewTrieSyncStatistics()
+
+	assert.Equal(t, 0, tss.NumResumed())
+	assert.Equal(t, 0, tss.NumSkipped())
+
+	tss.AddNumResumed(5)
+	tss.AddNumResumed(3)
+	tss.AddNumSkipped(1)
+	assert.Equal(t, 8, tss.NumResumed())
+	assert.Equal(t, 1, tss.NumSkipped())
+
+	tss.Reset()
+	assert.Equal(t, 0, tss.NumResumed())
+	assert.Equal(t, 0, tss.NumSkipped())
+}
@@ -40,6 +40,9 @@ type trieSyncer struct {
 	lastSyncedTrieNode        time.Time
 	timeoutBetweenCommits     time.Duration
 	maxHardCapForMissingNodes int
+	syncProgressStorer        SyncProgressStorer
+	syncProgress              *syncProgress
+	timeBetweenProgressSaves  time.Duration
 }
 
 const maxNewMissingAddedPerTurn = 10
@@ -57,6 +60,8 @@ type ArgTrieSyncer struct {
 	TrieSyncStatistics             data.SyncStatisticsHandler
 	TimeoutBetweenTrieNodesCommits time.Duration
 	MaxHardCapForMissingNodes      int
+	SyncProgressStorer             SyncProgressStorer
+	StateChunksTopic               string
 }
 
 // NewTrieSyncer creates a new instance of trieSyncer
@@ -80,6 +85,8 @@ func NewTrieSyncer(arg ArgTrieSyncer) (*trieSyncer, error) {
 		trieSyncStatistics:        arg.TrieSyncStatistics,
 		timeoutBetweenCommits:     arg.TimeoutBetweenTrieNodesCommits,
 		maxHardCapForMissingNodes: arg.MaxHardCapForMissingNodes,
+		syncProgressStorer:        arg.SyncProgressStorer,
+		timeBetweenProgressSaves:  timeBetweenSyncProgressSaves,
 	}
 
 	return ts, nil
@@ -114,11 +121,15 @@ func checkArguments(arg ArgTrieSyncer) error {
 	if arg.MaxHardCapForMissingNodes < 1 {
 		return fmt.Errorf("%w provided: %v", ErrInvalidMaxHardCapForMissingNodes, arg.MaxHardCapForMissingNodes)
 	}
+	if check.IfNil(arg.SyncProgressStorer) {
+		return ErrNilSyncProgressStorer
+	}
 
 	return nil
 }
 
-// StartSyncing completes the trie, asking for missing trie nodes on the network
+// StartSyncing completes the trie, asking for missing trie nodes on the network. If a previous sync of the same root
+// hash was interrupted, it resumes from the saved progress
 func (ts *trieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 	if len(rootHash) == 0 || bytes.Equal(rootHash, EmptyTrieHash) {
 		return nil
@@ -127,35 +138,69 @@ func (ts *trieSyncer) StartSyncing(rootHash []byte, ctx context.Context) error {
 		return ErrNilContext
 	}
 
+	progress := newSyncProgress(ts.syncProgressStorer, ts.marshalizer, rootHash, ts.timeBetweenProgressSaves)
+	if progress.isCompleted(ts.db) {
+		ts.trieSyncStatistics.AddNumSkipped(1)
+		return nil
+	}
+
 	ts.mutOperation.Lock()
 	ts.nodesForTrie = make(map[string]trieNodeInfo)
-	ts.nodesForTrie[string(rootHash)] = trieNodeInfo{received: false}
+	pendingHashes := progress.loadPending()
+	for _, hash := range pendingHashes {
+		ts.nodesForTrie[string(hash)] = trieNodeInfo{received: false}
+	}
+	if len(ts.nodesForTrie) == 0 {
+		ts.nodesForTrie[string(rootHash)] = trieNodeInfo{received: false}
+	}
+	ts.syncProgress = progress
 	ts.lastSyncedTrieNode = time.Now()
 	ts.mutOperation.Unlock()
 
+	ts.trieSyncStatistics.AddNumResumed(len(pendingHashes))
 	ts.rootFound = false
 	ts.rootHash = rootHash
 
 	for {
 		shouldRetryAfterRequest, err := ts.checkIfSynced()
 		if err != nil {
+			ts.saveProgress(true)
 			return err
 		}
 
 		numUnResolved := ts.requestNodes()
 		if !shouldRetryAfterRequest && numUnResolved == 0 {
+			progress.markCompleted()
 			return nil
 		}
+		ts.saveProgress(false)
 
 		select {
 		case <-time.After(ts.waitTimeBetweenRequests):
 			continue
 		case <-ctx.Done():
+			ts.saveProgress(true)
 			return ErrContextClosing
 		}
 	}
 }
 
+func (ts *trieSyncer) saveProgress(force bool) {
+	if !ts.syncProgress.shouldSave(force) {
+		return
+	}
+
+	ts.mutOperation.RLock()
+	defer ts.mutOperation.RUnlock()
+
+	hashes := make([][]byte, 0, len(ts.nodesForTrie))
+	for hash := range ts.nodesForTrie {
+		hashes = append(hashes, []byte(hash))
+	}
+
+	ts.syncProgress.savePending(hashes)
+}
+
 func (ts *trieSyncer) checkIfSynced() (bool, error) {
 	var currentNode node
 	var err error
@@ -8,6 +8,7 @@ import (
 
 const initialVersion = 1
 const secondVersion = 2
+const thirdVersion = 3
 
 // CreateTrieSyncer is the method factory to create the correct trie syncer implementation
 // TODO try to split this package (syncers should go in sync package, this file in the factory package)
@@ -17,6 +18,8 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (data.TrieSyncer
 		return NewTrieSyncer(arg)
 	case secondVersion:
 		return NewDoubleListTrieSyncer(arg)
+	case thirdVersion:
+		return NewStateChunksTrieSyncer(arg)
 	default:
 		return nil, fmt.Errorf("%w, unknown value %d", ErrInvalidTrieSyncerVersion, trieSyncerVersion)
 	}
@@ -24,7 +27,7 @@ func CreateTrieSyncer(arg ArgTrieSyncer, trieSyncerVersion int) (data.TrieSyncer
 
 // CheckTrieSyncerVersion can check if the syncer version has a correct value
 func CheckTrieSyncerVersion(trieSyncerVersion int) error {
-	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= secondVersion
+	isCorrectVersion := trieSyncerVersion >= initialVersion && trieSyncerVersion <= thirdVersion
 	if isCorrectVersion {
 		return nil
 	}
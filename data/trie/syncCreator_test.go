@@ -43,6 +43,19 @@ func TestNewTrieSync_SecondVariantImplementation(t *testing.T) {
 	assert.True(t, isInstanceOk)
 }
 
+func TestNewTrieSync_ThirdVariantImplementation(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.StateChunksTopic = "state chunks"
+	syncer, err := CreateTrieSyncer(arg, 3)
+
+	require.False(t, check.IfNil(syncer))
+	require.Nil(t, err)
+	_, isInstanceOk := syncer.(*stateChunksTrieSyncer)
+	assert.True(t, isInstanceOk)
+}
+
 func TestCheckTrieSyncerVersion(t *testing.T) {
 	t.Parallel()
 
@@ -55,6 +68,9 @@ func TestCheckTrieSyncerVersion(t *testing.T) {
 	err = CheckTrieSyncerVersion(secondVersion)
 	assert.Nil(t, err)
 
-	err = CheckTrieSyncerVersion(3)
+	err = CheckTrieSyncerVersion(thirdVersion)
+	assert.Nil(t, err)
+
+	err = CheckTrieSyncerVersion(4)
 	assert.True(t, errors.Is(err, ErrInvalidTrieSyncerVersion))
 }
@@ -0,0 +1,189 @@
+package trie
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+)
+
+const pendingSyncProgressPrefix = "trieSyncPending_"
+const completedSyncProgressPrefix = "trieSyncCompleted_"
+const chunksSyncProgressPrefix = "trieSyncChunks_"
+
+// timeBetweenSyncProgressSaves should be longer than the BatchDelaySeconds of the trie storage, as a progress is
+// persisted only on the next save, once the trie nodes committed before it was taken are flushed
+const timeBetweenSyncProgressSaves = 5 * time.Second
+
+// syncProgress persists the hashes of the trie nodes that still have to be synced for a root hash, so that a
+// restarted sync does not start from the root again. Everything outside the pending hashes is already flushed in
+// the trie storage. Once the trie is fully synced, the pending hashes are replaced by a completed marker
+type syncProgress struct {
+	storer          SyncProgressStorer
+	marshalizer     marshal.Marshalizer
+	rootHash        []byte
+	lastSave        time.Time
+	timeBetweenSave time.Duration
+	unflushed       *syncProgressEntry
+}
+
+type syncProgressEntry struct {
+	key   []byte
+	value []byte
+}
+
+func newSyncProgress(
+	storer SyncProgressStorer,
+	marshalizer marshal.Marshalizer,
+	rootHash []byte,
+	timeBetweenSave time.Duration,
+) *syncProgress {
+	return &syncProgress{
+		storer:          storer,
+		marshalizer:     marshalizer,
+		rootHash:        rootHash,
+		lastSave:        time.Now(),
+		timeBetweenSave: timeBetweenSave,
+	}
+}
+
+// isCompleted returns true if the trie was marked as fully synced and its root node is still in the trie storage
+func (sp *syncProgress) isCompleted(db data.DBWriteCacher) bool {
+	_, err := sp.storer.Get(completedSyncProgressKey(sp.rootHash))
+	if err != nil {
+		return false
+	}
+
+	_, err = db.Get(sp.rootHash)
+
+	return err == nil
+}
+
+// loadPending returns the hashes saved by a previous sync of the same root hash, if any
+func (sp *syncProgress) loadPending() [][]byte {
+	buff, err := sp.storer.Get(pendingSyncProgressKey(sp.rootHash))
+	if err != nil {
+		return nil
+	}
+
+	pending := &batch.Batch{}
+	err = sp.marshalizer.Unmarshal(pending, buff)
+	if err != nil {
+		log.Debug("cannot unmarshal the trie sync progress", "root hash", sp.rootHash, "error", err)
+		return nil
+	}
+
+	return pending.Data
+}
+
+// shouldSave returns true if enough time has passed since the last save or if the save is forced
+func (sp *syncProgress) shouldSave(force bool) bool {
+	return force || time.Since(sp.lastSave) >= sp.timeBetweenSave
+}
+
+// savePending replaces the saved hashes with the ones provided on the previous save
+func (sp *syncProgress) savePending(hashes [][]byte) {
+	buff, err := sp.marshalizer.Marshal(batch.New(hashes...))
+	if err != nil {
+		log.Debug("cannot marshal the trie sync progress", "root hash", sp.rootHash, "error", err)
+		return
+	}
+
+	sp.putAfterFlush(pendingSyncProgressKey(sp.rootHash), buff)
+}
+
+// putAfterFlush keeps the provided progress and persists the one kept on the previous call, if it was taken at
+// least timeBetweenSave ago. The trie nodes committed before a progress was taken are still in the write batch of
+// the trie storage, so a progress persisted right away could point past the trie nodes lost in a crash
+func (sp *syncProgress) putAfterFlush(key []byte, value []byte) {
+	if sp.unflushed != nil && time.Since(sp.lastSave) < sp.timeBetweenSave {
+		return
+	}
+
+	flushed := sp.unflushed
+	sp.unflushed = &syncProgressEntry{
+		key:   key,
+		value: value,
+	}
+	sp.lastSave = time.Now()
+	if flushed == nil {
+		return
+	}
+
+	err := sp.storer.Put(flushed.key, flushed.value)
+	if err != nil {
+		log.Debug("cannot save the trie sync progress", "root hash", sp.rootHash, "error", err)
+	}
+}
+
+// loadChunksProgress returns the root hash of the partially rebuilt trie and the last key received by a previous
+// sync of the same root hash through state chunks, if any
+func (sp *syncProgress) loadChunksProgress() ([]byte, []byte, bool) {
+	buff, err := sp.storer.Get(chunksSyncProgressKey(sp.rootHash))
+	if err != nil {
+		return nil, nil, false
+	}
+
+	progress := &batch.Batch{}
+	err = sp.marshalizer.Unmarshal(progress, buff)
+	if err != nil || len(progress.Data) != 2 {
+		log.Debug("cannot unmarshal the trie chunks sync progress", "root hash", sp.rootHash, "error", err)
+		return nil, nil, false
+	}
+
+	return progress.Data[0], progress.Data[1], true
+}
+
+// saveChunksProgress replaces the saved state chunks progress with the one provided on the previous save. It is kept
+// apart from the pending hashes, as the partially rebuilt trie is not a part of the trie being synced
+func (sp *syncProgress) saveChunksProgress(partialRootHash []byte, lastKey []byte) {
+	buff, err := sp.marshalizer.Marshal(batch.New(partialRootHash, lastKey))
+	if err != nil {
+		log.Debug("cannot marshal the trie chunks sync progress", "root hash", sp.rootHash, "error", err)
+		return
+	}
+
+	sp.putAfterFlush(chunksSyncProgressKey(sp.rootHash), buff)
+}
+
+// markCompleted replaces the pending hashes and the state chunks progress with the completed marker
+func (sp *syncProgress) markCompleted() {
+	sp.unflushed = nil
+
+	err := sp.storer.Put(completedSyncProgressKey(sp.rootHash), []byte{1})
+	if err != nil {
+		log.Debug("cannot mark the trie sync as completed", "root hash", sp.rootHash, "error", err)
+	}
+
+	_ = sp.storer.Remove(pendingSyncProgressKey(sp.rootHash))
+	_ = sp.storer.Remove(chunksSyncProgressKey(sp.rootHash))
+}
+
+// RemoveSyncProgress removes the progress saved while syncing the trie with the given root hash. It should be called
+// once all the tries synced together are complete, as the completed markers are no longer needed
+func RemoveSyncProgress(storer SyncProgressStorer, rootHash []byte) error {
+	err := storer.Remove(pendingSyncProgressKey(rootHash))
+	if err != nil {
+		return err
+	}
+
+	err = storer.Remove(chunksSyncProgressKey(rootHash))
+	if err != nil {
+		return err
+	}
+
+	return storer.Remove(completedSyncProgressKey(rootHash))
+}
+
+func pendingSyncProgressKey(rootHash []byte) []byte {
+	return append([]byte(pendingSyncProgressPrefix), rootHash...)
+}
+
+func completedSyncProgressKey(rootHash []byte) []byte {
+	return append([]byte(completedSyncProgressPrefix), rootHash...)
+}
+
+func chunksSyncProgressKey(rootHash []byte) []byte {
+	return append([]byte(chunksSyncProgressPrefix), rootHash...)
+}
@@ -0,0 +1,46 @@
+package trie
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncProgress_SavePendingShouldPersistOnlyTheProgressTakenOnThePreviousSave(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	storer := mock.NewMemDbMock()
+	progress := newSyncProgress(storer, marshalizer, rootHash, timeBetweenProgressSavesInTests)
+
+	progress.savePending([][]byte{[]byte("hash1")})
+	assert.Nil(t, progress.loadPending())
+
+	progress.savePending([][]byte{[]byte("hash2")})
+	assert.Nil(t, progress.loadPending(), "the previous progress should not be persisted before the time between saves")
+
+	time.Sleep(timeBetweenProgressSavesInTests)
+	progress.savePending([][]byte{[]byte("hash3")})
+	assert.Equal(t, [][]byte{[]byte("hash1")}, progress.loadPending())
+
+	time.Sleep(timeBetweenProgressSavesInTests)
+	progress.savePending(nil)
+	assert.Equal(t, [][]byte{[]byte("hash3")}, progress.loadPending())
+}
+
+func TestSyncProgress_MarkCompletedShouldDropTheProgressNotPersisted(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	storer := mock.NewMemDbMock()
+	progress := newSyncProgress(storer, marshalizer, rootHash, timeBetweenProgressSavesInTests)
+
+	progress.savePending([][]byte{[]byte("hash1")})
+	progress.markCompleted()
+
+	time.Sleep(timeBetweenProgressSavesInTests)
+	progress.savePending([][]byte{[]byte("hash2")})
+	assert.Nil(t, progress.loadPending())
+}
@@ -27,6 +27,7 @@ func createMockArgument() ArgTrieSyncer {
 		TrieSyncStatistics:             statistics.NewTrieSyncStatistics(),
 		TimeoutBetweenTrieNodesCommits: minTimeoutBetweenNodesCommits,
 		MaxHardCapForMissingNodes:      500,
+		SyncProgressStorer:             mock.NewMemDbMock(),
 	}
 }
 
@@ -129,6 +130,17 @@ func TestNewTrieSyncer_InvalidMaxHardCapForMissingNodesShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, ErrInvalidMaxHardCapForMissingNodes))
 }
 
+func TestNewTrieSyncer_NilSyncProgressStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.SyncProgressStorer = nil
+
+	ts, err := NewTrieSyncer(arg)
+	assert.True(t, check.IfNil(ts))
+	assert.Equal(t, ErrNilSyncProgressStorer, err)
+}
+
 func TestNewTrieSyncer_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,212 @@
+package trie
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// diffCursorItem is a node waiting to be visited, alongside its position in the trie. The position is the hex path
+// from the root to the node, and for a leaf it also contains the leaf key, so it is the full hex key of the leaf
+type diffCursorItem struct {
+	position []byte
+	n        node
+}
+
+// diffCursor visits the nodes of a trie in pre-order, with the children in increasing order. This way, the
+// positions of the visited nodes are always increasing, so two cursors can be merged by comparing their positions
+type diffCursor struct {
+	pending []diffCursorItem
+	db      data.DBWriteCacher
+}
+
+func newDiffCursor(root node, db data.DBWriteCacher) *diffCursor {
+	dc := &diffCursor{
+		pending: make([]diffCursorItem, 0),
+		db:      db,
+	}
+	dc.push([]byte{}, root)
+
+	return dc
+}
+
+func (dc *diffCursor) push(path []byte, n node) {
+	if n == nil {
+		return
+	}
+
+	ln, ok := n.(*leafNode)
+	if ok {
+		path = concat(path, ln.Key...)
+	}
+
+	dc.pending = append(dc.pending, diffCursorItem{position: path, n: n})
+}
+
+func (dc *diffCursor) isEmpty() bool {
+	return len(dc.pending) == 0
+}
+
+func (dc *diffCursor) current() diffCursorItem {
+	return dc.pending[len(dc.pending)-1]
+}
+
+// skip moves the cursor past the current node and its whole subtrie
+func (dc *diffCursor) skip() {
+	dc.pending = dc.pending[:len(dc.pending)-1]
+}
+
+// descend moves the cursor to the first child of the current node
+func (dc *diffCursor) descend() error {
+	item := dc.current()
+	dc.skip()
+
+	switch n := item.n.(type) {
+	case *branchNode:
+		for i := len(n.children) - 1; i >= 0; i-- {
+			err := resolveIfCollapsed(n, byte(i), dc.db)
+			if err != nil {
+				return err
+			}
+
+			dc.push(concat(item.position, byte(i)), n.children[i])
+			n.children[i] = nil
+		}
+	case *extensionNode:
+		err := resolveIfCollapsed(n, 0, dc.db)
+		if err != nil {
+			return err
+		}
+
+		dc.push(concat(item.position, n.Key...), n.child)
+		n.child = nil
+	}
+
+	return nil
+}
+
+func isLeaf(n node) bool {
+	_, ok := n.(*leafNode)
+	return ok
+}
+
+func haveSameHash(first node, second node) bool {
+	firstHash := first.getHash()
+	return len(firstHash) > 0 && bytes.Equal(firstHash, second.getHash())
+}
+
+// diffTries walks the two tries at the same time and calls the handler for each leaf that differs. The subtries
+// found at the same position and having the same hash are skipped, without being walked
+func diffTries(fromRoot node, toRoot node, db data.DBWriteCacher, handler func(data.TrieLeafDiff) bool) error {
+	from := newDiffCursor(fromRoot, db)
+	to := newDiffCursor(toRoot, db)
+
+	for !from.isEmpty() || !to.isEmpty() {
+		comparison := compareDiffCursors(from, to)
+		if comparison < 0 {
+			shouldContinue, err := consumeOneSide(from, data.LeafDeleted, handler)
+			if err != nil || !shouldContinue {
+				return err
+			}
+			continue
+		}
+		if comparison > 0 {
+			shouldContinue, err := consumeOneSide(to, data.LeafAdded, handler)
+			if err != nil || !shouldContinue {
+				return err
+			}
+			continue
+		}
+
+		shouldContinue, err := consumeBothSides(from, to, handler)
+		if err != nil || !shouldContinue {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compareDiffCursors returns -1 if the next position to visit is only found in the from trie, +1 if it is only found
+// in the to trie and 0 if it is found in both tries
+func compareDiffCursors(from *diffCursor, to *diffCursor) int {
+	if to.isEmpty() {
+		return -1
+	}
+	if from.isEmpty() {
+		return 1
+	}
+
+	return bytes.Compare(from.current().position, to.current().position)
+}
+
+// consumeOneSide handles a node whose position is found in only one of the tries. A leaf is reported with the
+// provided change, while any other node is descended, as its children might be found in both tries
+func consumeOneSide(dc *diffCursor, change data.TrieLeafChange, handler func(data.TrieLeafDiff) bool) (bool, error) {
+	item := dc.current()
+	ln, ok := item.n.(*leafNode)
+	if !ok {
+		return true, dc.descend()
+	}
+
+	key, err := hexToKeyBytes(item.position)
+	if err != nil {
+		return false, err
+	}
+	dc.skip()
+
+	leafDiff := data.TrieLeafDiff{
+		Change: change,
+		Key:    key,
+	}
+	if change == data.LeafDeleted {
+		leafDiff.OldValue = ln.Value
+	} else {
+		leafDiff.NewValue = ln.Value
+	}
+
+	return handler(leafDiff), nil
+}
+
+// consumeBothSides handles the nodes found at the same position in both tries. As only the leaf positions end with
+// the hex terminator, the nodes are either both leaves or both inner nodes
+func consumeBothSides(from *diffCursor, to *diffCursor, handler func(data.TrieLeafDiff) bool) (bool, error) {
+	fromItem := from.current()
+	toItem := to.current()
+
+	if haveSameHash(fromItem.n, toItem.n) {
+		from.skip()
+		to.skip()
+		return true, nil
+	}
+
+	if !isLeaf(fromItem.n) || !isLeaf(toItem.n) {
+		err := from.descend()
+		if err != nil {
+			return false, err
+		}
+
+		return true, to.descend()
+	}
+
+	from.skip()
+	to.skip()
+
+	oldValue := fromItem.n.(*leafNode).Value
+	newValue := toItem.n.(*leafNode).Value
+	if bytes.Equal(oldValue, newValue) {
+		return true, nil
+	}
+
+	key, err := hexToKeyBytes(toItem.position)
+	if err != nil {
+		return false, err
+	}
+
+	return handler(data.TrieLeafDiff{
+		Change:   data.LeafModified,
+		Key:      key,
+		OldValue: oldValue,
+		NewValue: newValue,
+	}), nil
+}
@@ -0,0 +1,160 @@
+package trie_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func commitAndGetRootHash(t *testing.T, tr data.Trie) []byte {
+	err := tr.Commit()
+	require.Nil(t, err)
+
+	rootHash, err := tr.RootHash()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func collectDiff(t *testing.T, tr data.Trie, fromRootHash []byte, toRootHash []byte) map[string]data.TrieLeafDiff {
+	diffs := make(map[string]data.TrieLeafDiff)
+	err := tr.Diff(fromRootHash, toRootHash, func(leafDiff data.TrieLeafDiff) bool {
+		_, found := diffs[string(leafDiff.Key)]
+		assert.False(t, found)
+
+		diffs[string(leafDiff.Key)] = leafDiff
+		return true
+	})
+	require.Nil(t, err)
+
+	return diffs
+}
+
+func TestPatriciaMerkleTrie_DiffNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+
+	err := tr.Diff(nil, nil, nil)
+	assert.Equal(t, trie.ErrNilTrieDiffHandler, err)
+}
+
+func TestPatriciaMerkleTrie_DiffSameRootHashShouldNotReportChanges(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(100)
+	rootHash := commitAndGetRootHash(t, tr)
+
+	diffs := collectDiff(t, tr, rootHash, rootHash)
+	assert.Equal(t, 0, len(diffs))
+}
+
+func TestPatriciaMerkleTrie_DiffFromEmptyTrieShouldReportAllLeavesAsAdded(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash := commitAndGetRootHash(t, tr)
+
+	diffs := collectDiff(t, tr, emptyTrieHash, rootHash)
+	assert.Equal(t, map[string]data.TrieLeafDiff{
+		"doe":  {Change: data.LeafAdded, Key: []byte("doe"), NewValue: []byte("reindeer")},
+		"dog":  {Change: data.LeafAdded, Key: []byte("dog"), NewValue: []byte("puppy")},
+		"ddog": {Change: data.LeafAdded, Key: []byte("ddog"), NewValue: []byte("cat")},
+	}, diffs)
+
+	diffs = collectDiff(t, tr, rootHash, nil)
+	assert.Equal(t, 3, len(diffs))
+	assert.Equal(t, data.LeafDeleted, diffs["dog"].Change)
+	assert.Equal(t, []byte("puppy"), diffs["dog"].OldValue)
+	assert.Nil(t, diffs["dog"].NewValue)
+}
+
+func TestPatriciaMerkleTrie_DiffShouldReportAddedModifiedAndDeletedLeaves(t *testing.T) {
+	t.Parallel()
+
+	numKeys := 500
+	tr := emptyTrie()
+	values := make(map[string]string)
+	for i := 0; i < numKeys; i++ {
+		key := fmt.Sprintf("key%d", i)
+		values[key] = fmt.Sprintf("value%d", i)
+		_ = tr.Update([]byte(key), []byte(values[key]))
+	}
+	fromRootHash := commitAndGetRootHash(t, tr)
+
+	expectedDiffs := make(map[string]data.TrieLeafDiff)
+	random := rand.New(rand.NewSource(0))
+	for i := 0; i < 50; i++ {
+		key := fmt.Sprintf("key%d", random.Intn(numKeys))
+		if _, found := expectedDiffs[key]; found {
+			continue
+		}
+
+		switch i % 3 {
+		case 0:
+			_ = tr.Delete([]byte(key))
+			expectedDiffs[key] = data.TrieLeafDiff{Change: data.LeafDeleted, Key: []byte(key), OldValue: []byte(values[key])}
+		case 1:
+			newValue := []byte(values[key] + "new")
+			_ = tr.Update([]byte(key), newValue)
+			expectedDiffs[key] = data.TrieLeafDiff{Change: data.LeafModified, Key: []byte(key), OldValue: []byte(values[key]), NewValue: newValue}
+		default:
+			newKey := fmt.Sprintf("new key%d", i)
+			_ = tr.Update([]byte(newKey), []byte("value"))
+			expectedDiffs[newKey] = data.TrieLeafDiff{Change: data.LeafAdded, Key: []byte(newKey), NewValue: []byte("value")}
+		}
+	}
+	toRootHash := commitAndGetRootHash(t, tr)
+
+	diffs := collectDiff(t, tr, fromRootHash, toRootHash)
+	assert.Equal(t, expectedDiffs, diffs)
+
+	reversedDiffs := collectDiff(t, tr, toRootHash, fromRootHash)
+	require.Equal(t, len(expectedDiffs), len(reversedDiffs))
+	for key, leafDiff := range expectedDiffs {
+		reversed := reversedDiffs[key]
+		assert.Equal(t, leafDiff.OldValue, reversed.NewValue)
+		assert.Equal(t, leafDiff.NewValue, reversed.OldValue)
+	}
+}
+
+func TestPatriciaMerkleTrie_DiffShouldStopWhenTheHandlerReturnsFalse(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(20)
+	rootHash := commitAndGetRootHash(t, tr)
+
+	numCalls := 0
+	err := tr.Diff(nil, rootHash, func(_ data.TrieLeafDiff) bool {
+		numCalls++
+		return false
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestPatriciaMerkleTrie_DiffMissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash := commitAndGetRootHash(t, tr)
+
+	err := tr.Diff(rootHash, []byte("missing root hash"), func(_ data.TrieLeafDiff) bool {
+		return true
+	})
+	assert.NotNil(t, err)
+}
+
+func TestTrieLeafChange_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "added", data.LeafAdded.String())
+	assert.Equal(t, "modified", data.LeafModified.String())
+	assert.Equal(t, "deleted", data.LeafDeleted.String())
+	assert.Equal(t, "unknown", data.TrieLeafChange(100).String())
+}
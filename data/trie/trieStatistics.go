@@ -0,0 +1,77 @@
+package trie
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// collectTrieStatistics walks the subtrie rooted in the given node, in depth first order, and adds each visited node
+// to the statistics. The children are released after being visited, so that only one path from the root is kept in
+// memory. The leaf handler, if provided, is called with the full key and the value of each leaf
+func collectTrieStatistics(
+	n node,
+	depth int,
+	path []byte,
+	db data.DBWriteCacher,
+	stats *data.TrieStatisticsDTO,
+	leafHandler func(key []byte, value []byte),
+) error {
+	encodedNode, err := n.getEncodedNode()
+	if err != nil {
+		return err
+	}
+
+	switch currentNode := n.(type) {
+	case *branchNode:
+		numChildren := 0
+		for i := range currentNode.EncodedChildren {
+			if len(currentNode.EncodedChildren[i]) > 0 {
+				numChildren++
+			}
+		}
+		stats.AddBranch(depth, numChildren, len(encodedNode))
+
+		for i := range currentNode.children {
+			err = resolveIfCollapsed(currentNode, byte(i), db)
+			if err != nil {
+				return err
+			}
+			if currentNode.children[i] == nil {
+				continue
+			}
+
+			err = collectTrieStatistics(currentNode.children[i], depth+1, concat(path, byte(i)), db, stats, leafHandler)
+			if err != nil {
+				return err
+			}
+			currentNode.children[i] = nil
+		}
+	case *extensionNode:
+		stats.AddExtension(depth, len(encodedNode))
+
+		err = resolveIfCollapsed(currentNode, 0, db)
+		if err != nil {
+			return err
+		}
+
+		err = collectTrieStatistics(currentNode.child, depth+1, concat(path, currentNode.Key...), db, stats, leafHandler)
+		if err != nil {
+			return err
+		}
+		currentNode.child = nil
+	case *leafNode:
+		stats.AddLeaf(depth, len(encodedNode))
+		if leafHandler == nil {
+			return nil
+		}
+
+		key, errConvert := hexToKeyBytes(concat(path, currentNode.Key...))
+		if errConvert != nil {
+			return errConvert
+		}
+		leafHandler(key, currentNode.Value)
+	default:
+		return ErrInvalidNode
+	}
+
+	return nil
+}
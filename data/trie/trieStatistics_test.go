@@ -0,0 +1,115 @@
+package trie_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatriciaMerkleTrie_GetStatisticsEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+
+	stats, err := tr.GetStatistics(emptyTrieHash, nil)
+	require.Nil(t, err)
+	assert.Equal(t, data.NewTrieStatisticsDTO(), stats)
+}
+
+func TestPatriciaMerkleTrie_GetStatisticsMissingRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	_ = commitAndGetRootHash(t, tr)
+
+	stats, err := tr.GetStatistics([]byte("missing root hash"), nil)
+	assert.Nil(t, stats)
+	assert.NotNil(t, err)
+}
+
+func TestPatriciaMerkleTrie_GetStatisticsShouldReportTheTrieStructure(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash := commitAndGetRootHash(t, tr)
+
+	leaves := make(map[string]string)
+	stats, err := tr.GetStatistics(rootHash, func(key []byte, value []byte) {
+		leaves[string(key)] = string(value)
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, map[string]string{"doe": "reindeer", "dog": "puppy", "ddog": "cat"}, leaves)
+	assert.Equal(t, uint64(2), stats.NumBranches)
+	assert.Equal(t, uint64(1), stats.NumExtensions)
+	assert.Equal(t, uint64(3), stats.NumLeaves)
+	assert.Equal(t, 3, stats.MaxDepth)
+	assert.Equal(t, []uint64{1, 2, 1, 2}, stats.NumNodesPerDepth)
+	assert.Equal(t, uint64(2), stats.BranchesFanOut[2])
+	assert.Equal(t, stats.BranchesSize+stats.ExtensionsSize+stats.LeavesSize, stats.TotalSize)
+}
+
+func TestPatriciaMerkleTrie_GetStatisticsShouldAccountAllNodes(t *testing.T) {
+	t.Parallel()
+
+	numLeaves := 1000
+	tr, values := initTrieMultipleValues(numLeaves)
+	rootHash := commitAndGetRootHash(t, tr)
+
+	numLeavesHandled := 0
+	stats, err := tr.GetStatistics(rootHash, func(key []byte, value []byte) {
+		assert.Equal(t, key, value)
+		numLeavesHandled++
+	})
+	require.Nil(t, err)
+	assert.Equal(t, numLeaves, numLeavesHandled)
+	assert.Equal(t, uint64(len(values)), stats.NumLeaves)
+
+	numNodes := uint64(0)
+	for _, numNodesOnDepth := range stats.NumNodesPerDepth {
+		numNodes += numNodesOnDepth
+	}
+	assert.Equal(t, stats.NumBranches+stats.NumExtensions+stats.NumLeaves, numNodes)
+	assert.Equal(t, stats.MaxDepth+1, len(stats.NumNodesPerDepth))
+
+	numBranches := uint64(0)
+	for _, numBranchesWithFanOut := range stats.BranchesFanOut {
+		numBranches += numBranchesWithFanOut
+	}
+	assert.Equal(t, stats.NumBranches, numBranches)
+
+	hashes, err := tr.GetAllHashes()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(len(hashes)), numNodes)
+}
+
+func TestTrieStatisticsDTO_Merge(t *testing.T) {
+	t.Parallel()
+
+	first := data.NewTrieStatisticsDTO()
+	first.AddBranch(0, 2, 100)
+	first.AddLeaf(1, 10)
+	first.AddLeaf(1, 20)
+
+	second := data.NewTrieStatisticsDTO()
+	second.AddExtension(0, 30)
+	second.AddBranch(1, 16, 500)
+	second.AddLeaf(2, 40)
+
+	first.Merge(second)
+	first.Merge(nil)
+
+	assert.Equal(t, uint64(2), first.NumBranches)
+	assert.Equal(t, uint64(1), first.NumExtensions)
+	assert.Equal(t, uint64(3), first.NumLeaves)
+	assert.Equal(t, 2, first.MaxDepth)
+	assert.Equal(t, []uint64{2, 3, 1}, first.NumNodesPerDepth)
+	assert.Equal(t, uint64(1), first.BranchesFanOut[2])
+	assert.Equal(t, uint64(1), first.BranchesFanOut[16])
+	assert.Equal(t, uint64(600), first.BranchesSize)
+	assert.Equal(t, uint64(30), first.ExtensionsSize)
+	assert.Equal(t, uint64(70), first.LeavesSize)
+	assert.Equal(t, uint64(700), first.TotalSize)
+}
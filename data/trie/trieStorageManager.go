@@ -149,6 +149,7 @@ func getSnapshotsAndSnapshotId(snapshotDbCfg config.DBConfig) ([]data.SnapshotDb
 			BatchDelaySeconds: snapshotDbCfg.BatchDelaySeconds,
 			MaxBatchSize:      snapshotDbCfg.MaxBatchSize,
 			MaxOpenFiles:      snapshotDbCfg.MaxOpenFiles,
+			Compression:       storageUnit.CompressionType(snapshotDbCfg.Compression),
 		}
 		db, err = storageUnit.NewDB(arg)
 		if err != nil {
@@ -525,6 +526,7 @@ func (tsm *trieStorageManager) newSnapshotDb() (storage.Persister, error) {
 		BatchDelaySeconds: tsm.snapshotDbCfg.BatchDelaySeconds,
 		MaxBatchSize:      tsm.snapshotDbCfg.MaxBatchSize,
 		MaxOpenFiles:      tsm.snapshotDbCfg.MaxOpenFiles,
+		Compression:       storageUnit.CompressionType(tsm.snapshotDbCfg.Compression),
 	}
 	db, err := storageUnit.NewDB(arg)
 	if err != nil {
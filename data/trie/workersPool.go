@@ -0,0 +1,92 @@
+package trie
+
+import (
+	"runtime"
+	"sync"
+)
+
+// minChildrenForConcurrentProcessing is the minimum number of children of a branch node that need to be hashed or
+// committed for them to be sent to the workers pool. For fewer children the synchronization costs more than it saves
+const minChildrenForConcurrentProcessing = 4
+
+// branchChildrenWorkers bounds the number of go routines hashing or committing branch node children, across all tries.
+// One CPU is left for the calling go routines, which run the children that do not find a free worker. It is guarded
+// by mutBranchChildrenWorkers, as the tests replace it while tries might still be hashed by other go routines
+var (
+	mutBranchChildrenWorkers sync.RWMutex
+	branchChildrenWorkers    = newWorkersPool(runtime.NumCPU() - 1)
+)
+
+func getBranchChildrenWorkers() *workersPool {
+	mutBranchChildrenWorkers.RLock()
+	defer mutBranchChildrenWorkers.RUnlock()
+
+	return branchChildrenWorkers
+}
+
+// workersPool runs tasks on a bounded number of go routines. A task that does not find a free worker is run on the
+// calling go routine, so a recursive processing never waits for a worker held by one of its ancestors
+type workersPool struct {
+	slots chan struct{}
+}
+
+func newWorkersPool(numWorkers int) *workersPool {
+	if numWorkers < 0 {
+		numWorkers = 0
+	}
+
+	return &workersPool{
+		slots: make(chan struct{}, numWorkers),
+	}
+}
+
+// process calls the handler for each of the provided positions and returns the first error encountered. The positions
+// are processed concurrently only if there are at least minChildrenForConcurrentProcessing of them
+func (wp *workersPool) process(positions []int, handler func(pos int) error) error {
+	if len(positions) < minChildrenForConcurrentProcessing || cap(wp.slots) == 0 {
+		for _, pos := range positions {
+			err := handler(pos)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	errc := make(chan error, len(positions))
+	for _, pos := range positions {
+		select {
+		case wp.slots <- struct{}{}:
+			wg.Add(1)
+			go func(position int) {
+				defer func() {
+					<-wp.slots
+					wg.Done()
+				}()
+
+				err := handler(position)
+				if err != nil {
+					errc <- err
+				}
+			}(pos)
+		default:
+			err := handler(pos)
+			if err != nil {
+				errc <- err
+			}
+		}
+
+		if len(errc) != 0 {
+			break
+		}
+	}
+	wg.Wait()
+
+	if len(errc) != 0 {
+		return <-errc
+	}
+
+	return nil
+}
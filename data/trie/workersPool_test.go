@@ -0,0 +1,222 @@
+package trie
+
+import (
+	"errors"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setBranchChildrenWorkers(numWorkers int) func() {
+	mutBranchChildrenWorkers.Lock()
+	previousWorkers := branchChildrenWorkers
+	branchChildrenWorkers = newWorkersPool(numWorkers)
+	mutBranchChildrenWorkers.Unlock()
+
+	return func() {
+		mutBranchChildrenWorkers.Lock()
+		branchChildrenWorkers = previousWorkers
+		mutBranchChildrenWorkers.Unlock()
+	}
+}
+
+func createPositions(numPositions int) []int {
+	positions := make([]int, numPositions)
+	for i := range positions {
+		positions[i] = i
+	}
+
+	return positions
+}
+
+func newTrieWithValues(tb testing.TB, numValues int) (*patriciaMerkleTrie, *memorydb.DB) {
+	db := memorydb.New()
+	storageManager, err := NewTrieStorageManagerWithoutPruning(db)
+	require.Nil(tb, err)
+
+	marshalizer, hasher := getTestMarshalizerAndHasher()
+	tr, err := NewTrie(storageManager, marshalizer, hasher, 5)
+	require.Nil(tb, err)
+
+	keccakHasher := keccak.Keccak{}
+	for i := 0; i < numValues; i++ {
+		key := keccakHasher.Compute(strconv.Itoa(i))
+		_ = tr.Update(key, append(key, []byte(strconv.Itoa(i))...))
+	}
+
+	return tr, db
+}
+
+func getAllRecords(db *memorydb.DB) map[string][]byte {
+	records := make(map[string][]byte)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		records[string(key)] = value
+		return true
+	})
+
+	return records
+}
+
+func TestWorkersPool_ProcessFewPositionsShouldRunSerially(t *testing.T) {
+	t.Parallel()
+
+	wp := newWorkersPool(4)
+	processed := make([]int, 0)
+	err := wp.process(createPositions(minChildrenForConcurrentProcessing-1), func(pos int) error {
+		processed = append(processed, pos)
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, createPositions(minChildrenForConcurrentProcessing-1), processed)
+}
+
+func TestWorkersPool_ProcessShouldCallTheHandlerForAllPositions(t *testing.T) {
+	t.Parallel()
+
+	wp := newWorkersPool(4)
+	mutProcessed := sync.Mutex{}
+	processed := make(map[int]int)
+	err := wp.process(createPositions(nrOfChildren), func(pos int) error {
+		mutProcessed.Lock()
+		processed[pos]++
+		mutProcessed.Unlock()
+
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, nrOfChildren, len(processed))
+	for pos := 0; pos < nrOfChildren; pos++ {
+		assert.Equal(t, 1, processed[pos])
+	}
+}
+
+func TestWorkersPool_ProcessShouldNotExceedTheNumberOfWorkers(t *testing.T) {
+	t.Parallel()
+
+	numWorkers := 2
+	wp := newWorkersPool(numWorkers)
+	numRunning := int32(0)
+	maxRunning := int32(0)
+	err := wp.process(createPositions(nrOfChildren), func(pos int) error {
+		running := atomic.AddInt32(&numRunning, 1)
+		for {
+			currentMax := atomic.LoadInt32(&maxRunning)
+			if running <= currentMax || atomic.CompareAndSwapInt32(&maxRunning, currentMax, running) {
+				break
+			}
+		}
+		runtime.Gosched()
+		atomic.AddInt32(&numRunning, -1)
+
+		return nil
+	})
+
+	assert.Nil(t, err)
+	// the calling go routine runs the tasks that do not find a free worker
+	assert.True(t, atomic.LoadInt32(&maxRunning) <= int32(numWorkers+1))
+}
+
+func TestWorkersPool_ProcessShouldReturnTheHandlerError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	wp := newWorkersPool(4)
+	err := wp.process(createPositions(nrOfChildren), func(pos int) error {
+		if pos == 5 {
+			return expectedErr
+		}
+
+		return nil
+	})
+	assert.Equal(t, expectedErr, err)
+
+	err = wp.process(createPositions(minChildrenForConcurrentProcessing-1), func(pos int) error {
+		return expectedErr
+	})
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestWorkersPool_ConcurrentHashingAndCommitShouldMatchTheSerialOnes(t *testing.T) {
+	numValues := 10000
+
+	restore := setBranchChildrenWorkers(0)
+	serialTrie, serialDb := newTrieWithValues(t, numValues)
+	serialRootHash, err := serialTrie.RootHash()
+	require.Nil(t, err)
+	require.Nil(t, serialTrie.Commit())
+	restore()
+
+	restore = setBranchChildrenWorkers(8)
+	defer restore()
+	concurrentTrie, concurrentDb := newTrieWithValues(t, numValues)
+	concurrentRootHash, err := concurrentTrie.RootHash()
+	require.Nil(t, err)
+	require.Nil(t, concurrentTrie.Commit())
+
+	assert.Equal(t, serialRootHash, concurrentRootHash)
+	assert.Equal(t, getAllRecords(serialDb), getAllRecords(concurrentDb))
+
+	snapshotDb := memorydb.New()
+	err = concurrentTrie.root.commit(true, 0, 5, concurrentDb, snapshotDb)
+	require.Nil(t, err)
+	assert.Equal(t, getAllRecords(serialDb), getAllRecords(snapshotDb))
+}
+
+func benchmarkTrieCommit(b *testing.B, numWorkers int) {
+	restore := setBranchChildrenWorkers(numWorkers)
+	defer restore()
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		tr, _ := newTrieWithValues(b, 100000)
+		b.StartTimer()
+
+		_ = tr.Commit()
+	}
+}
+
+func BenchmarkPatriciaMerkleTrie_CommitSerial(b *testing.B) {
+	benchmarkTrieCommit(b, 0)
+}
+
+func BenchmarkPatriciaMerkleTrie_CommitConcurrent(b *testing.B) {
+	benchmarkTrieCommit(b, runtime.NumCPU())
+}
+
+func benchmarkTrieRootHash(b *testing.B, numWorkers int) {
+	restore := setBranchChildrenWorkers(numWorkers)
+	defer restore()
+
+	tr, _ := newTrieWithValues(b, 100000)
+	_ = tr.Commit()
+
+	keccakHasher := keccak.Keccak{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for j := 0; j < 10000; j++ {
+			key := keccakHasher.Compute(strconv.Itoa(j))
+			_ = tr.Update(key, append(key, []byte(strconv.Itoa(i))...))
+		}
+		b.StartTimer()
+
+		_, _ = tr.RootHash()
+	}
+}
+
+func BenchmarkPatriciaMerkleTrie_RootHashSerial(b *testing.B) {
+	benchmarkTrieRootHash(b, 0)
+}
+
+func BenchmarkPatriciaMerkleTrie_RootHashConcurrent(b *testing.B) {
+	benchmarkTrieRootHash(b, runtime.NumCPU())
+}
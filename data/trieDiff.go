@@ -0,0 +1,36 @@
+package data
+
+// TrieLeafChange defines the way in which a trie leaf changed between two root hashes
+type TrieLeafChange byte
+
+const (
+	// LeafAdded signals a leaf that exists only under the newer root hash
+	LeafAdded TrieLeafChange = iota
+	// LeafModified signals a leaf that exists under both root hashes, but with different values
+	LeafModified
+	// LeafDeleted signals a leaf that exists only under the older root hash
+	LeafDeleted
+)
+
+// String returns the human readable form of the leaf change
+func (tlc TrieLeafChange) String() string {
+	switch tlc {
+	case LeafAdded:
+		return "added"
+	case LeafModified:
+		return "modified"
+	case LeafDeleted:
+		return "deleted"
+	default:
+		return "unknown"
+	}
+}
+
+// TrieLeafDiff holds a leaf that differs between two root hashes. The old value is nil for an added leaf and
+// the new value is nil for a deleted one
+type TrieLeafDiff struct {
+	Change   TrieLeafChange
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
@@ -0,0 +1,95 @@
+package data
+
+// maxBranchChildren is the maximum number of children of a branch node, used for the fan-out distribution
+const maxBranchChildren = 16
+
+// TrieStatisticsDTO holds the structure of a trie, as found by walking all its nodes from the root. The root is
+// found at depth 0, the sizes are the serialized sizes of the nodes, in bytes, and BranchesFanOut[i] holds the number
+// of branch nodes having exactly i children
+type TrieStatisticsDTO struct {
+	NumBranches      uint64   `json:"numBranches"`
+	NumExtensions    uint64   `json:"numExtensions"`
+	NumLeaves        uint64   `json:"numLeaves"`
+	MaxDepth         int      `json:"maxDepth"`
+	NumNodesPerDepth []uint64 `json:"numNodesPerDepth"`
+	BranchesFanOut   []uint64 `json:"branchesFanOut"`
+	BranchesSize     uint64   `json:"branchesSize"`
+	ExtensionsSize   uint64   `json:"extensionsSize"`
+	LeavesSize       uint64   `json:"leavesSize"`
+	TotalSize        uint64   `json:"totalSize"`
+}
+
+// NewTrieStatisticsDTO creates the statistics of an empty trie
+func NewTrieStatisticsDTO() *TrieStatisticsDTO {
+	return &TrieStatisticsDTO{
+		NumNodesPerDepth: make([]uint64, 0),
+		BranchesFanOut:   make([]uint64, maxBranchChildren+1),
+	}
+}
+
+// AddBranch accounts a branch node with the given number of children, found at the given depth
+func (tsd *TrieStatisticsDTO) AddBranch(depth int, numChildren int, size int) {
+	tsd.NumBranches++
+	tsd.BranchesSize += uint64(size)
+	if numChildren >= 0 && numChildren < len(tsd.BranchesFanOut) {
+		tsd.BranchesFanOut[numChildren]++
+	}
+	tsd.addNode(depth, size)
+}
+
+// AddExtension accounts an extension node found at the given depth
+func (tsd *TrieStatisticsDTO) AddExtension(depth int, size int) {
+	tsd.NumExtensions++
+	tsd.ExtensionsSize += uint64(size)
+	tsd.addNode(depth, size)
+}
+
+// AddLeaf accounts a leaf node found at the given depth
+func (tsd *TrieStatisticsDTO) AddLeaf(depth int, size int) {
+	tsd.NumLeaves++
+	tsd.LeavesSize += uint64(size)
+	tsd.addNode(depth, size)
+}
+
+func (tsd *TrieStatisticsDTO) addNode(depth int, size int) {
+	for len(tsd.NumNodesPerDepth) <= depth {
+		tsd.NumNodesPerDepth = append(tsd.NumNodesPerDepth, 0)
+	}
+
+	tsd.NumNodesPerDepth[depth]++
+	tsd.TotalSize += uint64(size)
+	if depth > tsd.MaxDepth {
+		tsd.MaxDepth = depth
+	}
+}
+
+// Merge adds the statistics of another trie to the current ones, as if the two tries were rooted at the same depth
+func (tsd *TrieStatisticsDTO) Merge(other *TrieStatisticsDTO) {
+	if other == nil {
+		return
+	}
+
+	tsd.NumBranches += other.NumBranches
+	tsd.NumExtensions += other.NumExtensions
+	tsd.NumLeaves += other.NumLeaves
+	tsd.BranchesSize += other.BranchesSize
+	tsd.ExtensionsSize += other.ExtensionsSize
+	tsd.LeavesSize += other.LeavesSize
+	tsd.TotalSize += other.TotalSize
+	if other.MaxDepth > tsd.MaxDepth {
+		tsd.MaxDepth = other.MaxDepth
+	}
+
+	for depth, numNodes := range other.NumNodesPerDepth {
+		for len(tsd.NumNodesPerDepth) <= depth {
+			tsd.NumNodesPerDepth = append(tsd.NumNodesPerDepth, 0)
+		}
+		tsd.NumNodesPerDepth[depth] += numNodes
+	}
+
+	for numChildren, numBranches := range other.BranchesFanOut {
+		if numChildren < len(tsd.BranchesFanOut) {
+			tsd.BranchesFanOut[numChildren] += numBranches
+		}
+	}
+}
@@ -304,3 +304,41 @@ func (brcf *baseResolversContainerFactory) createTrieNodesResolver(
 
 	return resolver, nil
 }
+
+func (brcf *baseResolversContainerFactory) createStateChunksResolver(
+	topic string,
+	trieId string,
+	numCrossShard int,
+	numIntraShard int,
+) (dataRetriever.Resolver, error) {
+	resolverSender, err := brcf.createOneResolverSenderWithSpecifiedNumRequests(
+		topic,
+		EmptyExcludePeersOnTopic,
+		defaultTargetShardID,
+		numCrossShard,
+		numIntraShard,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	trie := brcf.triesContainer.Get([]byte(trieId))
+	argStateChunk := resolvers.ArgStateChunkResolver{
+		SenderResolver:   resolverSender,
+		StateChunkGetter: trie,
+		Marshalizer:      brcf.marshalizer,
+		AntifloodHandler: brcf.inputAntifloodHandler,
+		Throttler:        brcf.throttler,
+	}
+	resolver, err := resolvers.NewStateChunkResolver(argStateChunk)
+	if err != nil {
+		return nil, err
+	}
+
+	err = brcf.messenger.RegisterMessageProcessor(resolver.RequestTopic(), resolver)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolver, nil
+}
@@ -114,7 +114,7 @@ func (mrcf *metaResolversContainerFactory) Create() (dataRetriever.ResolversCont
 	return mrcf.container, nil
 }
 
-// AddShardTrieNodeResolvers will add trie node resolvers to the existing container, needed for start in epoch
+// AddShardTrieNodeResolvers will add trie node and state chunk resolvers to the existing container, needed for start in epoch
 func (mrcf *metaResolversContainerFactory) AddShardTrieNodeResolvers(container dataRetriever.ResolversContainer) error {
 	if check.IfNil(container) {
 		return dataRetriever.ErrNilResolverContainer
@@ -134,6 +134,15 @@ func (mrcf *metaResolversContainerFactory) AddShardTrieNodeResolvers(container d
 
 		resolversSlice = append(resolversSlice, resolver)
 		keys = append(keys, identifierTrieNodes)
+
+		identifierStateChunks := factory.AccountStateChunksTopic + shardC.CommunicationIdentifier(i)
+		resolver, err = mrcf.createStateChunksResolver(identifierStateChunks, triesFactory.UserAccountTrie, numCrossShardPeers, numIntraShardPeers)
+		if err != nil {
+			return err
+		}
+
+		resolversSlice = append(resolversSlice, resolver)
+		keys = append(keys, identifierStateChunks)
 	}
 
 	return container.AddMultiple(keys, resolversSlice)
@@ -273,6 +282,24 @@ func (mrcf *metaResolversContainerFactory) generateTrieNodesResolvers() error {
 	resolversSlice = append(resolversSlice, resolver)
 	keys = append(keys, identifierTrieNodes)
 
+	identifierStateChunks := factory.AccountStateChunksTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	resolver, err = mrcf.createStateChunksResolver(identifierStateChunks, triesFactory.UserAccountTrie, 0, numIntraShardPeers+numCrossShardPeers)
+	if err != nil {
+		return err
+	}
+
+	resolversSlice = append(resolversSlice, resolver)
+	keys = append(keys, identifierStateChunks)
+
+	identifierStateChunks = factory.ValidatorStateChunksTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	resolver, err = mrcf.createStateChunksResolver(identifierStateChunks, triesFactory.PeerAccountTrie, 0, numIntraShardPeers+numCrossShardPeers)
+	if err != nil {
+		return err
+	}
+
+	resolversSlice = append(resolversSlice, resolver)
+	keys = append(keys, identifierStateChunks)
+
 	return mrcf.container.AddMultiple(keys, resolversSlice)
 }
 
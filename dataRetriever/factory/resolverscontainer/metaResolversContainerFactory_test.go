@@ -241,14 +241,15 @@ func TestMetaResolversContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numResolversRewards := noOfShards
 	numResolversTxs := noOfShards + 1
 	numResolversTrieNodes := 2
+	numResolversStateChunks := 2
 	totalResolvers := numResolversShardHeadersForMetachain + numResolverMetablocks + numResolversMiniBlocks +
-		numResolversUnsigned + numResolversTxs + numResolversTrieNodes + numResolversRewards
+		numResolversUnsigned + numResolversTxs + numResolversTrieNodes + numResolversStateChunks + numResolversRewards
 
 	assert.Equal(t, totalResolvers, container.Len())
 
 	err := rcf.AddShardTrieNodeResolvers(container)
 	assert.Nil(t, err)
-	assert.Equal(t, totalResolvers+noOfShards, container.Len())
+	assert.Equal(t, totalResolvers+2*noOfShards, container.Len())
 }
 
 func getArgumentsMeta() resolverscontainer.FactoryArgs {
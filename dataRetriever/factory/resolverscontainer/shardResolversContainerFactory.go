@@ -206,6 +206,15 @@ func (srcf *shardResolversContainerFactory) generateTrieNodesResolvers() error {
 	resolversSlice = append(resolversSlice, resolver)
 	keys = append(keys, identifierTrieNodes)
 
+	identifierStateChunks := factory.AccountStateChunksTopic + shardC.CommunicationIdentifier(core.MetachainShardId)
+	resolver, err = srcf.createStateChunksResolver(identifierStateChunks, triesFactory.UserAccountTrie, 0, numIntraShardPeers+numCrossShardPeers)
+	if err != nil {
+		return err
+	}
+
+	resolversSlice = append(resolversSlice, resolver)
+	keys = append(keys, identifierStateChunks)
+
 	return srcf.container.AddMultiple(keys, resolversSlice)
 }
 
@@ -85,6 +85,8 @@ const (
 	ReceiptsUnit UnitType = 15
 	// ResultsHashesByTxHashUnit is the results hashes by transaction storage unit identifier
 	ResultsHashesByTxHashUnit UnitType = 16
+	// TransactionsByAddressUnit is the transactions by address storage unit identifier
+	TransactionsByAddressUnit UnitType = 17
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -118,6 +120,12 @@ type TrieNodesResolver interface {
 	RequestDataFromHashArray(hashes [][]byte, epoch uint32) error
 }
 
+// StateChunksResolver defines what a state chunks resolver should do
+type StateChunksResolver interface {
+	Resolver
+	RequestStateChunk(rootHash []byte, startAfterKey []byte) error
+}
+
 // HeaderResolver defines what a block header resolver should do
 type HeaderResolver interface {
 	Resolver
@@ -319,6 +327,15 @@ type StorageService interface {
 	IsInterfaceNil() bool
 }
 
+// StorageGroupCommitter gathers the writes of a committed block, spread over more storage units, and persists them
+// as one crash consistent group
+type StorageGroupCommitter interface {
+	Put(unitType UnitType, key []byte, value []byte) error
+	Commit(epoch uint32) error
+	Discard()
+	IsInterfaceNil() bool
+}
+
 // DataPacker can split a large slice of byte slices in smaller packets
 type DataPacker interface {
 	PackDataInChunks(data [][]byte, limit int) ([][]byte, error)
@@ -332,6 +349,12 @@ type TrieDataGetter interface {
 	IsInterfaceNil() bool
 }
 
+// StateChunkGetter returns chunks of leaves, together with their proof, from a trie snapshot
+type StateChunkGetter interface {
+	GetSerializedStateChunk(rootHash []byte, startAfterKey []byte, maxBuffToSend uint64) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
 // RequestedItemsHandler can determine if a certain key has or not been requested
 type RequestedItemsHandler interface {
 	Add(key string) error
@@ -8,14 +8,15 @@ import (
 
 // ChainStorerMock is a mock implementation of the ChainStorer interface
 type ChainStorerMock struct {
-	AddStorerCalled func(key dataRetriever.UnitType, s storage.Storer)
-	GetStorerCalled func(unitType dataRetriever.UnitType) storage.Storer
-	HasCalled       func(unitType dataRetriever.UnitType, key []byte) error
-	GetCalled       func(unitType dataRetriever.UnitType, key []byte) ([]byte, error)
-	PutCalled       func(unitType dataRetriever.UnitType, key []byte, value []byte) error
-	GetAllCalled    func(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error)
-	DestroyCalled   func() error
-	CloseAllCalled  func() error
+	AddStorerCalled               func(key dataRetriever.UnitType, s storage.Storer)
+	GetStorerCalled               func(unitType dataRetriever.UnitType) storage.Storer
+	HasCalled                     func(unitType dataRetriever.UnitType, key []byte) error
+	GetCalled                     func(unitType dataRetriever.UnitType, key []byte) ([]byte, error)
+	PutCalled                     func(unitType dataRetriever.UnitType, key []byte, value []byte) error
+	GetAllCalled                  func(unitType dataRetriever.UnitType, keys [][]byte) (map[string][]byte, error)
+	SetEpochForPutOperationCalled func(epoch uint32)
+	DestroyCalled                 func() error
+	CloseAllCalled                func() error
 }
 
 // CloseAll -
@@ -81,8 +82,11 @@ func (bc *ChainStorerMock) GetAll(unitType dataRetriever.UnitType, keys [][]byte
 	return nil, nil
 }
 
-// SetEpochForPutOperation won't do anything
+// SetEpochForPutOperation -
 func (bc *ChainStorerMock) SetEpochForPutOperation(epoch uint32) {
+	if bc.SetEpochForPutOperationCalled != nil {
+		bc.SetEpochForPutOperationCalled(epoch)
+	}
 }
 
 // Destroy removes the underlying files/resources used by the storage service
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/storage/writeAheadLog"
+
+// WriteAheadLogStub -
+type WriteAheadLogStub struct {
+	AppendCalled func(group writeAheadLog.Group) error
+	GroupsCalled func() ([]writeAheadLog.Group, error)
+}
+
+// Append -
+func (stub *WriteAheadLogStub) Append(group writeAheadLog.Group) error {
+	if stub.AppendCalled != nil {
+		return stub.AppendCalled(group)
+	}
+
+	return nil
+}
+
+// Groups -
+func (stub *WriteAheadLogStub) Groups() ([]writeAheadLog.Group, error) {
+	if stub.GroupsCalled != nil {
+		return stub.GroupsCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *WriteAheadLogStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -41,6 +41,9 @@ const (
 	NonceType RequestDataType = 3
 	// EpochType indicates that the request data object is of type epoch
 	EpochType RequestDataType = 4
+	// StateChunkType indicates that the request data object contains a serialised root hash and the key after which
+	// the requested chunk of trie leaves starts
+	StateChunkType RequestDataType = 5
 )
 
 var RequestDataType_name = map[int32]string{
@@ -49,14 +52,16 @@ var RequestDataType_name = map[int32]string{
 	2: "HashArrayType",
 	3: "NonceType",
 	4: "EpochType",
+	5: "StateChunkType",
 }
 
 var RequestDataType_value = map[string]int32{
-	"InvalidType":   0,
-	"HashType":      1,
-	"HashArrayType": 2,
-	"NonceType":     3,
-	"EpochType":     4,
+	"InvalidType":    0,
+	"HashType":       1,
+	"HashArrayType":  2,
+	"NonceType":      3,
+	"EpochType":      4,
+	"StateChunkType": 5,
 }
 
 func (RequestDataType) EnumDescriptor() ([]byte, []int) {
@@ -128,27 +133,27 @@ func init() {
 func init() { proto.RegisterFile("requestData.proto", fileDescriptor_d2e280b7501d5666) }
 
 var fileDescriptor_d2e280b7501d5666 = []byte{
-	// 309 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0xbf, 0x4e, 0x7a, 0x31,
-	0x14, 0x80, 0x7b, 0xf8, 0xf3, 0x0b, 0x14, 0xee, 0x0f, 0xe9, 0x60, 0x88, 0xc3, 0x81, 0x38, 0x11,
-	0x13, 0x21, 0x51, 0x5f, 0x40, 0xa2, 0x51, 0x17, 0x87, 0x1b, 0xe3, 0xe0, 0x56, 0xa0, 0x02, 0x09,
-	0xd2, 0x6b, 0xe9, 0x25, 0x61, 0x73, 0x71, 0xf7, 0x31, 0x7c, 0x14, 0x47, 0x46, 0x26, 0xe2, 0xed,
-	0x5d, 0x0c, 0x13, 0x8f, 0x60, 0x7a, 0xee, 0xa0, 0x71, 0x6a, 0xbf, 0xaf, 0x3d, 0x5f, 0x72, 0x78,
-	0xdd, 0xa8, 0xe7, 0x58, 0xcd, 0xed, 0x85, 0xb4, 0xb2, 0x13, 0x19, 0x6d, 0xb5, 0x28, 0xd2, 0x71,
-	0x70, 0x3c, 0x9a, 0xd8, 0x71, 0xdc, 0xef, 0x0c, 0xf4, 0x53, 0x77, 0xa4, 0x47, 0xba, 0x4b, 0xba,
-	0x1f, 0x3f, 0x12, 0x11, 0xd0, 0x2d, 0x9b, 0x3a, 0x7c, 0x05, 0x5e, 0x09, 0x7f, 0x5a, 0xa2, 0xc9,
-	0x8b, 0xf7, 0x72, 0x1a, 0xab, 0x46, 0xae, 0x05, 0xed, 0x6a, 0xaf, 0xbc, 0xdd, 0x34, 0x8b, 0x0b,
-	0x2f, 0xc2, 0xcc, 0x8b, 0x33, 0x5e, 0xb8, 0x5b, 0x46, 0xaa, 0x01, 0x2d, 0x68, 0xff, 0x3f, 0xd9,
-	0xcf, 0x32, 0x9d, 0x5f, 0x09, 0xff, 0xda, 0x2b, 0x6d, 0x37, 0xcd, 0x82, 0x5d, 0x46, 0x2a, 0xa4,
-	0xdf, 0x3e, 0x7b, 0x19, 0xe9, 0xc1, 0xb8, 0x91, 0x6f, 0x41, 0x3b, 0xc8, 0xb2, 0xca, 0x8b, 0x30,
-	0xf3, 0x47, 0x92, 0xd7, 0xfe, 0x34, 0x44, 0x8d, 0x57, 0x6e, 0x66, 0x0b, 0x39, 0x9d, 0x0c, 0x3d,
-	0xee, 0x31, 0x51, 0xe5, 0xa5, 0x6b, 0x39, 0x1f, 0x13, 0x81, 0xa8, 0xf3, 0xc0, 0xd3, 0xb9, 0x31,
-	0x72, 0x49, 0x2a, 0x27, 0x02, 0x5e, 0xbe, 0xd5, 0xb3, 0x81, 0x22, 0xcc, 0x7b, 0xa4, 0x38, 0x61,
-	0xa1, 0x77, 0xb5, 0x4a, 0x90, 0xad, 0x13, 0x64, 0xbb, 0x04, 0xe1, 0xc5, 0x21, 0xbc, 0x3b, 0x84,
-	0x0f, 0x87, 0xb0, 0x72, 0x08, 0x6b, 0x87, 0xf0, 0xe9, 0x10, 0xbe, 0x1c, 0xb2, 0x9d, 0x43, 0x78,
-	0x4b, 0x91, 0xad, 0x52, 0x64, 0xeb, 0x14, 0xd9, 0x43, 0x30, 0x94, 0x56, 0x86, 0xca, 0x9a, 0x89,
-	0x5a, 0x28, 0xd3, 0xff, 0x47, 0x3b, 0x9f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x94, 0x8d, 0xc0,
-	0xb8, 0x85, 0x01, 0x00, 0x00,
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4e, 0x72, 0x31,
+	0x14, 0x80, 0x7b, 0x80, 0xfb, 0x07, 0x0a, 0x17, 0x7e, 0x3a, 0x18, 0xe2, 0x70, 0x20, 0x4e, 0xc4,
+	0x44, 0x48, 0xd4, 0x17, 0x10, 0x35, 0xea, 0xe2, 0x70, 0x35, 0x0e, 0x6e, 0x05, 0x2a, 0x97, 0x88,
+	0xf4, 0x7a, 0xe9, 0x25, 0x61, 0x73, 0x71, 0xf7, 0x31, 0x7c, 0x14, 0x47, 0x46, 0x26, 0x22, 0x65,
+	0x31, 0x4c, 0x3c, 0x82, 0xe9, 0xb9, 0x83, 0xc6, 0xa9, 0xfd, 0xbe, 0xf6, 0x7c, 0xc3, 0xe1, 0xd5,
+	0x58, 0x3d, 0x27, 0x6a, 0x62, 0xce, 0xa4, 0x91, 0xad, 0x28, 0xd6, 0x46, 0x0b, 0x8f, 0x8e, 0xdd,
+	0x83, 0xc1, 0xd0, 0x84, 0x49, 0xb7, 0xd5, 0xd3, 0x4f, 0xed, 0x81, 0x1e, 0xe8, 0x36, 0xe9, 0x6e,
+	0xf2, 0x40, 0x44, 0x40, 0xb7, 0x74, 0x6a, 0xef, 0x15, 0x78, 0x31, 0xf8, 0x69, 0x89, 0x3a, 0xf7,
+	0xee, 0xe4, 0x28, 0x51, 0xb5, 0x4c, 0x03, 0x9a, 0xa5, 0x4e, 0x61, 0xb3, 0xac, 0x7b, 0x53, 0x27,
+	0x82, 0xd4, 0x8b, 0x63, 0x9e, 0xbb, 0x9d, 0x45, 0xaa, 0x06, 0x0d, 0x68, 0x96, 0x0f, 0x77, 0xd2,
+	0x4c, 0xeb, 0x57, 0xc2, 0xbd, 0x76, 0xf2, 0x9b, 0x65, 0x3d, 0x67, 0x66, 0x91, 0x0a, 0xe8, 0xb7,
+	0xcb, 0x9e, 0x47, 0xba, 0x17, 0xd6, 0xb2, 0x0d, 0x68, 0xfa, 0x69, 0x56, 0x39, 0x11, 0xa4, 0x7e,
+	0x3f, 0xe1, 0x95, 0x3f, 0x0d, 0x51, 0xe1, 0xc5, 0xab, 0xf1, 0x54, 0x8e, 0x86, 0x7d, 0x87, 0xff,
+	0x99, 0x28, 0xf1, 0xfc, 0xa5, 0x9c, 0x84, 0x44, 0x20, 0xaa, 0xdc, 0x77, 0x74, 0x12, 0xc7, 0x72,
+	0x46, 0x2a, 0x23, 0x7c, 0x5e, 0xb8, 0xd6, 0xe3, 0x9e, 0x22, 0xcc, 0x3a, 0xa4, 0x38, 0x61, 0x4e,
+	0x08, 0x5e, 0xbe, 0x31, 0xd2, 0xa8, 0xd3, 0x30, 0x19, 0x3f, 0x92, 0xf3, 0x3a, 0x17, 0xf3, 0x15,
+	0xb2, 0xc5, 0x0a, 0xd9, 0x76, 0x85, 0xf0, 0x62, 0x11, 0xde, 0x2d, 0xc2, 0x87, 0x45, 0x98, 0x5b,
+	0x84, 0x85, 0x45, 0xf8, 0xb4, 0x08, 0x5f, 0x16, 0xd9, 0xd6, 0x22, 0xbc, 0xad, 0x91, 0xcd, 0xd7,
+	0xc8, 0x16, 0x6b, 0x64, 0xf7, 0x7e, 0x5f, 0x1a, 0x19, 0x28, 0x13, 0x0f, 0xd5, 0x54, 0xc5, 0xdd,
+	0x7f, 0xb4, 0x87, 0xa3, 0xef, 0x01, 0x00, 0xcc, 0xad, 0x2a, 0xee, 0x99, 0x01, 0x00, 0x00,
 }
 
 func (x RequestDataType) String() string {
@@ -419,6 +419,52 @@ func (rrh *resolverRequestHandler) logTrieHashesFromAccumulator() {
 	}
 }
 
+// RequestStateChunk method asks for the state chunk that starts after the given key, in the trie with the given
+// root hash, from the connected peers
+func (rrh *resolverRequestHandler) RequestStateChunk(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string) {
+	key := append(append(make([]byte, 0, len(rootHash)+len(startAfterKey)), rootHash...), startAfterKey...)
+	if !rrh.testIfRequestIsNeeded(key) {
+		return
+	}
+
+	log.Trace("requesting state chunk from network",
+		"topic", topic,
+		"shard", destShardID,
+		"root hash", rootHash,
+		"start after key", startAfterKey,
+	)
+
+	resolver, err := rrh.resolversFinder.MetaCrossShardResolver(topic, destShardID)
+	if err != nil {
+		log.Error("RequestStateChunk.MetaCrossShardResolver",
+			"error", err.Error(),
+			"topic", topic,
+			"shard", destShardID,
+		)
+		return
+	}
+
+	stateChunksResolver, ok := resolver.(dataRetriever.StateChunksResolver)
+	if !ok {
+		log.Warn("wrong assertion type when creating a state chunks resolver")
+		return
+	}
+
+	rrh.whiteList.Add([][]byte{rootHash})
+
+	err = stateChunksResolver.RequestStateChunk(rootHash, startAfterKey)
+	if err != nil {
+		log.Debug("RequestStateChunk.RequestStateChunk",
+			"error", err.Error(),
+			"topic", topic,
+			"shard", destShardID,
+		)
+		return
+	}
+
+	rrh.addRequestedItems([][]byte{key})
+}
+
 // RequestMetaHeaderByNonce method asks for meta header from the connected peers by nonce
 func (rrh *resolverRequestHandler) RequestMetaHeaderByNonce(nonce uint64) {
 	key := []byte(fmt.Sprintf("%d-%d", core.MetachainShardId, nonce))
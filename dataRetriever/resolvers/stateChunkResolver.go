@@ -0,0 +1,164 @@
+package resolvers
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+var _ dataRetriever.StateChunksResolver = (*StateChunkResolver)(nil)
+
+// maxBuffToSendStateChunk represents max size of the chunk leaves in bytes
+var maxBuffToSendStateChunk = uint64(1 << 18) //256KB
+
+const numStateChunkRequestFields = 2
+
+// ArgStateChunkResolver is the argument structure used to create new StateChunkResolver instance
+type ArgStateChunkResolver struct {
+	SenderResolver   dataRetriever.TopicResolverSender
+	StateChunkGetter dataRetriever.StateChunkGetter
+	Marshalizer      marshal.Marshalizer
+	AntifloodHandler dataRetriever.P2PAntifloodHandler
+	Throttler        dataRetriever.ResolverThrottler
+}
+
+// StateChunkResolver is a wrapper over Resolver that is specialized in resolving state chunk requests. The chunks are
+// served from the trie snapshots
+type StateChunkResolver struct {
+	dataRetriever.TopicResolverSender
+	messageProcessor
+	stateChunkGetter dataRetriever.StateChunkGetter
+}
+
+// NewStateChunkResolver creates a new state chunk resolver
+func NewStateChunkResolver(arg ArgStateChunkResolver) (*StateChunkResolver, error) {
+	if check.IfNil(arg.SenderResolver) {
+		return nil, dataRetriever.ErrNilResolverSender
+	}
+	if check.IfNil(arg.StateChunkGetter) {
+		return nil, dataRetriever.ErrNilStateChunkGetter
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.AntifloodHandler) {
+		return nil, dataRetriever.ErrNilAntifloodHandler
+	}
+	if check.IfNil(arg.Throttler) {
+		return nil, dataRetriever.ErrNilThrottler
+	}
+
+	return &StateChunkResolver{
+		TopicResolverSender: arg.SenderResolver,
+		stateChunkGetter:    arg.StateChunkGetter,
+		messageProcessor: messageProcessor{
+			marshalizer:      arg.Marshalizer,
+			antifloodHandler: arg.AntifloodHandler,
+			topic:            arg.SenderResolver.RequestTopic(),
+			throttler:        arg.Throttler,
+		},
+	}, nil
+}
+
+// ProcessReceivedMessage will be the callback func from the p2p.Messenger and will be called each time a new message was received
+// (for the topic this validator was registered to, usually a request topic)
+func (scRes *StateChunkResolver) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	err := scRes.canProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	scRes.throttler.StartProcessing()
+	defer scRes.throttler.EndProcessing()
+
+	rd, err := scRes.parseReceivedMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+
+	switch rd.Type {
+	case dataRetriever.HashType:
+		return scRes.resolveStateChunk(rd.Value, nil, message)
+	case dataRetriever.StateChunkType:
+		b := batch.Batch{}
+		err = scRes.marshalizer.Unmarshal(&b, rd.Value)
+		if err != nil {
+			return err
+		}
+		if len(b.Data) != numStateChunkRequestFields {
+			return dataRetriever.ErrInvalidValue
+		}
+
+		return scRes.resolveStateChunk(b.Data[0], b.Data[1], message)
+	default:
+		return dataRetriever.ErrRequestTypeNotImplemented
+	}
+}
+
+func (scRes *StateChunkResolver) resolveStateChunk(rootHash []byte, startAfterKey []byte, message p2p.MessageP2P) error {
+	serializedChunk, err := scRes.stateChunkGetter.GetSerializedStateChunk(rootHash, startAfterKey, maxBuffToSendStateChunk)
+	if err != nil {
+		scRes.ResolverDebugHandler().LogFailedToResolveData(
+			scRes.topic,
+			rootHash,
+			err,
+		)
+
+		return err
+	}
+
+	scRes.ResolverDebugHandler().LogSucceededToResolveData(scRes.topic, rootHash)
+
+	return scRes.Send(serializedChunk, message.Peer())
+}
+
+// RequestDataFromHash requests the first state chunk of the trie with the given root hash from other peers
+func (scRes *StateChunkResolver) RequestDataFromHash(rootHash []byte, _ uint32) error {
+	return scRes.SendOnRequestTopic(
+		&dataRetriever.RequestData{
+			Type:  dataRetriever.HashType,
+			Value: rootHash,
+		},
+		[][]byte{rootHash},
+	)
+}
+
+// RequestStateChunk requests from other peers the state chunk that starts after the given key, in the trie with the
+// given root hash
+func (scRes *StateChunkResolver) RequestStateChunk(rootHash []byte, startAfterKey []byte) error {
+	buff, err := scRes.marshalizer.Marshal(batch.New(rootHash, startAfterKey))
+	if err != nil {
+		return err
+	}
+
+	return scRes.SendOnRequestTopic(
+		&dataRetriever.RequestData{
+			Type:  dataRetriever.StateChunkType,
+			Value: buff,
+		},
+		[][]byte{rootHash},
+	)
+}
+
+// SetNumPeersToQuery will set the number of intra shard and cross shard number of peer to query
+func (scRes *StateChunkResolver) SetNumPeersToQuery(intra int, cross int) {
+	scRes.TopicResolverSender.SetNumPeersToQuery(intra, cross)
+}
+
+// NumPeersToQuery will return the number of intra shard and cross shard number of peer to query
+func (scRes *StateChunkResolver) NumPeersToQuery() (int, int) {
+	return scRes.TopicResolverSender.NumPeersToQuery()
+}
+
+// SetResolverDebugHandler will set a resolver debug handler
+func (scRes *StateChunkResolver) SetResolverDebugHandler(handler dataRetriever.ResolverDebugHandler) error {
+	return scRes.TopicResolverSender.SetResolverDebugHandler(handler)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (scRes *StateChunkResolver) IsInterfaceNil() bool {
+	return scRes == nil
+}
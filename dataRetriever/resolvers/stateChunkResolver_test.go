@@ -0,0 +1,215 @@
+package resolvers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgStateChunkResolver() resolvers.ArgStateChunkResolver {
+	return resolvers.ArgStateChunkResolver{
+		SenderResolver:   &mock.TopicResolverSenderStub{},
+		StateChunkGetter: &mock.TrieStub{},
+		Marshalizer:      &mock.MarshalizerMock{},
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		Throttler:        &mock.ThrottlerStub{},
+	}
+}
+
+func TestNewStateChunkResolver_NilResolverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgStateChunkResolver()
+	arg.SenderResolver = nil
+	scRes, err := resolvers.NewStateChunkResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilResolverSender, err)
+	assert.Nil(t, scRes)
+}
+
+func TestNewStateChunkResolver_NilStateChunkGetterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgStateChunkResolver()
+	arg.StateChunkGetter = nil
+	scRes, err := resolvers.NewStateChunkResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilStateChunkGetter, err)
+	assert.Nil(t, scRes)
+}
+
+func TestNewStateChunkResolver_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgStateChunkResolver()
+	arg.Marshalizer = nil
+	scRes, err := resolvers.NewStateChunkResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+	assert.Nil(t, scRes)
+}
+
+func TestNewStateChunkResolver_NilAntiflooderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgStateChunkResolver()
+	arg.AntifloodHandler = nil
+	scRes, err := resolvers.NewStateChunkResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilAntifloodHandler, err)
+	assert.Nil(t, scRes)
+}
+
+func TestNewStateChunkResolver_NilThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgStateChunkResolver()
+	arg.Throttler = nil
+	scRes, err := resolvers.NewStateChunkResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilThrottler, err)
+	assert.Nil(t, scRes)
+}
+
+func TestNewStateChunkResolver_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	scRes, err := resolvers.NewStateChunkResolver(createMockArgStateChunkResolver())
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(scRes))
+}
+
+func TestStateChunkResolver_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgStateChunkResolver()
+	scRes, _ := resolvers.NewStateChunkResolver(arg)
+
+	err := scRes.ProcessReceivedMessage(nil, fromConnectedPeer)
+	assert.Equal(t, dataRetriever.ErrNilMessage, err)
+	assert.False(t, arg.Throttler.(*mock.ThrottlerStub).StartWasCalled)
+}
+
+func TestStateChunkResolver_ProcessReceivedMessageWrongTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgStateChunkResolver()
+	scRes, _ := resolvers.NewStateChunkResolver(arg)
+
+	data, _ := arg.Marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.HashArrayType, Value: []byte("aaa")})
+	msg := &mock.P2PMessageMock{DataField: data}
+
+	err := scRes.ProcessReceivedMessage(msg, fromConnectedPeer)
+	assert.Equal(t, dataRetriever.ErrRequestTypeNotImplemented, err)
+	assert.True(t, arg.Throttler.(*mock.ThrottlerStub).StartWasCalled)
+	assert.True(t, arg.Throttler.(*mock.ThrottlerStub).EndWasCalled)
+}
+
+func TestStateChunkResolver_ProcessReceivedMessageInvalidRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgStateChunkResolver()
+	scRes, _ := resolvers.NewStateChunkResolver(arg)
+
+	value, _ := arg.Marshalizer.Marshal(batch.New([]byte("root hash")))
+	data, _ := arg.Marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.StateChunkType, Value: value})
+	msg := &mock.P2PMessageMock{DataField: data}
+
+	err := scRes.ProcessReceivedMessage(msg, fromConnectedPeer)
+	assert.Equal(t, dataRetriever.ErrInvalidValue, err)
+}
+
+func TestStateChunkResolver_ProcessReceivedMessageGetterErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected err")
+	arg := createMockArgStateChunkResolver()
+	arg.StateChunkGetter = &mock.TrieStub{
+		GetSerializedStateChunkCalled: func(_ []byte, _ []byte, _ uint64) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	arg.SenderResolver = &mock.TopicResolverSenderStub{
+		SendCalled: func(_ []byte, _ core.PeerID) error {
+			assert.Fail(t, "should have not sent")
+			return nil
+		},
+	}
+	scRes, _ := resolvers.NewStateChunkResolver(arg)
+
+	data, _ := arg.Marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.HashType, Value: []byte("root hash")})
+	msg := &mock.P2PMessageMock{DataField: data}
+
+	err := scRes.ProcessReceivedMessage(msg, fromConnectedPeer)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestStateChunkResolver_ProcessReceivedMessageShouldGetChunkAndSend(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	startAfterKey := []byte("start after key")
+	serializedChunk := []byte("serialized chunk")
+	var sentBuff []byte
+
+	arg := createMockArgStateChunkResolver()
+	arg.StateChunkGetter = &mock.TrieStub{
+		GetSerializedStateChunkCalled: func(providedRootHash []byte, providedStartAfterKey []byte, _ uint64) ([]byte, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			assert.Equal(t, startAfterKey, providedStartAfterKey)
+			return serializedChunk, nil
+		},
+	}
+	arg.SenderResolver = &mock.TopicResolverSenderStub{
+		SendCalled: func(buff []byte, _ core.PeerID) error {
+			sentBuff = buff
+			return nil
+		},
+	}
+	scRes, _ := resolvers.NewStateChunkResolver(arg)
+
+	value, _ := arg.Marshalizer.Marshal(batch.New(rootHash, startAfterKey))
+	data, _ := arg.Marshalizer.Marshal(&dataRetriever.RequestData{Type: dataRetriever.StateChunkType, Value: value})
+	msg := &mock.P2PMessageMock{DataField: data}
+
+	err := scRes.ProcessReceivedMessage(msg, fromConnectedPeer)
+	assert.Nil(t, err)
+	assert.Equal(t, serializedChunk, sentBuff)
+	assert.True(t, arg.Throttler.(*mock.ThrottlerStub).EndWasCalled)
+}
+
+func TestStateChunkResolver_RequestStateChunkShouldSendTheRootHashAndTheStartKey(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	startAfterKey := []byte("start after key")
+	sendWasCalled := false
+
+	arg := createMockArgStateChunkResolver()
+	arg.SenderResolver = &mock.TopicResolverSenderStub{
+		SendOnRequestTopicCalled: func(rd *dataRetriever.RequestData, originalHashes [][]byte) error {
+			sendWasCalled = true
+			assert.Equal(t, dataRetriever.StateChunkType, rd.Type)
+			assert.Equal(t, [][]byte{rootHash}, originalHashes)
+
+			b := &batch.Batch{}
+			require.Nil(t, arg.Marshalizer.Unmarshal(b, rd.Value))
+			assert.Equal(t, [][]byte{rootHash, startAfterKey}, b.Data)
+			return nil
+		},
+	}
+	scRes, _ := resolvers.NewStateChunkResolver(arg)
+
+	err := scRes.RequestStateChunk(rootHash, startAfterKey)
+	assert.Nil(t, err)
+	assert.True(t, sendWasCalled)
+}
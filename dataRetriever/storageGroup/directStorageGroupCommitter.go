@@ -0,0 +1,44 @@
+package storageGroup
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var _ dataRetriever.StorageGroupCommitter = (*directStorageGroupCommitter)(nil)
+
+// directStorageGroupCommitter writes the data directly in the storage units, without any crash consistency
+// guarantee. It is used when the write ahead log is disabled
+type directStorageGroupCommitter struct {
+	store dataRetriever.StorageService
+}
+
+// NewDirectStorageGroupCommitter creates a storage group committer which does not group the writes
+func NewDirectStorageGroupCommitter(store dataRetriever.StorageService) (*directStorageGroupCommitter, error) {
+	if check.IfNil(store) {
+		return nil, dataRetriever.ErrNilStore
+	}
+
+	return &directStorageGroupCommitter{
+		store: store,
+	}, nil
+}
+
+// Put writes the key, value pair in the selected storage unit
+func (dsgc *directStorageGroupCommitter) Put(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+	return dsgc.store.Put(unitType, key, value)
+}
+
+// Commit does nothing as the data was already written
+func (dsgc *directStorageGroupCommitter) Commit(_ uint32) error {
+	return nil
+}
+
+// Discard does nothing as the data was already written
+func (dsgc *directStorageGroupCommitter) Discard() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsgc *directStorageGroupCommitter) IsInterfaceNil() bool {
+	return dsgc == nil
+}
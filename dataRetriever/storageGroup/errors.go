@@ -0,0 +1,12 @@
+package storageGroup
+
+import "errors"
+
+// ErrNilWriteAheadLog signals that a nil write ahead log has been provided
+var ErrNilWriteAheadLog = errors.New("nil write ahead log")
+
+// ErrNilStorageGroupCommitter signals that a nil storage group committer has been provided
+var ErrNilStorageGroupCommitter = errors.New("nil storage group committer")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
@@ -0,0 +1,43 @@
+package storageGroup
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+var _ dataRetriever.StorageService = (*groupedStorageService)(nil)
+
+// groupedStorageService is a storage service which adds the put operations to the storage group of the block being
+// committed. All the other operations are done directly on the wrapped storage service
+type groupedStorageService struct {
+	dataRetriever.StorageService
+	storageGroupCommitter dataRetriever.StorageGroupCommitter
+}
+
+// NewGroupedStorageService creates a storage service which writes through the provided storage group committer
+func NewGroupedStorageService(
+	store dataRetriever.StorageService,
+	storageGroupCommitter dataRetriever.StorageGroupCommitter,
+) (*groupedStorageService, error) {
+	if check.IfNil(store) {
+		return nil, dataRetriever.ErrNilStore
+	}
+	if check.IfNil(storageGroupCommitter) {
+		return nil, ErrNilStorageGroupCommitter
+	}
+
+	return &groupedStorageService{
+		StorageService:        store,
+		storageGroupCommitter: storageGroupCommitter,
+	}, nil
+}
+
+// Put adds the key, value pair to the storage group of the block being committed
+func (gss *groupedStorageService) Put(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+	return gss.storageGroupCommitter.Put(unitType, key, value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gss *groupedStorageService) IsInterfaceNil() bool {
+	return gss == nil
+}
@@ -0,0 +1,66 @@
+package storageGroup_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGroupedStorageService_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gss, err := storageGroup.NewGroupedStorageService(nil, &testscommon.StorageGroupCommitterStub{})
+	assert.True(t, check.IfNil(gss))
+	assert.Equal(t, dataRetriever.ErrNilStore, err)
+}
+
+func TestNewGroupedStorageService_NilStorageGroupCommitterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gss, err := storageGroup.NewGroupedStorageService(&mock.ChainStorerMock{}, nil)
+	assert.True(t, check.IfNil(gss))
+	assert.Equal(t, storageGroup.ErrNilStorageGroupCommitter, err)
+}
+
+func TestGroupedStorageService_PutShouldAddToTheGroup(t *testing.T) {
+	t.Parallel()
+
+	store := &mock.ChainStorerMock{
+		PutCalled: func(_ dataRetriever.UnitType, _ []byte, _ []byte) error {
+			assert.Fail(t, "should have not written directly in the store")
+			return nil
+		},
+	}
+	var puts []putOperation
+	storageGroupCommitter := &testscommon.StorageGroupCommitterStub{
+		PutCalled: func(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+			puts = append(puts, putOperation{unit: unitType, key: string(key), value: string(value)})
+			return nil
+		},
+	}
+	gss, _ := storageGroup.NewGroupedStorageService(store, storageGroupCommitter)
+
+	err := gss.Put(dataRetriever.TransactionUnit, []byte("key"), []byte("value"))
+	assert.Nil(t, err)
+	assert.Equal(t, []putOperation{{unit: dataRetriever.TransactionUnit, key: "key", value: "value"}}, puts)
+}
+
+func TestGroupedStorageService_GetShouldReadFromTheStore(t *testing.T) {
+	t.Parallel()
+
+	store := &mock.ChainStorerMock{
+		GetCalled: func(_ dataRetriever.UnitType, key []byte) ([]byte, error) {
+			return append([]byte("value of "), key...), nil
+		},
+	}
+	gss, _ := storageGroup.NewGroupedStorageService(store, &testscommon.StorageGroupCommitterStub{})
+
+	value, err := gss.Get(dataRetriever.TransactionUnit, []byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value of key"), value)
+}
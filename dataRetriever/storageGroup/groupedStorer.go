@@ -0,0 +1,47 @@
+package storageGroup
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Storer = (*groupedStorer)(nil)
+
+// groupedStorer is a storer which adds the put operations to the storage group of the block being committed. All
+// the other operations are done directly on the wrapped storer
+type groupedStorer struct {
+	storage.Storer
+	unitType              dataRetriever.UnitType
+	storageGroupCommitter dataRetriever.StorageGroupCommitter
+}
+
+// NewGroupedStorer creates a storer for the provided unit type which writes through the storage group committer
+func NewGroupedStorer(
+	storer storage.Storer,
+	unitType dataRetriever.UnitType,
+	storageGroupCommitter dataRetriever.StorageGroupCommitter,
+) (*groupedStorer, error) {
+	if check.IfNil(storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(storageGroupCommitter) {
+		return nil, ErrNilStorageGroupCommitter
+	}
+
+	return &groupedStorer{
+		Storer:                storer,
+		unitType:              unitType,
+		storageGroupCommitter: storageGroupCommitter,
+	}, nil
+}
+
+// Put adds the key, value pair to the storage group of the block being committed
+func (gs *groupedStorer) Put(key []byte, data []byte) error {
+	return gs.storageGroupCommitter.Put(gs.unitType, key, data)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gs *groupedStorer) IsInterfaceNil() bool {
+	return gs == nil
+}
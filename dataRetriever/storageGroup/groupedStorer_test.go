@@ -0,0 +1,66 @@
+package storageGroup_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGroupedStorer_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gs, err := storageGroup.NewGroupedStorer(nil, dataRetriever.BootstrapUnit, &testscommon.StorageGroupCommitterStub{})
+	assert.True(t, check.IfNil(gs))
+	assert.Equal(t, storageGroup.ErrNilStorer, err)
+}
+
+func TestNewGroupedStorer_NilStorageGroupCommitterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	gs, err := storageGroup.NewGroupedStorer(&mock.StorerStub{}, dataRetriever.BootstrapUnit, nil)
+	assert.True(t, check.IfNil(gs))
+	assert.Equal(t, storageGroup.ErrNilStorageGroupCommitter, err)
+}
+
+func TestGroupedStorer_PutShouldAddToTheGroup(t *testing.T) {
+	t.Parallel()
+
+	storer := &mock.StorerStub{
+		PutCalled: func(_, _ []byte) error {
+			assert.Fail(t, "should have not written directly in the storer")
+			return nil
+		},
+	}
+	var puts []putOperation
+	storageGroupCommitter := &testscommon.StorageGroupCommitterStub{
+		PutCalled: func(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+			puts = append(puts, putOperation{unit: unitType, key: string(key), value: string(value)})
+			return nil
+		},
+	}
+	gs, _ := storageGroup.NewGroupedStorer(storer, dataRetriever.BootstrapUnit, storageGroupCommitter)
+
+	err := gs.Put([]byte("key"), []byte("value"))
+	assert.Nil(t, err)
+	assert.Equal(t, []putOperation{{unit: dataRetriever.BootstrapUnit, key: "key", value: "value"}}, puts)
+}
+
+func TestGroupedStorer_GetShouldReadFromTheStorer(t *testing.T) {
+	t.Parallel()
+
+	storer := &mock.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return append([]byte("value of "), key...), nil
+		},
+	}
+	gs, _ := storageGroup.NewGroupedStorer(storer, dataRetriever.BootstrapUnit, &testscommon.StorageGroupCommitterStub{})
+
+	value, err := gs.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value of key"), value)
+}
@@ -0,0 +1,10 @@
+package storageGroup
+
+import "github.com/ElrondNetwork/elrond-go/storage/writeAheadLog"
+
+// WriteAheadLog defines the log in which the groups are persisted before they are written in the storage units
+type WriteAheadLog interface {
+	Append(group writeAheadLog.Group) error
+	Groups() ([]writeAheadLog.Group, error)
+	IsInterfaceNil() bool
+}
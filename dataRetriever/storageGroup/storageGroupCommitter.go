@@ -0,0 +1,136 @@
+package storageGroup
+
+import (
+	"fmt"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage/writeAheadLog"
+)
+
+var _ dataRetriever.StorageGroupCommitter = (*storageGroupCommitter)(nil)
+
+var log = logger.GetOrCreate("dataRetriever/storageGroup")
+
+// ArgsStorageGroupCommitter holds the arguments needed to create a storage group committer
+type ArgsStorageGroupCommitter struct {
+	Store         dataRetriever.StorageService
+	WriteAheadLog WriteAheadLog
+}
+
+// storageGroupCommitter buffers the writes until the group is committed. The group is first persisted in the write
+// ahead log and only then written in the storage units, so a crash leaves either none or all of its writes, as the
+// group is replayed on the next start
+type storageGroupCommitter struct {
+	store         dataRetriever.StorageService
+	writeAheadLog WriteAheadLog
+	mutEntries    sync.Mutex
+	entries       []writeAheadLog.Entry
+}
+
+// NewStorageGroupCommitter creates a storage group committer backed by a write ahead log
+func NewStorageGroupCommitter(args ArgsStorageGroupCommitter) (*storageGroupCommitter, error) {
+	if check.IfNil(args.Store) {
+		return nil, dataRetriever.ErrNilStore
+	}
+	if check.IfNil(args.WriteAheadLog) {
+		return nil, ErrNilWriteAheadLog
+	}
+
+	return &storageGroupCommitter{
+		store:         args.Store,
+		writeAheadLog: args.WriteAheadLog,
+		entries:       make([]writeAheadLog.Entry, 0),
+	}, nil
+}
+
+// Put adds the key, value pair to the current group. The value can not be read from the storage unit until the
+// group is committed
+func (sgc *storageGroupCommitter) Put(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+	sgc.mutEntries.Lock()
+	sgc.entries = append(sgc.entries, writeAheadLog.Entry{
+		Unit:  uint8(unitType),
+		Key:   key,
+		Value: value,
+	})
+	sgc.mutEntries.Unlock()
+
+	return nil
+}
+
+// Commit persists the current group in the write ahead log and then writes it in the storage units. If the log can
+// not be written, the data is still written in the storage units, but without the crash consistency guarantee
+func (sgc *storageGroupCommitter) Commit(epoch uint32) error {
+	sgc.mutEntries.Lock()
+	entries := sgc.entries
+	sgc.entries = make([]writeAheadLog.Entry, 0, len(entries))
+	sgc.mutEntries.Unlock()
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	errAppend := sgc.writeAheadLog.Append(writeAheadLog.Group{
+		Epoch:   epoch,
+		Entries: entries,
+	})
+
+	errPut := sgc.putEntries(entries)
+	if errAppend != nil {
+		return fmt.Errorf("%w while appending the group in the write ahead log", errAppend)
+	}
+
+	return errPut
+}
+
+// Discard drops the writes of the current group, which will never reach the storage units
+func (sgc *storageGroupCommitter) Discard() {
+	sgc.mutEntries.Lock()
+	sgc.entries = make([]writeAheadLog.Entry, 0)
+	sgc.mutEntries.Unlock()
+}
+
+// ReplayGroups writes again in the storage units all the groups found in the write ahead log, in the epochs they
+// were committed in, and then sets the provided epoch for the put operations
+func (sgc *storageGroupCommitter) ReplayGroups(currentEpoch uint32) error {
+	groups, err := sgc.writeAheadLog.Groups()
+	if err != nil {
+		return err
+	}
+
+	defer sgc.store.SetEpochForPutOperation(currentEpoch)
+
+	for _, group := range groups {
+		sgc.store.SetEpochForPutOperation(group.Epoch)
+
+		err = sgc.putEntries(group.Entries)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("replayed the write ahead log", "num groups", len(groups))
+
+	return nil
+}
+
+func (sgc *storageGroupCommitter) putEntries(entries []writeAheadLog.Entry) error {
+	var lastErr error
+	for _, entry := range entries {
+		unitType := dataRetriever.UnitType(entry.Unit)
+		err := sgc.store.Put(unitType, entry.Key, entry.Value)
+		if err != nil {
+			lastErr = fmt.Errorf("%w for unit %s", err, unitType.String())
+			log.Warn("storageGroupCommitter.Put", "unit", unitType.String(), "error", err.Error())
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sgc *storageGroupCommitter) IsInterfaceNil() bool {
+	return sgc == nil
+}
@@ -0,0 +1,196 @@
+package storageGroup_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
+	"github.com/ElrondNetwork/elrond-go/storage/writeAheadLog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type putOperation struct {
+	epoch uint32
+	unit  dataRetriever.UnitType
+	key   string
+	value string
+}
+
+func createStoreRecordingPuts(puts *[]putOperation) *mock.ChainStorerMock {
+	epoch := uint32(0)
+	return &mock.ChainStorerMock{
+		PutCalled: func(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+			*puts = append(*puts, putOperation{epoch: epoch, unit: unitType, key: string(key), value: string(value)})
+			return nil
+		},
+		SetEpochForPutOperationCalled: func(e uint32) {
+			epoch = e
+		},
+	}
+}
+
+func createWriteAheadLog(t *testing.T) (storageGroup.WriteAheadLog, string) {
+	dir, _ := ioutil.TempDir("", "storageGroup")
+	wal, err := writeAheadLog.NewWriteAheadLog(dir, 10)
+	require.Nil(t, err)
+
+	return wal, dir
+}
+
+func TestNewStorageGroupCommitter_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sgc, err := storageGroup.NewStorageGroupCommitter(storageGroup.ArgsStorageGroupCommitter{
+		WriteAheadLog: &mock.WriteAheadLogStub{},
+	})
+	assert.True(t, check.IfNil(sgc))
+	assert.Equal(t, dataRetriever.ErrNilStore, err)
+
+	sgc, err = storageGroup.NewStorageGroupCommitter(storageGroup.ArgsStorageGroupCommitter{
+		Store: &mock.ChainStorerMock{},
+	})
+	assert.True(t, check.IfNil(sgc))
+	assert.Equal(t, storageGroup.ErrNilWriteAheadLog, err)
+}
+
+func TestStorageGroupCommitter_PutShouldWriteOnlyOnCommit(t *testing.T) {
+	t.Parallel()
+
+	wal, dir := createWriteAheadLog(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	puts := make([]putOperation, 0)
+	sgc, _ := storageGroup.NewStorageGroupCommitter(storageGroup.ArgsStorageGroupCommitter{
+		Store:         createStoreRecordingPuts(&puts),
+		WriteAheadLog: wal,
+	})
+
+	_ = sgc.Put(dataRetriever.BlockHeaderUnit, []byte("hash"), []byte("header"))
+	_ = sgc.Put(dataRetriever.MiniBlockUnit, []byte("mb hash"), []byte("miniblock"))
+	assert.Equal(t, 0, len(puts))
+
+	err := sgc.Commit(3)
+	assert.Nil(t, err)
+	assert.Equal(t, []putOperation{
+		{unit: dataRetriever.BlockHeaderUnit, key: "hash", value: "header"},
+		{unit: dataRetriever.MiniBlockUnit, key: "mb hash", value: "miniblock"},
+	}, puts)
+
+	groups, _ := wal.Groups()
+	require.Equal(t, 1, len(groups))
+	assert.Equal(t, uint32(3), groups[0].Epoch)
+	assert.Equal(t, 2, len(groups[0].Entries))
+
+	err = sgc.Commit(3)
+	assert.Nil(t, err)
+	groups, _ = wal.Groups()
+	assert.Equal(t, 1, len(groups))
+}
+
+func TestStorageGroupCommitter_CommitShouldWriteTheDataEvenIfTheLogFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	puts := make([]putOperation, 0)
+	sgc, _ := storageGroup.NewStorageGroupCommitter(storageGroup.ArgsStorageGroupCommitter{
+		Store: createStoreRecordingPuts(&puts),
+		WriteAheadLog: &mock.WriteAheadLogStub{
+			AppendCalled: func(_ writeAheadLog.Group) error {
+				return expectedErr
+			},
+		},
+	})
+
+	_ = sgc.Put(dataRetriever.BlockHeaderUnit, []byte("hash"), []byte("header"))
+	err := sgc.Commit(0)
+
+	assert.True(t, errors.Is(err, expectedErr))
+	assert.Equal(t, 1, len(puts))
+}
+
+func TestStorageGroupCommitter_ReplayGroupsShouldWriteTheGroupsInTheirEpochs(t *testing.T) {
+	t.Parallel()
+
+	wal, dir := createWriteAheadLog(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	_ = wal.Append(writeAheadLog.Group{
+		Epoch:   4,
+		Entries: []writeAheadLog.Entry{{Unit: uint8(dataRetriever.BlockHeaderUnit), Key: []byte("h1"), Value: []byte("v1")}},
+	})
+	_ = wal.Append(writeAheadLog.Group{
+		Epoch:   5,
+		Entries: []writeAheadLog.Entry{{Unit: uint8(dataRetriever.BootstrapUnit), Key: []byte("h2"), Value: []byte("v2")}},
+	})
+
+	puts := make([]putOperation, 0)
+	lastEpoch := uint32(0)
+	store := createStoreRecordingPuts(&puts)
+	setEpoch := store.SetEpochForPutOperationCalled
+	store.SetEpochForPutOperationCalled = func(epoch uint32) {
+		lastEpoch = epoch
+		setEpoch(epoch)
+	}
+	sgc, _ := storageGroup.NewStorageGroupCommitter(storageGroup.ArgsStorageGroupCommitter{
+		Store:         store,
+		WriteAheadLog: wal,
+	})
+
+	err := sgc.ReplayGroups(6)
+	assert.Nil(t, err)
+	assert.Equal(t, []putOperation{
+		{epoch: 4, unit: dataRetriever.BlockHeaderUnit, key: "h1", value: "v1"},
+		{epoch: 5, unit: dataRetriever.BootstrapUnit, key: "h2", value: "v2"},
+	}, puts)
+	assert.Equal(t, uint32(6), lastEpoch)
+}
+
+func TestStorageGroupCommitter_DiscardShouldDropTheCurrentGroup(t *testing.T) {
+	t.Parallel()
+
+	wal, dir := createWriteAheadLog(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	puts := make([]putOperation, 0)
+	sgc, _ := storageGroup.NewStorageGroupCommitter(storageGroup.ArgsStorageGroupCommitter{
+		Store:         createStoreRecordingPuts(&puts),
+		WriteAheadLog: wal,
+	})
+
+	_ = sgc.Put(dataRetriever.BlockHeaderUnit, []byte("hash"), []byte("header"))
+	sgc.Discard()
+	_ = sgc.Put(dataRetriever.MiniBlockUnit, []byte("mb hash"), []byte("miniblock"))
+
+	err := sgc.Commit(3)
+	assert.Nil(t, err)
+	assert.Equal(t, []putOperation{
+		{unit: dataRetriever.MiniBlockUnit, key: "mb hash", value: "miniblock"},
+	}, puts)
+}
+
+func TestDirectStorageGroupCommitter_PutShouldWriteDirectly(t *testing.T) {
+	t.Parallel()
+
+	dsgc, err := storageGroup.NewDirectStorageGroupCommitter(nil)
+	assert.True(t, check.IfNil(dsgc))
+	assert.Equal(t, dataRetriever.ErrNilStore, err)
+
+	puts := make([]putOperation, 0)
+	dsgc, _ = storageGroup.NewDirectStorageGroupCommitter(createStoreRecordingPuts(&puts))
+
+	_ = dsgc.Put(dataRetriever.ReceiptsUnit, []byte("hash"), []byte("receipts"))
+	assert.Equal(t, 1, len(puts))
+	assert.Nil(t, dsgc.Commit(0))
+}
@@ -102,6 +102,16 @@ func (a *accountsAdapter) RecreateAllTries(_ []byte, _ context.Context) (map[str
 	return nil, nil
 }
 
+// GetTrie -
+func (a *accountsAdapter) GetTrie(_ []byte) (data.Trie, error) {
+	return nil, nil
+}
+
+// GetStateDiff -
+func (a *accountsAdapter) GetStateDiff(_ []byte, _ []byte, _ int, _ func(state.AccountDiff) bool) (bool, error) {
+	return false, nil
+}
+
 // GetNumCheckpoints -
 func (a *accountsAdapter) GetNumCheckpoints() uint32 {
 	return 0
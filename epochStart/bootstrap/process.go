@@ -841,6 +841,15 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 		return err
 	}
 
+	syncProgressStorer, err := e.createTrieSyncProgressStorer()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := syncProgressStorer.Close()
+		log.LogIfError(errClose)
+	}()
+
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.hasher,
@@ -852,6 +861,7 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 			MaxTrieLevelInMemory:      e.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 			MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         e.trieSyncerVersion,
+			SyncProgressStorer:        syncProgressStorer,
 		},
 		ShardId:   e.shardCoordinator.SelfId(),
 		Throttler: thr,
@@ -869,6 +879,20 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	return nil
 }
 
+// createTrieSyncProgressStorer opens the static unit holding the progress of the trie syncs, so that an interrupted
+// bootstrap resumes the download of the tries instead of starting from their roots again
+func (e *epochStartBootstrap) createTrieSyncProgressStorer() (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(e.generalConfig.TrieSyncStorage.DB)
+	shardID := core.GetShardIDString(e.baseData.shardId)
+	dbConfig.FilePath = e.pathManager.PathForStatic(shardID, e.generalConfig.TrieSyncStorage.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(e.generalConfig.TrieSyncStorage.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(e.generalConfig.TrieSyncStorage.Bloom),
+	)
+}
+
 func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) error {
 
 	trieFactoryArgs := factory.TrieFactoryArgs{
@@ -914,6 +938,15 @@ func (e *epochStartBootstrap) createTriesComponentsForShardId(shardId uint32) er
 }
 
 func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
+	syncProgressStorer, err := e.createTrieSyncProgressStorer()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := syncProgressStorer.Close()
+		log.LogIfError(errClose)
+	}()
+
 	argsValidatorAccountsSyncer := syncer.ArgsNewValidatorAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                    e.hasher,
@@ -925,6 +958,7 @@ func (e *epochStartBootstrap) syncPeerAccountsState(rootHash []byte) error {
 			MaxTrieLevelInMemory:      e.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 			MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         e.trieSyncerVersion,
+			SyncProgressStorer:        syncProgressStorer,
 		},
 	}
 	accountsDBSyncer, err := syncer.NewValidatorAccountsSyncer(argsValidatorAccountsSyncer)
@@ -110,6 +110,20 @@ func createMockEpochStartBootstrapArgs() ArgsEpochStartBootstrap {
 					MaxOpenFiles:      10,
 				},
 			},
+			TrieSyncStorage: config.StorageConfig{
+				Cache: config.CacheConfig{
+					Capacity: 1000,
+					Type:     "LRU",
+					Shards:   1,
+				},
+				DB: config.DBConfig{
+					FilePath:          "TrieSync",
+					Type:              "MemoryDB",
+					BatchDelaySeconds: 30,
+					MaxBatchSize:      6,
+					MaxOpenFiles:      10,
+				},
+			},
 			TrieStorageManagerConfig: config.TrieStorageManagerConfig{
 				PruningBufferLen:   1000,
 				SnapshotsBufferLen: 10,
@@ -13,6 +13,7 @@ type RequestHandlerStub struct {
 	RequestRewardTxHandlerCalled       func(destShardID uint32, txHashes [][]byte)
 	RequestMiniBlocksHandlerCalled     func(destShardID uint32, miniblockHashes [][]byte)
 	RequestStartOfEpochMetaBlockCalled func(epoch uint32)
+	RequestStateChunkCalled            func(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string)
 	SetNumPeersToQueryCalled           func(key string, intra int, cross int) error
 	GetNumPeersToQueryCalled           func(key string) (int, int, error)
 }
@@ -130,3 +131,10 @@ func (rhs *RequestHandlerStub) RequestMiniBlocks(shardId uint32, miniblockHashes
 func (rhs *RequestHandlerStub) IsInterfaceNil() bool {
 	return rhs == nil
 }
+
+// RequestStateChunk -
+func (rhs *RequestHandlerStub) RequestStateChunk(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string) {
+	if rhs.RequestStateChunkCalled != nil {
+		rhs.RequestStateChunkCalled(destShardID, rootHash, startAfterKey, topic)
+	}
+}
@@ -1,6 +1,7 @@
 package facade
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -20,16 +21,28 @@ type NodeHandler interface {
 	StartConsensus() error
 
 	// GetBalance returns the balance for a specific address
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error)
 
 	// GetUsername returns the username for a specific address
 	GetUsername(address string) (string, error)
 
 	// GetValueForKey returns the value of a key from a given account
-	GetValueForKey(address string, key string) (string, error)
+	GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, error)
 
 	// GetKeyValuePairs returns the key-value pairs under a given address
-	GetKeyValuePairs(address string) (map[string]string, error)
+	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error)
+
+	// GetKeyValuePairsPage returns a page of the key-value pairs under a given address, and the key of the next page
+	GetKeyValuePairsPage(ctx context.Context, address string, startKey string, limit int, options api.AccountQueryOptions) (map[string]string, string, error)
+
+	// StreamKeyValuePairs calls the handler for each of the key-value pairs under a given address
+	StreamKeyValuePairs(ctx context.Context, address string, startKey string, options api.AccountQueryOptions, handler func(key string, value string) error) error
+
+	// GetTransactionsByAddress returns a page of the transactions involving a given address, and their total number
+	GetTransactionsByAddress(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error)
+
+	// CreateEventsSubscriber creates a subscriber for the committed blocks, finalized transactions and logs
+	CreateEventsSubscriber() (api.EventsSubscriber, error)
 
 	// GetESDTBalance returns the esdt balance and properties from a given account
 	GetESDTBalance(address string, key string) (string, string, error)
@@ -51,9 +64,12 @@ type NodeHandler interface {
 	// GetTransaction will return a transaction based on the hash
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 
+	// GetTransactions will return the transactions based on the hashes, together with the hashes that were not found
+	GetTransactions(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error)
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
 
 	// GetCode returns the code for the given account
 	GetCode(account state.UserAccountHandler) []byte
@@ -77,6 +93,16 @@ type NodeHandler interface {
 
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
+	GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
+	GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error)
+	GetHyperblockByHash(hash string) (*api.Hyperblock, error)
+
+	GetProof(rootHash string, address string) ([][]byte, error)
+	GetProofDataTrie(rootHash string, address string, key string) ([][]byte, [][]byte, error)
+	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+
+	GetStateDiff(fromRootHash string, toRootHash string) (*api.StateDiff, error)
+	GetTrieAnalysis(rootHash string, numTopAccounts uint32) (*api.TrieAnalysis, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
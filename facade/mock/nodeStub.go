@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"encoding/hex"
 	"math/big"
 
@@ -17,7 +18,7 @@ type NodeStub struct {
 	AddressHandler             func() (string, error)
 	ConnectToAddressesHandler  func([]string) error
 	StartConsensusHandler      func() error
-	GetBalanceHandler          func(address string) (*big.Int, error)
+	GetBalanceHandler          func(address string, options api.AccountQueryOptions) (*big.Int, error)
 	GenerateTransactionHandler func(sender string, receiver string, amount string, code string) (*transaction.Transaction, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
@@ -25,7 +26,7 @@ type NodeStub struct {
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
 	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
-	GetAccountHandler                              func(address string) (state.UserAccountHandler, error)
+	GetAccountHandler                              func(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error)
 	GetCodeCalled                                  func(state.UserAccountHandler) []byte
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
@@ -35,14 +36,27 @@ type NodeStub struct {
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetValueForKeyCalled                           func(address string, key string, options api.AccountQueryOptions) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*api.Block, error)
+	GetTransactionsCalled                          func(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error)
+	GetBlocksByNonceRangeCalled                    func(from uint64, to uint64, withTxs bool) ([]*api.Block, error)
+	GetHyperblockByNonceCalled                     func(nonce uint64) (*api.Hyperblock, error)
+	GetHyperblockByHashCalled                      func(hash string) (*api.Hyperblock, error)
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTBalanceCalled                           func(address string, key string) (string, string, error)
 	GetAllESDTTokensCalled                         func(address string) ([]string, error)
-	GetKeyValuePairsCalled                         func(address string) (map[string]string, error)
+	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions) (map[string]string, error)
+	GetKeyValuePairsPageCalled                     func(ctx context.Context, address string, startKey string, limit int, options api.AccountQueryOptions) (map[string]string, string, error)
+	StreamKeyValuePairsCalled                      func(ctx context.Context, address string, startKey string, options api.AccountQueryOptions, handler func(key string, value string) error) error
+	GetTransactionsByAddressCalled                 func(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error)
+	CreateEventsSubscriberCalled                   func() (api.EventsSubscriber, error)
+	GetProofCalled                                 func(rootHash string, address string) ([][]byte, error)
+	GetProofDataTrieCalled                         func(rootHash string, address string, key string) ([][]byte, [][]byte, error)
+	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetStateDiffCalled                             func(fromRootHash string, toRootHash string) (*api.StateDiff, error)
+	GetTrieAnalysisCalled                          func(rootHash string, numTopAccounts uint32) (*api.TrieAnalysis, error)
 }
 
 // GetUsername -
@@ -55,18 +69,66 @@ func (ns *NodeStub) GetUsername(address string) (string, error) {
 }
 
 // GetKeyValuesPairs -
-func (ns *NodeStub) GetKeyValuePairs(address string) (map[string]string, error) {
+func (ns *NodeStub) GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error) {
 	if ns.GetKeyValuePairsCalled != nil {
-		return ns.GetKeyValuePairsCalled(address)
+		return ns.GetKeyValuePairsCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetKeyValuePairsPage -
+func (ns *NodeStub) GetKeyValuePairsPage(
+	ctx context.Context,
+	address string,
+	startKey string,
+	limit int,
+	options api.AccountQueryOptions,
+) (map[string]string, string, error) {
+	if ns.GetKeyValuePairsPageCalled != nil {
+		return ns.GetKeyValuePairsPageCalled(ctx, address, startKey, limit, options)
+	}
+
+	return nil, "", nil
+}
+
+// StreamKeyValuePairs -
+func (ns *NodeStub) StreamKeyValuePairs(
+	ctx context.Context,
+	address string,
+	startKey string,
+	options api.AccountQueryOptions,
+	handler func(key string, value string) error,
+) error {
+	if ns.StreamKeyValuePairsCalled != nil {
+		return ns.StreamKeyValuePairsCalled(ctx, address, startKey, options, handler)
+	}
+
+	return nil
+}
+
+// GetTransactionsByAddress -
+func (ns *NodeStub) GetTransactionsByAddress(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error) {
+	if ns.GetTransactionsByAddressCalled != nil {
+		return ns.GetTransactionsByAddressCalled(address, offset, limit)
+	}
+
+	return nil, 0, nil
+}
+
+// CreateEventsSubscriber -
+func (ns *NodeStub) CreateEventsSubscriber() (api.EventsSubscriber, error) {
+	if ns.CreateEventsSubscriberCalled != nil {
+		return ns.CreateEventsSubscriberCalled()
 	}
 
 	return nil, nil
 }
 
 // GetValueForKey -
-func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
+func (ns *NodeStub) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, error) {
 	if ns.GetValueForKeyCalled != nil {
-		return ns.GetValueForKeyCalled(address, key)
+		return ns.GetValueForKeyCalled(address, key, options)
 	}
 
 	return "", nil
@@ -87,6 +149,78 @@ func (ns *NodeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, err
 	return ns.GetBlockByNonceCalled(nonce, withTxs)
 }
 
+// GetBlocksByNonceRange -
+func (ns *NodeStub) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+	if ns.GetBlocksByNonceRangeCalled != nil {
+		return ns.GetBlocksByNonceRangeCalled(from, to, withTxs)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByNonce -
+func (ns *NodeStub) GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error) {
+	if ns.GetHyperblockByNonceCalled != nil {
+		return ns.GetHyperblockByNonceCalled(nonce)
+	}
+
+	return nil, nil
+}
+
+// GetHyperblockByHash -
+func (ns *NodeStub) GetHyperblockByHash(hash string) (*api.Hyperblock, error) {
+	if ns.GetHyperblockByHashCalled != nil {
+		return ns.GetHyperblockByHashCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetProof -
+func (ns *NodeStub) GetProof(rootHash string, address string) ([][]byte, error) {
+	if ns.GetProofCalled != nil {
+		return ns.GetProofCalled(rootHash, address)
+	}
+
+	return nil, nil
+}
+
+// GetProofDataTrie -
+func (ns *NodeStub) GetProofDataTrie(rootHash string, address string, key string) ([][]byte, [][]byte, error) {
+	if ns.GetProofDataTrieCalled != nil {
+		return ns.GetProofDataTrieCalled(rootHash, address, key)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyProof -
+func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
+	if ns.VerifyProofCalled != nil {
+		return ns.VerifyProofCalled(rootHash, address, proof)
+	}
+
+	return false, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(fromRootHash string, toRootHash string) (*api.StateDiff, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(fromRootHash, toRootHash)
+	}
+
+	return nil, nil
+}
+
+// GetTrieAnalysis -
+func (ns *NodeStub) GetTrieAnalysis(rootHash string, numTopAccounts uint32) (*api.TrieAnalysis, error) {
+	if ns.GetTrieAnalysisCalled != nil {
+		return ns.GetTrieAnalysisCalled(rootHash, numTopAccounts)
+	}
+
+	return nil, nil
+}
+
 // DecodeAddressPubkey -
 func (ns *NodeStub) DecodeAddressPubkey(pk string) ([]byte, error) {
 	return hex.DecodeString(pk)
@@ -98,8 +232,8 @@ func (ns *NodeStub) StartConsensus() error {
 }
 
 // GetBalance -
-func (ns *NodeStub) GetBalance(address string) (*big.Int, error) {
-	return ns.GetBalanceHandler(address)
+func (ns *NodeStub) GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	return ns.GetBalanceHandler(address, options)
 }
 
 // CreateTransaction -
@@ -109,7 +243,7 @@ func (ns *NodeStub) CreateTransaction(nonce uint64, value string, receiver strin
 	return ns.CreateTransactionHandler(nonce, value, receiver, receiverUsername, sender, senderUsername, gasPrice, gasLimit, data, signatureHex, chainID, version, options)
 }
 
-//ValidateTransaction -
+// ValidateTransaction -
 func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return ns.ValidateTransactionHandler(tx)
 }
@@ -124,14 +258,23 @@ func (ns *NodeStub) GetTransaction(hash string, withEvents bool) (*transaction.A
 	return ns.GetTransactionHandler(hash, withEvents)
 }
 
+// GetTransactions -
+func (ns *NodeStub) GetTransactions(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error) {
+	if ns.GetTransactionsCalled != nil {
+		return ns.GetTransactionsCalled(hashes, withResults)
+	}
+
+	return nil, nil
+}
+
 // SendBulkTransactions -
 func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return ns.SendBulkTransactionsHandler(txs)
 }
 
 // GetAccount -
-func (ns *NodeStub) GetAccount(address string) (state.UserAccountHandler, error) {
-	return ns.GetAccountHandler(address)
+func (ns *NodeStub) GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return ns.GetAccountHandler(address, options)
 }
 
 // GetCode -
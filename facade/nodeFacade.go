@@ -256,8 +256,8 @@ func (nf *nodeFacade) sourceLimiterReset(reset resetHandler) {
 }
 
 // GetBalance gets the current balance for a specified address
-func (nf *nodeFacade) GetBalance(address string) (*big.Int, error) {
-	return nf.node.GetBalance(address)
+func (nf *nodeFacade) GetBalance(address string, options apiData.AccountQueryOptions) (*big.Int, error) {
+	return nf.node.GetBalance(address, options)
 }
 
 // GetUsername gets the username for a specified address
@@ -266,8 +266,8 @@ func (nf *nodeFacade) GetUsername(address string) (string, error) {
 }
 
 // GetValueForKey gets the value for a key in a given address
-func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error) {
-	return nf.node.GetValueForKey(address, key)
+func (nf *nodeFacade) GetValueForKey(address string, key string, options apiData.AccountQueryOptions) (string, error) {
+	return nf.node.GetValueForKey(address, key, options)
 }
 
 // GetESDTBalance returns the ESDT balance and if it is frozen
@@ -276,8 +276,40 @@ func (nf *nodeFacade) GetESDTBalance(address string, key string) (string, string
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the provided address
-func (nf *nodeFacade) GetKeyValuePairs(address string) (map[string]string, error) {
-	return nf.node.GetKeyValuePairs(address)
+func (nf *nodeFacade) GetKeyValuePairs(address string, options apiData.AccountQueryOptions) (map[string]string, error) {
+	return nf.node.GetKeyValuePairs(address, options)
+}
+
+// GetKeyValuePairsPage returns a page of the key-value pairs under the provided address, and the key of the next page
+func (nf *nodeFacade) GetKeyValuePairsPage(
+	ctx context.Context,
+	address string,
+	startKey string,
+	limit int,
+	options apiData.AccountQueryOptions,
+) (map[string]string, string, error) {
+	return nf.node.GetKeyValuePairsPage(ctx, address, startKey, limit, options)
+}
+
+// StreamKeyValuePairs calls the handler for each of the key-value pairs under the provided address
+func (nf *nodeFacade) StreamKeyValuePairs(
+	ctx context.Context,
+	address string,
+	startKey string,
+	options apiData.AccountQueryOptions,
+	handler func(key string, value string) error,
+) error {
+	return nf.node.StreamKeyValuePairs(ctx, address, startKey, options, handler)
+}
+
+// GetTransactionsByAddress returns a page of the transactions involving the provided address, and their total number
+func (nf *nodeFacade) GetTransactionsByAddress(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error) {
+	return nf.node.GetTransactionsByAddress(address, offset, limit)
+}
+
+// CreateEventsSubscriber creates a subscriber for the committed blocks, finalized transactions and logs events
+func (nf *nodeFacade) CreateEventsSubscriber() (apiData.EventsSubscriber, error) {
+	return nf.node.CreateEventsSubscriber()
 }
 
 // GetAllESDTTokens returns all the esdt tokens for a given address
@@ -335,6 +367,11 @@ func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transactio
 	return nf.node.GetTransaction(hash, withResults)
 }
 
+// GetTransactions gets the transactions with the specified hashes
+func (nf *nodeFacade) GetTransactions(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error) {
+	return nf.node.GetTransactions(hashes, withResults)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -342,8 +379,8 @@ func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*
 
 // GetAccount returns an accountResponse containing information
 // about the account correlated with provided address
-func (nf *nodeFacade) GetAccount(address string) (state.UserAccountHandler, error) {
-	return nf.node.GetAccount(address)
+func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOptions) (state.UserAccountHandler, error) {
+	return nf.node.GetAccount(address, options)
 }
 
 // GetCode returns the code for the given account
@@ -434,6 +471,11 @@ func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler,
 	return throttlerForEndpoint, isThrottlerOk
 }
 
+// GetGraphQLQueryLimits returns the maximum depth and cost accepted for a GraphQL query
+func (nf *nodeFacade) GetGraphQLQueryLimits() (uint32, uint32) {
+	return nf.wsAntifloodConfig.GraphQLMaxQueryDepth, nf.wsAntifloodConfig.GraphQLMaxQueryCost
+}
+
 // GetBlockByHash return the block for a given hash
 func (nf *nodeFacade) GetBlockByHash(hash string, withTxs bool) (*apiData.Block, error) {
 	return nf.node.GetBlockByHash(hash, withTxs)
@@ -444,6 +486,47 @@ func (nf *nodeFacade) GetBlockByNonce(nonce uint64, withTxs bool) (*apiData.Bloc
 	return nf.node.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetBlocksByNonceRange returns the blocks having the nonces in the given interval
+func (nf *nodeFacade) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*apiData.Block, error) {
+	return nf.node.GetBlocksByNonceRange(from, to, withTxs)
+}
+
+// GetHyperblockByNonce returns the hyperblock for a given meta block nonce
+func (nf *nodeFacade) GetHyperblockByNonce(nonce uint64) (*apiData.Hyperblock, error) {
+	return nf.node.GetHyperblockByNonce(nonce)
+}
+
+// GetHyperblockByHash returns the hyperblock for a given meta block hash
+func (nf *nodeFacade) GetHyperblockByHash(hash string) (*apiData.Hyperblock, error) {
+	return nf.node.GetHyperblockByHash(hash)
+}
+
+// GetProof returns the Merkle proof for the given address and root hash
+func (nf *nodeFacade) GetProof(rootHash string, address string) ([][]byte, error) {
+	return nf.node.GetProof(rootHash, address)
+}
+
+// GetProofDataTrie returns the Merkle proofs for the given address and for the given key from its data trie
+func (nf *nodeFacade) GetProofDataTrie(rootHash string, address string, key string) ([][]byte, [][]byte, error) {
+	return nf.node.GetProofDataTrie(rootHash, address, key)
+}
+
+// VerifyProof verifies the given Merkle proof for the given address and root hash
+func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
+	return nf.node.VerifyProof(rootHash, address, proof)
+}
+
+// GetStateDiff returns the accounts that changed between the two root hashes, alongside their data trie changes
+func (nf *nodeFacade) GetStateDiff(fromRootHash string, toRootHash string) (*apiData.StateDiff, error) {
+	return nf.node.GetStateDiff(fromRootHash, toRootHash)
+}
+
+// GetTrieAnalysis returns the node statistics of the accounts trie and of its data tries, alongside the accounts
+// holding the biggest data tries
+func (nf *nodeFacade) GetTrieAnalysis(rootHash string, numTopAccounts uint32) (*apiData.TrieAnalysis, error) {
+	return nf.node.GetTrieAnalysis(rootHash, numTopAccounts)
+}
+
 // Close will cleanup started go routines
 // TODO use this close method
 func (nf *nodeFacade) Close() error {
@@ -141,7 +141,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	balance := big.NewInt(10)
 	addr := "testAddress"
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ api.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -153,7 +153,7 @@ func TestNodeFacade_GetBalanceWithValidAddressShouldReturnBalance(t *testing.T)
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, api.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, balance, amount)
@@ -168,7 +168,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ api.AccountQueryOptions) (*big.Int, error) {
 			if addr == address {
 				return balance, nil
 			}
@@ -180,7 +180,7 @@ func TestNodeFacade_GetBalanceWithUnknownAddressShouldReturnZeroBalance(t *testi
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(unknownAddr)
+	amount, err := nf.GetBalance(unknownAddr, api.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -192,7 +192,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	zeroBalance := big.NewInt(0)
 
 	node := &mock.NodeStub{
-		GetBalanceHandler: func(address string) (*big.Int, error) {
+		GetBalanceHandler: func(address string, _ api.AccountQueryOptions) (*big.Int, error) {
 			return big.NewInt(0), errors.New("error on getBalance on node")
 		},
 	}
@@ -201,7 +201,7 @@ func TestNodeFacade_GetBalanceWithErrorOnNodeShouldReturnZeroBalanceAndError(t *
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	amount, err := nf.GetBalance(addr)
+	amount, err := nf.GetBalance(addr, api.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, zeroBalance, amount)
 }
@@ -229,6 +229,28 @@ func TestNodeFacade_GetTransactionWithValidInputsShouldNotReturnError(t *testing
 	assert.Equal(t, testTx, tx)
 }
 
+func TestNodeFacade_GetTransactionsShouldCallNode(t *testing.T) {
+	t.Parallel()
+
+	testHashes := []string{"hash1", "hash2"}
+	testBatch := &transaction.ApiTransactionsBatch{NotFound: []string{"hash2"}}
+	node := &mock.NodeStub{
+		GetTransactionsCalled: func(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error) {
+			assert.Equal(t, testHashes, hashes)
+			assert.True(t, withResults)
+			return testBatch, nil
+		},
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	batch, err := nf.GetTransactions(testHashes, true)
+	assert.Nil(t, err)
+	assert.Equal(t, testBatch, batch)
+}
+
 func TestNodeFacade_SetAndGetTpsBenchmark(t *testing.T) {
 	t.Parallel()
 
@@ -280,7 +302,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 
 	called := 0
 	node := &mock.NodeStub{}
-	node.GetAccountHandler = func(address string) (state.UserAccountHandler, error) {
+	node.GetAccountHandler = func(address string, _ api.AccountQueryOptions) (state.UserAccountHandler, error) {
 		called++
 		return nil, nil
 	}
@@ -289,7 +311,7 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	arg.Node = node
 	nf, _ := NewNodeFacade(arg)
 
-	_, _ = nf.GetAccount("test")
+	_, _ = nf.GetAccount("test", api.AccountQueryOptions{})
 	assert.Equal(t, called, 1)
 }
 
@@ -656,20 +678,34 @@ func TestNodeFacade_GetThrottlerForEndpointShouldFindAndReturn(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestNodeFacade_GetGraphQLQueryLimits(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.WsAntifloodConfig.GraphQLMaxQueryDepth = 5
+	arg.WsAntifloodConfig.GraphQLMaxQueryCost = 100
+	nf, _ := NewNodeFacade(arg)
+
+	maxDepth, maxCost := nf.GetGraphQLQueryLimits()
+
+	assert.Equal(t, uint32(5), maxDepth)
+	assert.Equal(t, uint32(100), maxCost)
+}
+
 func TestNodeFacade_GetKeyValuePairs(t *testing.T) {
 	t.Parallel()
 
 	expectedPairs := map[string]string{"k": "v"}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetKeyValuePairsCalled: func(address string) (map[string]string, error) {
+		GetKeyValuePairsCalled: func(address string, _ api.AccountQueryOptions) (map[string]string, error) {
 			return expectedPairs, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	res, err := nf.GetKeyValuePairs("addr")
+	res, err := nf.GetKeyValuePairs("addr", api.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPairs, res)
 }
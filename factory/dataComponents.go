@@ -10,12 +10,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	dataRetrieverFactory "github.com/ElrondNetwork/elrond-go/dataRetriever/factory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/writeAheadLog"
 )
 
+const writeAheadLogDirectory = "WriteAheadLog"
+
 // DataComponentsFactoryArgs holds the arguments needed for creating a data components factory
 type DataComponentsFactoryArgs struct {
 	Config             config.Config
@@ -79,6 +83,11 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 		return nil, err
 	}
 
+	storageGroupCommitter, err := dcf.createStorageGroupCommitter(store)
+	if err != nil {
+		return nil, err
+	}
+
 	dataPoolArgs := dataRetrieverFactory.ArgsDataPool{
 		Config:           &dcf.config,
 		EconomicsData:    dcf.economicsData,
@@ -90,9 +99,10 @@ func (dcf *dataComponentsFactory) Create() (*DataComponents, error) {
 	}
 
 	return &DataComponents{
-		Blkc:     blkc,
-		Store:    store,
-		Datapool: datapool,
+		Blkc:                  blkc,
+		Store:                 store,
+		Datapool:              datapool,
+		StorageGroupCommitter: storageGroupCommitter,
 	}, nil
 }
 
@@ -139,3 +149,36 @@ func (dcf *dataComponentsFactory) createDataStoreFromConfig() (dataRetriever.Sto
 	}
 	return nil, ErrDataStoreCreation
 }
+
+// createStorageGroupCommitter creates the component through which the block processor saves the data of a committed
+// block. When the write ahead log is enabled, the groups found in the log are replayed before the storers are used
+func (dcf *dataComponentsFactory) createStorageGroupCommitter(
+	store dataRetriever.StorageService,
+) (dataRetriever.StorageGroupCommitter, error) {
+	if !dcf.config.WriteAheadLog.Enabled {
+		return storageGroup.NewDirectStorageGroupCommitter(store)
+	}
+
+	shardID := core.GetShardIDString(dcf.shardCoordinator.SelfId())
+	walPath := dcf.pathManager.PathForStatic(shardID, writeAheadLogDirectory)
+	wal, err := writeAheadLog.NewWriteAheadLog(walPath, dcf.config.WriteAheadLog.NumGroupsToKeep)
+	if err != nil {
+		return nil, err
+	}
+
+	argsStorageGroupCommitter := storageGroup.ArgsStorageGroupCommitter{
+		Store:         store,
+		WriteAheadLog: wal,
+	}
+	storageGroupCommitter, err := storageGroup.NewStorageGroupCommitter(argsStorageGroupCommitter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = storageGroupCommitter.ReplayGroups(dcf.currentEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while replaying the write ahead log from %s", err, walPath)
+	}
+
+	return storageGroupCommitter, nil
+}
@@ -1,14 +1,19 @@
 package factory_test
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/writeAheadLog"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -116,6 +121,41 @@ func TestDataComponentsFactory_CreateForMetaShouldWork(t *testing.T) {
 	require.NotNil(t, dc)
 }
 
+func TestDataComponentsFactory_CreateWithWriteAheadLogShouldReplayTheGroups(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "dataComponents")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	wal, _ := writeAheadLog.NewWriteAheadLog(dir, 1)
+	_ = wal.Append(writeAheadLog.Group{
+		Entries: []writeAheadLog.Entry{
+			{Unit: uint8(dataRetriever.BlockHeaderUnit), Key: []byte("hash"), Value: []byte("header")},
+		},
+	})
+
+	args := getDataArgs()
+	args.Config.WriteAheadLog = config.WriteAheadLogConfig{
+		Enabled:         true,
+		NumGroupsToKeep: 1,
+	}
+	args.PathManager = &mock.PathManagerStub{
+		PathForStaticCalled: func(_ string, _ string) string {
+			return dir
+		},
+	}
+	dcf, _ := factory.NewDataComponentsFactory(args)
+
+	dc, err := dcf.Create()
+	require.NoError(t, err)
+
+	value, err := dc.Store.Get(dataRetriever.BlockHeaderUnit, []byte("hash"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("header"), value)
+}
+
 func getDataArgs() factory.DataComponentsFactoryArgs {
 	testEconomics := &economicsmocks.EconomicsHandlerStub{
 		MinGasPriceCalled: func() uint64 {
@@ -50,9 +50,10 @@ type CryptoParams struct {
 
 // DataComponents struct holds the data components
 type DataComponents struct {
-	Blkc     data.ChainHandler
-	Store    dataRetriever.StorageService
-	Datapool dataRetriever.PoolsHolder
+	Blkc                  data.ChainHandler
+	Store                 dataRetriever.StorageService
+	Datapool              dataRetriever.PoolsHolder
+	StorageGroupCommitter dataRetriever.StorageGroupCommitter
 }
 
 // TriesComponents holds the tries components
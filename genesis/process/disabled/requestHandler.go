@@ -50,6 +50,10 @@ func (r *RequestHandler) RequestMiniBlocks(_ uint32, _ [][]byte) {
 func (r *RequestHandler) RequestTrieNodes(_ uint32, _ [][]byte, _ string) {
 }
 
+// RequestStateChunk -
+func (r *RequestHandler) RequestStateChunk(_ uint32, _ []byte, _ []byte, _ string) {
+}
+
 // RequestStartOfEpochMetaBlock -
 func (r *RequestHandler) RequestStartOfEpochMetaBlock(_ uint32) {
 }
@@ -53,15 +53,23 @@ type NetworkShardingUpdater interface {
 
 // Facade is the node facade used to decouple the node implementation with the web server. Used in integration tests
 type Facade interface {
-	GetBalance(address string) (*big.Int, error)
+	GetBalance(address string, options dataApi.AccountQueryOptions) (*big.Int, error)
 	GetUsername(address string) (string, error)
-	GetValueForKey(address string, key string) (string, error)
-	GetAccount(address string) (state.UserAccountHandler, error)
+	GetValueForKey(address string, key string, options dataApi.AccountQueryOptions) (string, error)
+	GetAccount(address string, options dataApi.AccountQueryOptions) (state.UserAccountHandler, error)
 	GetCode(account state.UserAccountHandler) []byte
 	GetESDTBalance(address string, key string) (string, string, error)
 	GetAllESDTTokens(address string) ([]string, error)
 	GetBlockByHash(hash string, withTxs bool) (*dataApi.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*dataApi.Block, error)
+	GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*dataApi.Block, error)
+	GetHyperblockByNonce(nonce uint64) (*dataApi.Hyperblock, error)
+	GetHyperblockByHash(hash string) (*dataApi.Hyperblock, error)
+	GetProof(rootHash string, address string) ([][]byte, error)
+	GetProofDataTrie(rootHash string, address string, key string) ([][]byte, [][]byte, error)
+	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetStateDiff(fromRootHash string, toRootHash string) (*dataApi.StateDiff, error)
+	GetTrieAnalysis(rootHash string, numTopAccounts uint32) (*dataApi.TrieAnalysis, error)
 	Trigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 	GetTotalStakedValue() (*dataApi.StakeValues, error)
@@ -79,6 +87,7 @@ type Facade interface {
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
 	SimulateTransactionExecution(tx *transaction.Transaction) (*transaction.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactions(hashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -38,6 +38,16 @@ func (as *AccountsStub) RecreateAllTries(_ []byte, _ context.Context) (map[strin
 	panic("implement me")
 }
 
+// GetTrie -
+func (as *AccountsStub) GetTrie(_ []byte) (data.Trie, error) {
+	panic("implement me")
+}
+
+// GetStateDiff -
+func (as *AccountsStub) GetStateDiff(_ []byte, _ []byte, _ int, _ func(state.AccountDiff) bool) (bool, error) {
+	panic("implement me")
+}
+
 // LoadAccount -
 func (as *AccountsStub) LoadAccount(address []byte) (state.AccountHandler, error) {
 	if as.LoadAccountCalled != nil {
@@ -74,6 +74,16 @@ func (cdb *countingDB) RangeKeys(handler func(key []byte, value []byte) bool) {
 	cdb.db.RangeKeys(handler)
 }
 
+// RangeKeysWithPrefix will call the handler on the (key, value) pairs whose keys start with the prefix
+func (cdb *countingDB) RangeKeysWithPrefix(prefix []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	cdb.db.RangeKeysWithPrefix(prefix, reverse, handler)
+}
+
+// RangeKeysFrom will call the handler on the (key, value) pairs whose keys are in the [start, end) interval
+func (cdb *countingDB) RangeKeysFrom(start []byte, end []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	cdb.db.RangeKeysFrom(start, end, reverse, handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cdb *countingDB) IsInterfaceNil() bool {
 	return cdb == nil
@@ -48,6 +48,14 @@ func (MockDB) DestroyClosed() error {
 func (MockDB) RangeKeys(_ func(key []byte, val []byte) bool) {
 }
 
+// RangeKeysWithPrefix -
+func (MockDB) RangeKeysWithPrefix(_ []byte, _ bool, _ func(key []byte, val []byte) bool) {
+}
+
+// RangeKeysFrom -
+func (MockDB) RangeKeysFrom(_ []byte, _ []byte, _ bool, _ func(key []byte, val []byte) bool) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (s MockDB) IsInterfaceNil() bool {
 	return false
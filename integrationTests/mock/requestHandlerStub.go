@@ -14,6 +14,7 @@ type RequestHandlerStub struct {
 	RequestMiniBlockHandlerCalled      func(destShardID uint32, miniblockHash []byte)
 	RequestMiniBlocksHandlerCalled     func(destShardID uint32, miniblocksHashes [][]byte)
 	RequestTrieNodesCalled             func(destShardID uint32, hashes [][]byte, topic string)
+	RequestStateChunkCalled            func(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string)
 	RequestStartOfEpochMetaBlockCalled func(epoch uint32)
 	SetNumPeersToQueryCalled           func(key string, intra int, cross int) error
 	GetNumPeersToQueryCalled           func(key string) (int, int, error)
@@ -134,6 +135,14 @@ func (rhs *RequestHandlerStub) RequestTrieNodes(destShardID uint32, hashes [][]b
 	rhs.RequestTrieNodesCalled(destShardID, hashes, topic)
 }
 
+// RequestStateChunk -
+func (rhs *RequestHandlerStub) RequestStateChunk(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string) {
+	if rhs.RequestStateChunkCalled == nil {
+		return
+	}
+	rhs.RequestStateChunkCalled(destShardID, rootHash, startAfterKey, topic)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rhs *RequestHandlerStub) IsInterfaceNil() bool {
 	return rhs == nil
@@ -0,0 +1,246 @@
+package stateChunksSync
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/random"
+	"github.com/ElrondNetwork/elrond-go/core/throttler"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/evictionWaitingList"
+	"github.com/ElrondNetwork/elrond-go/data/trie/statistics"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/topicResolverSender"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p/memp2p"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors"
+	interceptorFactory "github.com/ElrondNetwork/elrond-go/process/interceptors/factory"
+	"github.com/ElrondNetwork/elrond-go/process/interceptors/processor"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stateChunksNode struct {
+	messenger        *memp2p.Messenger
+	trie             data.Trie
+	resolver         *resolvers.StateChunkResolver
+	interceptedNodes storage.Cacher
+	whiteList        process.WhiteListHandler
+}
+
+var chunksTopic = factory.AccountStateChunksTopic + core.CommunicationIdentifierBetweenShards(0, 0)
+
+func createTrieWithSnapshots(t *testing.T, snapshotsDir string) data.Trie {
+	ewl, err := evictionWaitingList.NewEvictionWaitingList(100, memorydb.New(), integrationTests.TestMarshalizer)
+	require.Nil(t, err)
+
+	snapshotsCfg := config.DBConfig{
+		FilePath:          snapshotsDir,
+		Type:              string(storageUnit.MemoryDB),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+	}
+	tsm, err := trie.NewTrieStorageManager(
+		memorydb.New(),
+		integrationTests.TestMarshalizer,
+		integrationTests.TestHasher,
+		snapshotsCfg,
+		ewl,
+		generalCfg,
+	)
+	require.Nil(t, err)
+
+	tr, err := trie.NewTrie(tsm, integrationTests.TestMarshalizer, integrationTests.TestHasher, 5)
+	require.Nil(t, err)
+
+	return tr
+}
+
+func createStateChunksNode(t *testing.T, network *memp2p.Network, tr data.Trie) *stateChunksNode {
+	messenger, err := memp2p.NewMessenger(network)
+	require.Nil(t, err)
+
+	require.Nil(t, messenger.CreateTopic(chunksTopic, true))
+
+	peerListCreator, err := topicResolverSender.NewDiffPeerListCreator(messenger, chunksTopic, chunksTopic, "")
+	require.Nil(t, err)
+
+	resolverSender, err := topicResolverSender.NewTopicResolverSender(topicResolverSender.ArgTopicResolverSender{
+		Messenger:          messenger,
+		TopicName:          chunksTopic,
+		PeerListCreator:    peerListCreator,
+		Marshalizer:        integrationTests.TestMarshalizer,
+		Randomizer:         &random.ConcurrentSafeIntRandomizer{},
+		TargetShardId:      0,
+		OutputAntiflooder:  &mock.NilAntifloodHandler{},
+		NumIntraShardPeers: 1,
+		NumCrossShardPeers: 1,
+	})
+	require.Nil(t, err)
+	require.Nil(t, messenger.CreateTopic(resolverSender.RequestTopic(), true))
+
+	resolverThrottler, _ := throttler.NewNumGoRoutinesThrottler(10)
+	resolver, err := resolvers.NewStateChunkResolver(resolvers.ArgStateChunkResolver{
+		SenderResolver:   resolverSender,
+		StateChunkGetter: tr,
+		Marshalizer:      integrationTests.TestMarshalizer,
+		AntifloodHandler: &mock.NilAntifloodHandler{},
+		Throttler:        resolverThrottler,
+	})
+	require.Nil(t, err)
+	require.Nil(t, messenger.RegisterMessageProcessor(resolver.RequestTopic(), resolver))
+
+	interceptedNodes := testscommon.NewCacherMock()
+	whiteList, err := interceptors.NewWhiteListDataVerifier(testscommon.NewCacherMock())
+	require.Nil(t, err)
+
+	stateChunksFactory, err := interceptorFactory.NewInterceptedStateChunkDataFactory(&interceptorFactory.ArgInterceptedDataFactory{
+		ProtoMarshalizer: integrationTests.TestMarshalizer,
+		Hasher:           integrationTests.TestHasher,
+	})
+	require.Nil(t, err)
+
+	stateChunksProcessor, err := processor.NewTrieNodesInterceptorProcessor(interceptedNodes)
+	require.Nil(t, err)
+
+	interceptorThrottler, _ := throttler.NewNumGoRoutinesThrottler(10)
+	interceptor, err := interceptors.NewSingleDataInterceptor(interceptors.ArgSingleDataInterceptor{
+		Topic:            chunksTopic,
+		DataFactory:      stateChunksFactory,
+		Processor:        stateChunksProcessor,
+		Throttler:        interceptorThrottler,
+		AntifloodHandler: &mock.NilAntifloodHandler{},
+		WhiteListRequest: whiteList,
+		CurrentPeerId:    messenger.ID(),
+	})
+	require.Nil(t, err)
+	require.Nil(t, messenger.RegisterMessageProcessor(chunksTopic, interceptor))
+
+	return &stateChunksNode{
+		messenger:        messenger,
+		trie:             tr,
+		resolver:         resolver,
+		interceptedNodes: interceptedNodes,
+		whiteList:        whiteList,
+	}
+}
+
+func (n *stateChunksNode) createRequestHandler(t *testing.T) trie.RequestHandler {
+	requestHandler, err := requestHandlers.NewResolverRequestHandler(
+		&mock.ResolversFinderStub{
+			MetaCrossShardResolverCalled: func(baseTopic string, _ uint32) (dataRetriever.Resolver, error) {
+				assert.Equal(t, factory.AccountStateChunksTopic, baseTopic)
+				return n.resolver, nil
+			},
+		},
+		&mock.RequestedItemsHandlerStub{},
+		n.whiteList,
+		100,
+		0,
+		time.Second,
+	)
+	require.Nil(t, err)
+
+	return requestHandler
+}
+
+func getAllLeaves(t *testing.T, tr data.Trie, rootHash []byte) map[string][]byte {
+	leavesChannel, err := tr.GetAllLeavesOnChannel(rootHash, context.Background())
+	require.Nil(t, err)
+
+	leaves := make(map[string][]byte)
+	for leaf := range leavesChannel {
+		leaves[string(leaf.Key())] = leaf.Value()
+	}
+
+	return leaves
+}
+
+func TestNode_SyncTrieFromStateChunksWithMessenger(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	snapshotsDir, err := ioutil.TempDir("", "stateChunksSync")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(snapshotsDir)
+	}()
+
+	resolverTrie := createTrieWithSnapshots(t, snapshotsDir)
+	numTrieLeaves := 20000
+	for i := 0; i < numTrieLeaves; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		require.Nil(t, resolverTrie.Update(key, integrationTests.TestHasher.Compute(string(key))))
+	}
+	require.Nil(t, resolverTrie.Commit())
+	rootHash, _ := resolverTrie.RootHash()
+
+	resolverTrie.GetStorageManager().TakeSnapshot(rootHash)
+	time.Sleep(time.Second * 2)
+
+	network := memp2p.NewNetwork()
+	nResolver := createStateChunksNode(t, network, resolverTrie)
+	nRequester := createStateChunksNode(t, network, createTrieWithSnapshots(t, snapshotsDir+"_requester"))
+	defer func() {
+		_ = nResolver.messenger.Close()
+		_ = nRequester.messenger.Close()
+		_ = os.RemoveAll(snapshotsDir + "_requester")
+	}()
+
+	requesterDB := memorydb.New()
+	tss := statistics.NewTrieSyncStatistics()
+	arg := trie.ArgTrieSyncer{
+		RequestHandler:                 nRequester.createRequestHandler(t),
+		InterceptedNodes:               nRequester.interceptedNodes,
+		DB:                             requesterDB,
+		Marshalizer:                    integrationTests.TestMarshalizer,
+		Hasher:                         integrationTests.TestHasher,
+		ShardId:                        0,
+		Topic:                          factory.AccountTrieNodesTopic,
+		StateChunksTopic:               factory.AccountStateChunksTopic,
+		TrieSyncStatistics:             tss,
+		TimeoutBetweenTrieNodesCommits: 10 * time.Second,
+		MaxHardCapForMissingNodes:      10000,
+		SyncProgressStorer:             memorydb.New(),
+	}
+	trieSyncer, err := trie.NewStateChunksTrieSyncer(arg)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	err = trieSyncer.StartSyncing(rootHash, ctx)
+	cancel()
+	require.Nil(t, err)
+	assert.Equal(t, numTrieLeaves, tss.NumReceived())
+
+	requesterTsm, _ := trie.NewTrieStorageManagerWithoutPruning(requesterDB)
+	requesterTrie, _ := trie.NewTrie(requesterTsm, integrationTests.TestMarshalizer, integrationTests.TestHasher, 5)
+	syncedTrie, err := requesterTrie.Recreate(rootHash)
+	require.Nil(t, err)
+
+	syncedRootHash, _ := syncedTrie.RootHash()
+	assert.Equal(t, rootHash, syncedRootHash)
+	assert.Equal(t, getAllLeaves(t, resolverTrie, rootHash), getAllLeaves(t, syncedTrie, rootHash))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts/defaults"
@@ -163,6 +164,7 @@ func TestNode_RequestInterceptTrieNodesWithMessenger(t *testing.T) {
 		TrieSyncStatistics:             tss,
 		TimeoutBetweenTrieNodesCommits: timeout,
 		MaxHardCapForMissingNodes:      10000,
+		SyncProgressStorer:             memorydb.New(),
 	}
 	trieSyncer, _ := trie.NewDoubleListTrieSyncer(arg)
 
@@ -287,6 +289,7 @@ func TestMultipleDataTriesSync(t *testing.T) {
 			MaxTrieLevelInMemory:      200,
 			MaxHardCapForMissingNodes: 5000,
 			TrieSyncerVersion:         2,
+			SyncProgressStorer:        memorydb.New(),
 		},
 		ShardId:   shardID,
 		Throttler: thr,
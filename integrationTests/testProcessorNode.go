@@ -41,6 +41,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
 	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/epochStart/shardchain"
@@ -1728,6 +1729,8 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 	accountsDb[state.UserAccountsState] = tpn.AccntState
 	accountsDb[state.PeerAccountsState] = tpn.PeerState
 
+	storageGroupCommitter, _ := storageGroup.NewDirectStorageGroupCommitter(tpn.Storage)
+
 	argumentsBase := block.ArgBaseProcessor{
 		AccountsDB:       accountsDb,
 		ForkDetector:     tpn.ForkDetector,
@@ -1755,6 +1758,8 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 		Indexer:                 indexer.NewNilIndexer(),
 		TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 		HistoryRepository:       tpn.HistoryRepository,
+		CommittedBlockNotifier:  &testscommon.CommittedBlockNotifierStub{},
+		StorageGroupCommitter:   storageGroupCommitter,
 		EpochNotifier:           tpn.EpochNotifier,
 		HeaderIntegrityVerifier: tpn.HeaderIntegrityVerifier,
 	}
@@ -92,14 +92,20 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 func createTestApiConfig() config.ApiRoutesConfig {
 	routes := map[string][]string{
 		"node":        {"/status", "/metrics", "/heartbeatstatus", "/statistics", "/p2pstatus", "/debug", "/peerinfo"},
-		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier"},
+		"address":     {"/:address", "/:address/balance", "/:address/username", "/:address/key/:key", "/:address/esdt", "/:address/esdt/:tokenIdentifier", "/:address/transactions"},
 		"hardfork":    {"/trigger"},
 		"network":     {"/status", "/total-staked", "/economics", "/config"},
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
 		"vm-values":   {"/hex", "/string", "/int", "/query"},
-		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash"},
+		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash", "/batch", "/batch-status"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash"},
+		"blocks":      {"/blocks"},
+		"hyperblock":  {"/by-nonce/:nonce", "/by-hash/:hash"},
+		"proof":       {"/root-hash/:roothash/address/:address", "/root-hash/:roothash/address/:address/key/:key", "/verify"},
+		"events":      {"/subscribe"},
+		"graphql":     {"/query"},
+		"state":       {"/diff", "/analysis"},
 	}
 
 	routesConfig := config.ApiRoutesConfig{
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
@@ -160,6 +161,8 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 	accountsDb[state.UserAccountsState] = tpn.AccntState
 	accountsDb[state.PeerAccountsState] = tpn.PeerState
 
+	storageGroupCommitter, _ := storageGroup.NewDirectStorageGroupCommitter(tpn.Storage)
+
 	argumentsBase := block.ArgBaseProcessor{
 		AccountsDB:        accountsDb,
 		ForkDetector:      nil,
@@ -188,6 +191,8 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 		Indexer:                 indexer.NewNilIndexer(),
 		TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 		HistoryRepository:       tpn.HistoryRepository,
+		CommittedBlockNotifier:  &testscommon.CommittedBlockNotifierStub{},
+		StorageGroupCommitter:   storageGroupCommitter,
 		EpochNotifier:           tpn.EpochNotifier,
 		HeaderIntegrityVerifier: tpn.HeaderIntegrityVerifier,
 	}
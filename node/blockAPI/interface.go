@@ -9,3 +9,9 @@ type APIBlockHandler interface {
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByHash(hash []byte, withTxs bool) (*api.Block, error)
 }
+
+// APIHyperblockHandler defines the behavior of a component able to return hyperblocks
+type APIHyperblockHandler interface {
+	GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error)
+	GetHyperblockByHash(hash []byte) (*api.Hyperblock, error)
+}
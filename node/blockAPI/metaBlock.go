@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 )
 
@@ -32,15 +33,7 @@ func NewMetaApiBlockProcessor(arg *APIBlockProcessorArg) *metaAPIBlockProcessor
 
 // GetBlockByNonce wil return a meta APIBlock by nonce
 func (mbp *metaAPIBlockProcessor) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
-	storerUnit := dataRetriever.MetaHdrNonceHashDataUnit
-
-	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
-	headerHash, err := mbp.store.Get(storerUnit, nonceToByteSlice)
-	if err != nil {
-		return nil, err
-	}
-
-	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, headerHash)
+	headerHash, blockBytes, err := mbp.getMetaBlockBytesByNonce(nonce)
 	if err != nil {
 		return nil, err
 	}
@@ -63,6 +56,122 @@ func (mbp *metaAPIBlockProcessor) GetBlockByHash(hash []byte, withTxs bool) (*ap
 	return mbp.computeStatusAndPutInBlock(blockAPI, dataRetriever.MetaHdrNonceHashDataUnit)
 }
 
+// GetHyperblockByNonce will return the hyperblock built on the meta block with the given nonce
+func (mbp *metaAPIBlockProcessor) GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error) {
+	headerHash, blockBytes, err := mbp.getMetaBlockBytesByNonce(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return mbp.convertMetaBlockBytesToAPIHyperblock(headerHash, blockBytes)
+}
+
+// GetHyperblockByHash will return the hyperblock built on the meta block with the given hash
+func (mbp *metaAPIBlockProcessor) GetHyperblockByHash(hash []byte) (*api.Hyperblock, error) {
+	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblock, err := mbp.convertMetaBlockBytesToAPIHyperblock(hash, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	blockStatus, err := mbp.computeBlockStatus(dataRetriever.MetaHdrNonceHashDataUnit, &api.Block{
+		Nonce: hyperblock.Nonce,
+		Hash:  hyperblock.Hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblock.Status = blockStatus
+
+	return hyperblock, nil
+}
+
+func (mbp *metaAPIBlockProcessor) getMetaBlockBytesByNonce(nonce uint64) ([]byte, []byte, error) {
+	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := mbp.store.Get(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, headerHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return headerHash, blockBytes, nil
+}
+
+func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIHyperblock(hash []byte, blockBytes []byte) (*api.Hyperblock, error) {
+	blockHeader := &block.MetaBlock{}
+	err := mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlockAPI := mbp.convertMetaBlockToAPIBlock(hash, blockHeader, true)
+
+	transactions := make([]*transaction.ApiTransactionResult, 0, metaBlockAPI.NumTxs)
+	processedMiniBlocks := make(map[string]struct{})
+	for _, miniBlockAPI := range metaBlockAPI.MiniBlocks {
+		processedMiniBlocks[miniBlockAPI.Hash] = struct{}{}
+		transactions = append(transactions, miniBlockAPI.Transactions...)
+	}
+
+	numOfTxs := metaBlockAPI.NumTxs
+	missingMiniBlocks := make([]string, 0)
+	for _, shardData := range blockHeader.ShardInfo {
+		for _, mb := range shardData.ShardMiniBlockHeaders {
+			mbHash := hex.EncodeToString(mb.Hash)
+			_, isProcessed := processedMiniBlocks[mbHash]
+			if mb.Type == block.PeerBlock || isProcessed {
+				continue
+			}
+			processedMiniBlocks[mbHash] = struct{}{}
+
+			numOfTxs += mb.TxCount
+
+			// the bodies of the shard miniblocks are available only if this node stored them, otherwise the
+			// hyperblock is marked as incomplete so that its transactions list is not taken as the full one
+			errHas := mbp.store.GetStorer(dataRetriever.MiniBlockUnit).HasInEpoch(mb.Hash, blockHeader.Epoch)
+			if errHas != nil {
+				log.Warn("hyperblock: shard miniblock not found in storage",
+					"hash", mbHash,
+					"shard", shardData.ShardID,
+				)
+				missingMiniBlocks = append(missingMiniBlocks, mbHash)
+				continue
+			}
+
+			miniBlockCopy := mb
+			transactions = append(transactions, mbp.getTxsByMb(&miniBlockCopy, blockHeader.Epoch)...)
+		}
+	}
+
+	return &api.Hyperblock{
+		Nonce:                  metaBlockAPI.Nonce,
+		Round:                  metaBlockAPI.Round,
+		Hash:                   metaBlockAPI.Hash,
+		PrevBlockHash:          metaBlockAPI.PrevBlockHash,
+		Epoch:                  metaBlockAPI.Epoch,
+		NumTxs:                 numOfTxs,
+		ShardBlocks:            metaBlockAPI.NotarizedBlocks,
+		Transactions:           transactions,
+		Timestamp:              metaBlockAPI.Timestamp,
+		AccumulatedFees:        metaBlockAPI.AccumulatedFees,
+		DeveloperFees:          metaBlockAPI.DeveloperFees,
+		AccumulatedFeesInEpoch: metaBlockAPI.AccumulatedFeesInEpoch,
+		DeveloperFeesInEpoch:   metaBlockAPI.DeveloperFeesInEpoch,
+		Status:                 metaBlockAPI.Status,
+		Incomplete:             len(missingMiniBlocks) > 0,
+		MissingMiniBlocks:      missingMiniBlocks,
+	}, nil
+}
+
 func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, blockBytes []byte, withTxs bool) (*api.Block, error) {
 	blockHeader := &block.MetaBlock{}
 	err := mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
@@ -70,6 +179,10 @@ func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, b
 		return nil, err
 	}
 
+	return mbp.convertMetaBlockToAPIBlock(hash, blockHeader, withTxs), nil
+}
+
+func (mbp *metaAPIBlockProcessor) convertMetaBlockToAPIBlock(hash []byte, blockHeader *block.MetaBlock, withTxs bool) *api.Block {
 	headerEpoch := blockHeader.Epoch
 
 	numOfTxs := uint32(0)
@@ -122,5 +235,5 @@ func (mbp *metaAPIBlockProcessor) convertMetaBlockBytesToAPIBlock(hash []byte, b
 		DeveloperFeesInEpoch:   blockHeader.DevFeesInEpoch.String(),
 		Timestamp:              time.Duration(blockHeader.GetTimeStamp()),
 		Status:                 BlockStatusOnChain,
-	}, nil
+	}
 }
@@ -9,11 +9,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockMetaAPIProcessor(
@@ -280,3 +282,129 @@ func TestMetaAPIBlockProcessor_GetBlockByHashFromHistoryNodeStatusReverted(t *te
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func TestMetaAPIBlockProcessor_GetHyperblockByNonceShouldIncludeShardTransactions(t *testing.T) {
+	t.Parallel()
+
+	nonce := uint64(1)
+	epoch := uint32(1)
+	headerHash := []byte("d08089f2ab739520598fd7aeed08c427460fe94f286383047f3f61951afc4e00")
+	shardHeaderHash := []byte("shardHeaderHash")
+	metaMiniBlockHash := []byte("metaMiniBlockHash")
+	shardMiniBlockHash := []byte("shardMiniBlockHash")
+	missingMiniBlockHash := []byte("missingMiniBlockHash")
+
+	storerMock := mock.NewStorerMock()
+	uint64Converter := mock.NewNonceHashConverterMock()
+	metaAPIBlockProcessor := NewMetaApiBlockProcessor(
+		&APIBlockProcessorArg{
+			SelfShardID: core.MetachainShardId,
+			Marshalizer: &mock.MarshalizerFake{},
+			Store: &mock.ChainStorerMock{
+				GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+					return storerMock
+				},
+				GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+					return storerMock.Get(key)
+				},
+			},
+			Uint64ByteSliceConverter: uint64Converter,
+			HistoryRepo: &testscommon.HistoryRepositoryStub{
+				IsEnabledCalled: func() bool {
+					return false
+				},
+			},
+			UnmarshalTx: func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{
+					Tx:   &transaction.Transaction{},
+					Type: string(txType),
+				}, nil
+			},
+		},
+	)
+
+	header := &block.MetaBlock{
+		Nonce: nonce,
+		Epoch: epoch,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: metaMiniBlockHash, Type: block.TxBlock, TxCount: 1},
+		},
+		ShardInfo: []block.ShardData{
+			{
+				HeaderHash: shardHeaderHash,
+				ShardID:    0,
+				Nonce:      10,
+				ShardMiniBlockHeaders: []block.MiniBlockHeader{
+					{Hash: shardMiniBlockHash, Type: block.TxBlock, TxCount: 1},
+					{Hash: missingMiniBlockHash, Type: block.TxBlock, TxCount: 3},
+					{Hash: metaMiniBlockHash, Type: block.TxBlock, TxCount: 1},
+				},
+			},
+		},
+		AccumulatedFees:        big.NewInt(0),
+		DeveloperFees:          big.NewInt(0),
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+	}
+	headerBytes, _ := json.Marshal(header)
+	_ = storerMock.Put(headerHash, headerBytes)
+	_ = storerMock.Put(uint64Converter.ToByteSlice(nonce), headerHash)
+
+	metaMiniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("metaTx")}, Type: block.TxBlock}
+	metaMiniBlockBytes, _ := json.Marshal(metaMiniBlock)
+	_ = storerMock.Put(metaMiniBlockHash, metaMiniBlockBytes)
+	_ = storerMock.Put([]byte("metaTx"), []byte("tx"))
+
+	shardMiniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("shardTx")}, Type: block.TxBlock}
+	shardMiniBlockBytes, _ := json.Marshal(shardMiniBlock)
+	_ = storerMock.Put(shardMiniBlockHash, shardMiniBlockBytes)
+	_ = storerMock.Put([]byte("shardTx"), []byte("tx"))
+
+	hyperblock, err := metaAPIBlockProcessor.GetHyperblockByNonce(nonce)
+	require.Nil(t, err)
+
+	assert.Equal(t, nonce, hyperblock.Nonce)
+	assert.Equal(t, hex.EncodeToString(headerHash), hyperblock.Hash)
+	assert.Equal(t, uint32(5), hyperblock.NumTxs)
+	assert.Equal(t, []*api.NotarizedBlock{{Hash: hex.EncodeToString(shardHeaderHash), Nonce: 10, Shard: 0}}, hyperblock.ShardBlocks)
+	require.Equal(t, 2, len(hyperblock.Transactions))
+	assert.Equal(t, hex.EncodeToString([]byte("metaTx")), hyperblock.Transactions[0].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("shardTx")), hyperblock.Transactions[1].Hash)
+	assert.Equal(t, hex.EncodeToString(shardMiniBlockHash), hyperblock.Transactions[1].MiniBlockHash)
+	assert.Equal(t, BlockStatusOnChain, hyperblock.Status)
+	assert.True(t, hyperblock.Incomplete)
+	assert.Equal(t, []string{hex.EncodeToString(missingMiniBlockHash)}, hyperblock.MissingMiniBlocks)
+}
+
+func TestMetaAPIBlockProcessor_GetHyperblockByHashStatusReverted(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("d08089f2ab739520598fd7aeed08c427460fe94f286383047f3f61951afc4e00")
+	storerMock := mock.NewStorerMock()
+	uint64Converter := mock.NewNonceHashConverterMock()
+
+	metaAPIBlockProcessor := createMockMetaAPIProcessor(
+		headerHash,
+		storerMock,
+		false,
+		true,
+	)
+
+	header := &block.MetaBlock{
+		Nonce:                  1,
+		AccumulatedFees:        big.NewInt(0),
+		DeveloperFees:          big.NewInt(0),
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+	}
+	headerBytes, _ := json.Marshal(header)
+	_ = storerMock.Put(headerHash, headerBytes)
+	_ = storerMock.Put(uint64Converter.ToByteSlice(1), []byte("correct-hash"))
+
+	hyperblock, err := metaAPIBlockProcessor.GetHyperblockByHash(headerHash)
+	require.Nil(t, err)
+	assert.Equal(t, BlockStatusReverted, hyperblock.Status)
+	assert.Equal(t, 0, len(hyperblock.Transactions))
+	assert.False(t, hyperblock.Incomplete)
+	assert.Equal(t, 0, len(hyperblock.MissingMiniBlocks))
+}
@@ -228,3 +228,25 @@ var ErrNilTransactionVersionChecker = errors.New("nil transaction version checke
 
 // ErrNilNodeRedundancyHandler signals that provided node redundancy handler is nil
 var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
+
+// ErrStateNotAvailableForBlock signals that the state of the requested block can not be recreated, as its root hash
+// was already pruned
+var ErrStateNotAvailableForBlock = errors.New("state not available for the requested block, root hash might have been pruned")
+
+// ErrNilTrie signals that a nil trie has been provided or recreated
+var ErrNilTrie = errors.New("nil trie")
+
+// ErrNilDataTrie signals that the account has no data trie
+var ErrNilDataTrie = errors.New("account has no data trie")
+
+// ErrInvalidAddress signals that an invalid address has been provided
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrNilEventsHub signals that a nil events hub has been provided
+var ErrNilEventsHub = errors.New("nil events hub")
+
+// ErrInvalidBlockRange signals that the start of a blocks range is greater than its end
+var ErrInvalidBlockRange = errors.New("invalid block range")
+
+// ErrHyperblocksOnlyOnMetachain signals that hyperblocks were requested from a node that is not in the metachain
+var ErrHyperblocksOnlyOnMetachain = errors.New("hyperblocks are available only on metachain nodes")
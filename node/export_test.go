@@ -13,3 +13,5 @@ func (n *Node) CreateConsensusTopic(messageProcessor p2p.MessageProcessor) error
 func PutMiniblockFieldsInTransaction(tx *transaction.ApiTransactionResult, miniblockMetadata *dblookupext.MiniblockMetadata) *transaction.ApiTransactionResult {
 	return putMiniblockFieldsInTransaction(tx, miniblockMetadata)
 }
+
+const MaxStateDiffLeaves = maxStateDiffLeaves
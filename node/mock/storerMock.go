@@ -72,8 +72,9 @@ func (sm *StorerMock) GetBulkFromEpoch(keys [][]byte, _ uint32) (map[string][]by
 }
 
 // HasInEpoch -
-func (sm *StorerMock) HasInEpoch(_ []byte, _ uint32) error {
-	return errors.New("not implemented")
+func (sm *StorerMock) HasInEpoch(key []byte, _ uint32) error {
+	_, err := sm.Get(key)
+	return err
 }
 
 // SearchFirst -
@@ -23,10 +23,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/partitioning"
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/core/watchdog"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	disabledSig "github.com/ElrondNetwork/elrond-go/crypto/signing/disabled/singlesig"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -90,6 +92,7 @@ type Node struct {
 	epochStartRegistrationHandler epochStart.RegistrationHandler
 	accounts                      state.AccountsAdapter
 	accountsAPI                   state.AccountsAdapter
+	mutAccountsAPI                syncGo.Mutex
 	addressPubkeyConverter        core.PubkeyConverter
 	validatorPubkeyConverter      core.PubkeyConverter
 	uint64ByteSliceConverter      typeConverters.Uint64ByteSliceConverter
@@ -165,6 +168,7 @@ type Node struct {
 
 	watchdog          core.WatchdogTimer
 	historyRepository dblookupext.HistoryRepository
+	eventsHub         subscriptions.EventsHub
 
 	enableSignTxWithHashEpoch uint32
 	txSignHasher              hashing.Hasher
@@ -415,8 +419,8 @@ func (n *Node) addCloserInstances(closers ...update.Closer) error {
 }
 
 // GetBalance gets the balance for a specific address
-func (n *Node) GetBalance(address string) (*big.Int, error) {
-	account, err := n.getAccountHandler(address)
+func (n *Node) GetBalance(address string, options api.AccountQueryOptions) (*big.Int, error) {
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return nil, err
 	}
@@ -431,7 +435,7 @@ func (n *Node) GetBalance(address string) (*big.Int, error) {
 
 // GetUsername gets the username for a specific address
 func (n *Node) GetUsername(address string) (string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, api.AccountQueryOptions{})
 	if err != nil {
 		return "", err
 	}
@@ -446,48 +450,23 @@ func (n *Node) GetUsername(address string) (string, error) {
 }
 
 // GetKeyValuePairs returns all the key-value pairs under the address
-func (n *Node) GetKeyValuePairs(address string) (map[string]string, error) {
-	account, err := n.getAccountHandlerAPIAccounts(address)
+func (n *Node) GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, error) {
+	mapToReturn, _, err := n.GetKeyValuePairsPage(context.Background(), address, "", 0, options)
 	if err != nil {
 		return nil, err
 	}
 
-	userAccount, ok := n.castAccountToUserAccount(account)
-	if !ok {
-		return nil, ErrAccountNotFound
-	}
-
-	if check.IfNil(userAccount.DataTrie()) {
-		return map[string]string{}, nil
-	}
-
-	rootHash, err := userAccount.DataTrie().RootHash()
-	if err != nil {
-		return nil, err
-	}
-
-	ctx := context.Background()
-	chLeaves, err := userAccount.DataTrie().GetAllLeavesOnChannel(rootHash, ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	mapToReturn := make(map[string]string)
-	for leaf := range chLeaves {
-		mapToReturn[hex.EncodeToString(leaf.Key())] = hex.EncodeToString(leaf.Value())
-	}
-
 	return mapToReturn, nil
 }
 
 // GetValueForKey will return the value for a key from a given account
-func (n *Node) GetValueForKey(address string, key string) (string, error) {
+func (n *Node) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, error) {
 	keyBytes, err := hex.DecodeString(key)
 	if err != nil {
 		return "", fmt.Errorf("invalid key: %w", err)
 	}
 
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, options)
 	if err != nil {
 		return "", err
 	}
@@ -507,7 +486,7 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 
 // GetESDTBalance returns the esdt balance and properties from a given account
 func (n *Node) GetESDTBalance(address string, tokenName string) (string, string, error) {
-	account, err := n.getAccountHandler(address)
+	account, err := n.getAccountHandler(address, api.AccountQueryOptions{})
 	if err != nil {
 		return "", "", err
 	}
@@ -534,7 +513,7 @@ func (n *Node) GetESDTBalance(address string, tokenName string) (string, string,
 
 // GetAllESDTTokens returns the value of a key from a given account
 func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
-	account, err := n.getAccountHandlerAPIAccounts(address)
+	account, err := n.getAccountHandlerAPIAccounts(address, api.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -575,7 +554,7 @@ func (n *Node) GetAllESDTTokens(address string) ([]string, error) {
 	return foundTokens, nil
 }
 
-func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
+func (n *Node) getAccountHandler(address string, options api.AccountQueryOptions) (state.AccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
 	}
@@ -584,20 +563,30 @@ func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
+	if options.IsHistorical() {
+		return n.getAccountHandlerAtBlock(addr, options)
+	}
+
 	return n.accounts.GetExistingAccount(addr)
 }
 
-func (n *Node) getAccountHandlerAPIAccounts(address string) (state.AccountHandler, error) {
+func (n *Node) getAccountHandlerAPIAccounts(address string, options api.AccountQueryOptions) (state.AccountHandler, error) {
 	addr, err := n.addressPubkeyConverter.Decode(address)
 	if err != nil {
 		return nil, errors.New("invalid address, could not decode from: " + err.Error())
 	}
+	if options.IsHistorical() {
+		return n.getAccountHandlerAtBlock(addr, options)
+	}
 
 	blockHeader := n.blkc.GetCurrentBlockHeader()
 	if check.IfNil(blockHeader) {
 		return nil, nil
 	}
 
+	n.mutAccountsAPI.Lock()
+	defer n.mutAccountsAPI.Unlock()
+
 	err = n.accountsAPI.RecreateTrie(blockHeader.GetRootHash())
 	if err != nil {
 		return nil, err
@@ -1207,7 +1196,7 @@ func (n *Node) CreateTransaction(
 }
 
 // GetAccount will return account details for a given address
-func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
+func (n *Node) GetAccount(address string, options api.AccountQueryOptions) (state.UserAccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) {
 		return nil, ErrNilPubkeyConverter
 	}
@@ -1220,12 +1209,17 @@ func (n *Node) GetAccount(address string) (state.UserAccountHandler, error) {
 		return nil, err
 	}
 
-	accWrp, err := n.accounts.GetExistingAccount(addr)
+	var accWrp state.AccountHandler
+	if options.IsHistorical() {
+		accWrp, err = n.getAccountHandlerAtBlock(addr, options)
+	} else {
+		accWrp, err = n.accounts.GetExistingAccount(addr)
+	}
 	if err != nil {
 		if err == state.ErrAccNotFound {
 			return state.NewUserAccount(addr)
 		}
-		return nil, errors.New("could not fetch sender address from provided param: " + err.Error())
+		return nil, fmt.Errorf("could not fetch sender address from provided param: %w", err)
 	}
 
 	account, ok := accWrp.(state.UserAccountHandler)
@@ -0,0 +1,66 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// getAccountHandlerAtBlock returns the account as it was after the block described by the provided options
+// was committed. The accounts trie is recreated on the API accounts adapter so that the processing one is not altered
+func (n *Node) getAccountHandlerAtBlock(address []byte, options api.AccountQueryOptions) (state.AccountHandler, error) {
+	if check.IfNil(n.accountsAPI) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	header, err := n.getHeaderForAccountQuery(options)
+	if err != nil {
+		return nil, err
+	}
+
+	n.mutAccountsAPI.Lock()
+	defer n.mutAccountsAPI.Unlock()
+
+	err = n.accountsAPI.RecreateTrie(header.GetRootHash())
+	if err != nil {
+		return nil, fmt.Errorf("%w, block nonce %d, root hash %s: %s",
+			ErrStateNotAvailableForBlock,
+			header.GetNonce(),
+			hex.EncodeToString(header.GetRootHash()),
+			err.Error(),
+		)
+	}
+
+	return n.accountsAPI.GetExistingAccount(address)
+}
+
+func (n *Node) getHeaderForAccountQuery(options api.AccountQueryOptions) (data.HeaderHandler, error) {
+	if check.IfNil(n.shardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+
+	selfShardID := n.shardCoordinator.SelfId()
+	if len(options.BlockHash) > 0 {
+		if selfShardID == core.MetachainShardId {
+			return process.GetMetaHeaderFromStorage(options.BlockHash, n.internalMarshalizer, n.store)
+		}
+
+		return process.GetShardHeaderFromStorage(options.BlockHash, n.internalMarshalizer, n.store)
+	}
+
+	header, _, err := process.GetHeaderFromStorageWithNonce(
+		options.BlockNonce,
+		selfShardID,
+		n.store,
+		n.uint64ByteSliceConverter,
+		n.internalMarshalizer,
+	)
+
+	return header, err
+}
@@ -2,8 +2,10 @@ package node
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/node/blockAPI"
 )
@@ -26,8 +28,77 @@ func (n *Node) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
 	return apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
 }
 
+// GetBlocksByNonceRange returns the blocks having the nonces in the [from, to] interval. The interval is truncated to
+// the nonce of the current block, so that a range reaching the head of the chain returns the blocks produced so far
+func (n *Node) GetBlocksByNonceRange(from uint64, to uint64, withTxs bool) ([]*api.Block, error) {
+	if from > to {
+		return nil, ErrInvalidBlockRange
+	}
+
+	if !check.IfNil(n.blkc) && !check.IfNil(n.blkc.GetCurrentBlockHeader()) {
+		currentNonce := n.blkc.GetCurrentBlockHeader().GetNonce()
+		if to > currentNonce {
+			to = currentNonce
+		}
+	}
+
+	blocks := make([]*api.Block, 0)
+	if from > to {
+		return blocks, nil
+	}
+
+	apiBlockProcessor := n.createAPIBlockProcessor()
+	for nonce := from; ; nonce++ {
+		apiBlock, err := apiBlockProcessor.GetBlockByNonce(nonce, withTxs)
+		if err != nil {
+			return nil, fmt.Errorf("%w for nonce %d", err, nonce)
+		}
+
+		blocks = append(blocks, apiBlock)
+		if nonce == to {
+			return blocks, nil
+		}
+	}
+}
+
+// GetHyperblockByNonce returns the hyperblock built on the meta block with the given nonce
+func (n *Node) GetHyperblockByNonce(nonce uint64) (*api.Hyperblock, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrHyperblocksOnlyOnMetachain
+	}
+
+	return n.createAPIHyperblockProcessor().GetHyperblockByNonce(nonce)
+}
+
+// GetHyperblockByHash returns the hyperblock built on the meta block with the given hash
+func (n *Node) GetHyperblockByHash(hash string) (*api.Hyperblock, error) {
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrHyperblocksOnlyOnMetachain
+	}
+
+	decodedHash, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.createAPIHyperblockProcessor().GetHyperblockByHash(decodedHash)
+}
+
 func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
-	blockApiArgs := &blockAPI.APIBlockProcessorArg{
+	blockApiArgs := n.createAPIBlockProcessorArg()
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return blockAPI.NewShardApiBlockProcessor(blockApiArgs)
+	}
+
+	return blockAPI.NewMetaApiBlockProcessor(blockApiArgs)
+}
+
+func (n *Node) createAPIHyperblockProcessor() blockAPI.APIHyperblockHandler {
+	return blockAPI.NewMetaApiBlockProcessor(n.createAPIBlockProcessorArg())
+}
+
+func (n *Node) createAPIBlockProcessorArg() *blockAPI.APIBlockProcessorArg {
+	return &blockAPI.APIBlockProcessorArg{
 		SelfShardID:              n.shardCoordinator.SelfId(),
 		Store:                    n.store,
 		Marshalizer:              n.internalMarshalizer,
@@ -35,10 +106,4 @@ func (n *Node) createAPIBlockProcessor() blockAPI.APIBlockHandler {
 		HistoryRepo:              n.historyRepository,
 		UnmarshalTx:              n.unmarshalTransaction,
 	}
-
-	if n.shardCoordinator.SelfId() != core.MetachainShardId {
-		return blockAPI.NewShardApiBlockProcessor(blockApiArgs)
-	}
-
-	return blockAPI.NewMetaApiBlockProcessor(blockApiArgs)
 }
@@ -3,10 +3,12 @@ package node_test
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -16,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetBlockByHash_InvalidShardShouldErr(t *testing.T) {
@@ -378,3 +381,115 @@ func TestGetBlockByHashFromHistoryNode_StatusReverted(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blk)
 }
+
+func createNodeWithMetaBlocks(t *testing.T, currentNonce uint64, nonces ...uint64) *node.Node {
+	uint64Converter := mock.NewNonceHashConverterMock()
+	storerMock := mock.NewStorerMock()
+	for _, nonce := range nonces {
+		headerHash := []byte(fmt.Sprintf("hash%d", nonce))
+		header := &block.MetaBlock{
+			Nonce:                  nonce,
+			AccumulatedFees:        big.NewInt(0),
+			DeveloperFees:          big.NewInt(0),
+			AccumulatedFeesInEpoch: big.NewInt(0),
+			DevFeesInEpoch:         big.NewInt(0),
+		}
+		headerBytes, _ := json.Marshal(header)
+		_ = storerMock.Put(headerHash, headerBytes)
+		_ = storerMock.Put(uint64Converter.ToByteSlice(nonce), headerHash)
+	}
+
+	n, err := node.NewNode(
+		node.WithInternalMarshalizer(&mock.MarshalizerFake{}, 90),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return storerMock
+			},
+			GetCalled: func(unitType dataRetriever.UnitType, key []byte) ([]byte, error) {
+				return storerMock.Get(key)
+			},
+		}),
+		node.WithUint64ByteSliceConverter(uint64Converter),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Nonce: currentNonce}
+			},
+		}),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestGetBlocksByNonceRange_InvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithMetaBlocks(t, 10)
+
+	blocks, err := n.GetBlocksByNonceRange(5, 4, false)
+	assert.Nil(t, blocks)
+	assert.Equal(t, node.ErrInvalidBlockRange, err)
+}
+
+func TestGetBlocksByNonceRange_ShouldTruncateToCurrentBlock(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithMetaBlocks(t, 3, 1, 2, 3)
+
+	blocks, err := n.GetBlocksByNonceRange(2, 10, false)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(blocks))
+	assert.Equal(t, uint64(2), blocks[0].Nonce)
+	assert.Equal(t, uint64(3), blocks[1].Nonce)
+
+	blocks, err = n.GetBlocksByNonceRange(5, 10, false)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(blocks))
+}
+
+func TestGetBlocksByNonceRange_MissingBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithMetaBlocks(t, 3, 1, 3)
+
+	blocks, err := n.GetBlocksByNonceRange(1, 3, false)
+	assert.Nil(t, blocks)
+	assert.NotNil(t, err)
+}
+
+func TestGetHyperblockByNonce_NotMetachainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	hyperblock, err := n.GetHyperblockByNonce(1)
+	assert.Nil(t, hyperblock)
+	assert.Equal(t, node.ErrHyperblocksOnlyOnMetachain, err)
+
+	hyperblock, err = n.GetHyperblockByHash("aa")
+	assert.Nil(t, hyperblock)
+	assert.Equal(t, node.ErrHyperblocksOnlyOnMetachain, err)
+}
+
+func TestGetHyperblockByHash_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeWithMetaBlocks(t, 2, 1, 2)
+
+	hyperblock, err := n.GetHyperblockByHash(hex.EncodeToString([]byte("hash2")))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), hyperblock.Nonce)
+	assert.Equal(t, blockAPI.BlockStatusOnChain, hyperblock.Status)
+
+	hyperblock, err = n.GetHyperblockByNonce(1)
+	require.Nil(t, err)
+	assert.Equal(t, hex.EncodeToString([]byte("hash1")), hyperblock.Hash)
+}
@@ -0,0 +1,17 @@
+package node
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// CreateEventsSubscriber creates a subscriber that receives the committed blocks, finalized transactions and logs
+// matching its subscriptions. The subscriber should be closed when no longer used
+func (n *Node) CreateEventsSubscriber() (api.EventsSubscriber, error) {
+	if check.IfNil(n.eventsHub) {
+		return nil, subscriptions.ErrEventsSubscriptionsDisabled
+	}
+
+	return n.eventsHub.CreateSubscriber()
+}
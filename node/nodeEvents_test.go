@@ -0,0 +1,31 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_CreateEventsSubscriberWithoutEventsHubShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	subscriber, err := n.CreateEventsSubscriber()
+	assert.Nil(t, subscriber)
+	assert.Equal(t, subscriptions.ErrEventsSubscriptionsDisabled, err)
+}
+
+func TestNode_CreateEventsSubscriberShouldUseTheEventsHub(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithEventsHub(subscriptions.NewDisabledEventsHub()),
+	)
+
+	subscriber, err := n.CreateEventsSubscriber()
+	assert.Nil(t, subscriber)
+	assert.Equal(t, subscriptions.ErrEventsSubscriptionsDisabled, err)
+}
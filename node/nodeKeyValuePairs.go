@@ -0,0 +1,113 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+)
+
+// GetKeyValuePairsPage returns at most limit key-value pairs stored under the given address, starting with the
+// provided start key, in the order in which they are found in the account's data trie. The returned next key should be
+// used as start key when requesting the following page and is empty when there are no more pairs. A limit of 0 means
+// that all the remaining pairs are returned
+func (n *Node) GetKeyValuePairsPage(
+	ctx context.Context,
+	address string,
+	startKey string,
+	limit int,
+	options api.AccountQueryOptions,
+) (map[string]string, string, error) {
+	if limit < 0 {
+		return nil, "", fmt.Errorf("%w for limit: %d", ErrInvalidValue, limit)
+	}
+
+	pairs := make(map[string]string)
+	nextKey := ""
+	err := n.iterateKeyValuePairs(ctx, address, startKey, options, func(key []byte, value []byte) bool {
+		if limit > 0 && len(pairs) == limit {
+			nextKey = hex.EncodeToString(key)
+			return false
+		}
+
+		pairs[hex.EncodeToString(key)] = hex.EncodeToString(value)
+		return true
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pairs, nextKey, nil
+}
+
+// StreamKeyValuePairs calls the provided handler with each hex encoded key-value pair stored under the given address,
+// starting with the provided start key. The iteration stops when the context is done or when the handler errors
+func (n *Node) StreamKeyValuePairs(
+	ctx context.Context,
+	address string,
+	startKey string,
+	options api.AccountQueryOptions,
+	handler func(key string, value string) error,
+) error {
+	var handlerErr error
+	err := n.iterateKeyValuePairs(ctx, address, startKey, options, func(key []byte, value []byte) bool {
+		handlerErr = handler(hex.EncodeToString(key), hex.EncodeToString(value))
+		return handlerErr == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return handlerErr
+}
+
+// iterateKeyValuePairs walks the data trie leaves of the given address, starting with the leaf having the start key
+// or with the first one after it, until the handler returns false or the context is done. The trie nodes placed
+// before the start key are not loaded, so each page costs only the leaves it returns
+func (n *Node) iterateKeyValuePairs(
+	ctx context.Context,
+	address string,
+	startKey string,
+	options api.AccountQueryOptions,
+	handler func(key []byte, value []byte) bool,
+) error {
+	startKeyBytes, err := hex.DecodeString(startKey)
+	if err != nil {
+		return fmt.Errorf("invalid start key: %w", err)
+	}
+
+	account, err := n.getAccountHandlerAPIAccounts(address, options)
+	if err != nil {
+		return err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return ErrAccountNotFound
+	}
+
+	dataTrie := userAccount.DataTrie()
+	if check.IfNil(dataTrie) {
+		return nil
+	}
+
+	rootHash, err := dataTrie.RootHash()
+	if err != nil {
+		return err
+	}
+
+	err = dataTrie.IterateLeavesFrom(rootHash, startKeyBytes, func(key []byte, value []byte) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+
+		return handler(key, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,172 @@
+package node_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var addressWithManyKeys = []byte("12345678901234567890123456789012")
+
+func createNodeWithManyKeyValuePairs(t *testing.T, numPairs int) (*node.Node, map[string]string) {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	adb := createAccountsDBForProofs(t, marshalizer)
+
+	account, err := adb.LoadAccount(addressWithManyKeys)
+	require.Nil(t, err)
+
+	userAccount := account.(state.UserAccountHandler)
+	expectedPairs := make(map[string]string)
+	for i := 0; i < numPairs; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		value := []byte(fmt.Sprintf("value%d", i))
+		err = userAccount.DataTrieTracker().SaveKeyValue(key, value)
+		require.Nil(t, err)
+
+		storedValue := append(append(value, key...), addressWithManyKeys...)
+		expectedPairs[hex.EncodeToString(key)] = hex.EncodeToString(storedValue)
+	}
+
+	err = adb.SaveAccount(userAccount)
+	require.Nil(t, err)
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	n, _ := node.NewNode(
+		node.WithAccountsAdapterAPI(adb),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithInternalMarshalizer(marshalizer, 100),
+		node.WithBlockChain(&mock.BlockChainMock{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.Header{RootHash: rootHash}
+			},
+		}),
+	)
+
+	return n, expectedPairs
+}
+
+func TestNode_GetKeyValuePairsPageNegativeLimitShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithManyKeyValuePairs(t, 1)
+
+	pairs, nextKey, err := n.GetKeyValuePairsPage(context.Background(), hex.EncodeToString(addressWithManyKeys), "", -1, api.AccountQueryOptions{})
+	assert.Nil(t, pairs)
+	assert.Equal(t, "", nextKey)
+	assert.True(t, errors.Is(err, node.ErrInvalidValue))
+}
+
+func TestNode_GetKeyValuePairsPageInvalidStartKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithManyKeyValuePairs(t, 1)
+
+	pairs, _, err := n.GetKeyValuePairsPage(context.Background(), hex.EncodeToString(addressWithManyKeys), "not hex", 1, api.AccountQueryOptions{})
+	assert.Nil(t, pairs)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetKeyValuePairsPageShouldReturnAllPairsAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	numPairs := 53
+	n, expectedPairs := createNodeWithManyKeyValuePairs(t, numPairs)
+	address := hex.EncodeToString(addressWithManyKeys)
+
+	recoveredPairs := make(map[string]string)
+	startKey := ""
+	numPages := 0
+	for {
+		pairs, nextKey, err := n.GetKeyValuePairsPage(context.Background(), address, startKey, 10, api.AccountQueryOptions{})
+		require.Nil(t, err)
+		require.True(t, len(pairs) <= 10)
+		numPages++
+
+		for key, value := range pairs {
+			_, found := recoveredPairs[key]
+			assert.False(t, found, "key returned on more than one page")
+			recoveredPairs[key] = value
+		}
+
+		if nextKey == "" {
+			break
+		}
+		startKey = nextKey
+	}
+
+	assert.Equal(t, 6, numPages)
+	assert.Equal(t, expectedPairs, recoveredPairs)
+}
+
+func TestNode_GetKeyValuePairsShouldReturnAllPairs(t *testing.T) {
+	t.Parallel()
+
+	n, expectedPairs := createNodeWithManyKeyValuePairs(t, 20)
+
+	pairs, err := n.GetKeyValuePairs(hex.EncodeToString(addressWithManyKeys), api.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPairs, pairs)
+}
+
+func TestNode_StreamKeyValuePairsShouldStopOnHandlerError(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithManyKeyValuePairs(t, 300)
+
+	expectedErr := errors.New("expected error")
+	numCalls := 0
+	err := n.StreamKeyValuePairs(
+		context.Background(),
+		hex.EncodeToString(addressWithManyKeys),
+		"",
+		api.AccountQueryOptions{},
+		func(_ string, _ string) error {
+			numCalls++
+			if numCalls == 5 {
+				return expectedErr
+			}
+			return nil
+		},
+	)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 5, numCalls)
+}
+
+func TestNode_StreamKeyValuePairsCancelledContextShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := createNodeWithManyKeyValuePairs(t, 300)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	numCalls := 0
+	err := n.StreamKeyValuePairs(
+		ctx,
+		hex.EncodeToString(addressWithManyKeys),
+		"",
+		api.AccountQueryOptions{},
+		func(_ string, _ string) error {
+			numCalls++
+			if numCalls == 1 {
+				cancel()
+			}
+			return nil
+		},
+	)
+
+	assert.Equal(t, context.Canceled, err)
+	assert.True(t, numCalls < 300)
+}
@@ -0,0 +1,110 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// GetProof returns the Merkle proof for the given address, computed on the accounts trie with the given root hash
+func (n *Node) GetProof(rootHash string, address string) ([][]byte, error) {
+	mainTrie, addressBytes, err := n.getAccountsTrieAndAddress(rootHash, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return mainTrie.GetProof(addressBytes)
+}
+
+// GetProofDataTrie returns the Merkle proof for the given address, computed on the accounts trie with the given
+// root hash, alongside the Merkle proof for the given key, computed on the data trie of that account
+func (n *Node) GetProofDataTrie(rootHash string, address string, key string) ([][]byte, [][]byte, error) {
+	keyBytes, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for key: %s", ErrInvalidValue, err.Error())
+	}
+
+	mainTrie, addressBytes, err := n.getAccountsTrieAndAddress(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serializedAccount, err := mainTrie.Get(addressBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(serializedAccount) == 0 {
+		return nil, nil, ErrAccountNotFound
+	}
+
+	mainProof, err := mainTrie.GetProof(addressBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account := state.NewEmptyUserAccount()
+	err = n.internalMarshalizer.Unmarshal(account, serializedAccount)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(account.RootHash) == 0 {
+		return nil, nil, ErrNilDataTrie
+	}
+
+	dataTrie, err := n.accountsAPI.GetTrie(account.RootHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if check.IfNil(dataTrie) {
+		return nil, nil, ErrNilDataTrie
+	}
+
+	dataTrieProof, err := dataTrie.GetProof(keyBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mainProof, dataTrieProof, nil
+}
+
+// VerifyProof verifies the given Merkle proof for the given address against the accounts trie with the given root hash
+func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (bool, error) {
+	mainTrie, addressBytes, err := n.getAccountsTrieAndAddress(rootHash, address)
+	if err != nil {
+		return false, err
+	}
+
+	return mainTrie.VerifyProof(addressBytes, proof)
+}
+
+func (n *Node) getAccountsTrieAndAddress(rootHash string, address string) (data.Trie, []byte, error) {
+	if check.IfNil(n.accountsAPI) {
+		return nil, nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, nil, ErrNilPubkeyConverter
+	}
+
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for root hash: %s", ErrInvalidValue, err.Error())
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w for address: %s", ErrInvalidAddress, err.Error())
+	}
+
+	mainTrie, err := n.accountsAPI.GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if check.IfNil(mainTrie) {
+		return nil, nil, ErrNilTrie
+	}
+
+	return mainTrie, addressBytes, nil
+}
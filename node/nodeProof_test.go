@@ -0,0 +1,187 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsDBForProofs(t *testing.T, marshalizer marshal.Marshalizer) state.AccountsAdapter {
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(memorydb.New())
+	require.Nil(t, err)
+
+	hasher := &blake2b.Blake2b{}
+	tr, err := trie.NewTrie(storageManager, marshalizer, hasher, 5)
+	require.Nil(t, err)
+
+	adb, err := state.NewAccountsDB(tr, hasher, marshalizer, factory.NewAccountCreator())
+	require.Nil(t, err)
+
+	return adb
+}
+
+func createNodeForProofs(accountsAPI state.AccountsAdapter, marshalizer marshal.Marshalizer) *node.Node {
+	n, _ := node.NewNode(
+		node.WithAccountsAdapterAPI(accountsAPI),
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithInternalMarshalizer(marshalizer, 100),
+	)
+
+	return n
+}
+
+func saveAccountWithDataTrie(t *testing.T, adb state.AccountsAdapter, address []byte, key []byte, value []byte) []byte {
+	account, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	userAccount := account.(state.UserAccountHandler)
+	err = userAccount.DataTrieTracker().SaveKeyValue(key, value)
+	require.Nil(t, err)
+
+	err = adb.SaveAccount(userAccount)
+	require.Nil(t, err)
+
+	rootHash, err := adb.Commit()
+	require.Nil(t, err)
+
+	return rootHash
+}
+
+func TestNode_GetProofNilAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	proof, err := n.GetProof("aabb", "aabb")
+	assert.Nil(t, proof)
+	assert.Equal(t, node.ErrNilAccountsAdapter, err)
+}
+
+func TestNode_GetProofInvalidRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	n := createNodeForProofs(createAccountsDBForProofs(t, marshalizer), marshalizer)
+
+	proof, err := n.GetProof("invalid root hash", "aabb")
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, node.ErrInvalidValue))
+}
+
+func TestNode_GetProofInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	n := createNodeForProofs(createAccountsDBForProofs(t, marshalizer), marshalizer)
+
+	proof, err := n.GetProof("aabb", "invalid address")
+	assert.Nil(t, proof)
+	assert.True(t, errors.Is(err, node.ErrInvalidAddress))
+}
+
+func TestNode_GetProofTrieErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	accountsAPI := &mock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (data.Trie, error) {
+			return nil, expectedErr
+		},
+	}
+	n := createNodeForProofs(accountsAPI, &marshal.GogoProtoMarshalizer{})
+
+	proof, err := n.GetProof("aabb", "aabb")
+	assert.Nil(t, proof)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetProofAndVerifyProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	adb := createAccountsDBForProofs(t, marshalizer)
+	address := []byte("12345678901234567890123456789012")
+	rootHash := saveAccountWithDataTrie(t, adb, address, []byte("key"), []byte("value"))
+	n := createNodeForProofs(adb, marshalizer)
+
+	hexRootHash := hex.EncodeToString(rootHash)
+	hexAddress := hex.EncodeToString(address)
+	proof, err := n.GetProof(hexRootHash, hexAddress)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(proof))
+
+	ok, err := n.VerifyProof(hexRootHash, hexAddress, proof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	otherAddress := hex.EncodeToString([]byte("12345678901234567890123456789013"))
+	ok, err = n.VerifyProof(hexRootHash, otherAddress, proof)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestNode_GetProofDataTrieInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	n := createNodeForProofs(createAccountsDBForProofs(t, marshalizer), marshalizer)
+
+	mainProof, dataTrieProof, err := n.GetProofDataTrie("aabb", "aabb", "invalid key")
+	assert.Nil(t, mainProof)
+	assert.Nil(t, dataTrieProof)
+	assert.True(t, errors.Is(err, node.ErrInvalidValue))
+}
+
+func TestNode_GetProofDataTrieMissingAccountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	adb := createAccountsDBForProofs(t, marshalizer)
+	rootHash := saveAccountWithDataTrie(t, adb, []byte("12345678901234567890123456789012"), []byte("key"), []byte("value"))
+	n := createNodeForProofs(adb, marshalizer)
+
+	missingAddress := hex.EncodeToString([]byte("12345678901234567890123456789013"))
+	mainProof, dataTrieProof, err := n.GetProofDataTrie(hex.EncodeToString(rootHash), missingAddress, "aa")
+	assert.Nil(t, mainProof)
+	assert.Nil(t, dataTrieProof)
+	assert.Equal(t, node.ErrAccountNotFound, err)
+}
+
+func TestNode_GetProofDataTrieShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	adb := createAccountsDBForProofs(t, marshalizer)
+	address := []byte("12345678901234567890123456789012")
+	key := []byte("key")
+	rootHash := saveAccountWithDataTrie(t, adb, address, key, []byte("value"))
+	n := createNodeForProofs(adb, marshalizer)
+
+	mainProof, dataTrieProof, err := n.GetProofDataTrie(
+		hex.EncodeToString(rootHash),
+		hex.EncodeToString(address),
+		hex.EncodeToString(key),
+	)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(mainProof))
+	assert.NotEqual(t, 0, len(dataTrieProof))
+
+	account, err := adb.GetExistingAccount(address)
+	require.Nil(t, err)
+	dataTrie := account.(state.UserAccountHandler).DataTrie()
+	ok, err := dataTrie.VerifyProof(key, dataTrieProof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,70 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
+
+// maxStateDiffLeaves is the maximum number of changed leaves, counting both the accounts and their data trie leaves,
+// returned by a single state diff request
+const maxStateDiffLeaves = 10000
+
+// GetStateDiff returns the accounts that were added, modified or deleted between the two hex encoded root hashes,
+// alongside the changed leaves of their data tries. If there are too many changed leaves, only the first ones are
+// returned and the result is marked as truncated
+func (n *Node) GetStateDiff(fromRootHash string, toRootHash string) (*api.StateDiff, error) {
+	if check.IfNil(n.accountsAPI) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	fromRootHashBytes, err := hex.DecodeString(fromRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for from root hash: %s", ErrInvalidValue, err.Error())
+	}
+	toRootHashBytes, err := hex.DecodeString(toRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for to root hash: %s", ErrInvalidValue, err.Error())
+	}
+
+	stateDiff := &api.StateDiff{
+		FromRootHash: fromRootHash,
+		ToRootHash:   toRootHash,
+		Accounts:     make([]*api.AccountDiff, 0),
+	}
+	stateDiff.Truncated, err = n.accountsAPI.GetStateDiff(fromRootHashBytes, toRootHashBytes, maxStateDiffLeaves, func(accountDiff state.AccountDiff) bool {
+		stateDiff.Accounts = append(stateDiff.Accounts, toApiAccountDiff(accountDiff))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stateDiff, nil
+}
+
+func toApiAccountDiff(accountDiff state.AccountDiff) *api.AccountDiff {
+	apiAccountDiff := &api.AccountDiff{
+		LeafDiff:          *toApiLeafDiff(accountDiff.TrieLeafDiff),
+		DataTrieTruncated: accountDiff.DataTrieTruncated,
+	}
+
+	for _, leafDiff := range accountDiff.DataTrieChanges {
+		apiAccountDiff.DataTrieChanges = append(apiAccountDiff.DataTrieChanges, toApiLeafDiff(leafDiff))
+	}
+
+	return apiAccountDiff
+}
+
+func toApiLeafDiff(leafDiff data.TrieLeafDiff) *api.LeafDiff {
+	return &api.LeafDiff{
+		Change:   leafDiff.Change.String(),
+		Key:      hex.EncodeToString(leafDiff.Key),
+		OldValue: hex.EncodeToString(leafDiff.OldValue),
+		NewValue: hex.EncodeToString(leafDiff.NewValue),
+	}
+}
@@ -0,0 +1,108 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetStateDiffNilAccountsAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	stateDiff, err := n.GetStateDiff("aa", "bb")
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, node.ErrNilAccountsAdapter, err)
+}
+
+func TestNode_GetStateDiffInvalidRootHashesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n := createNodeForProofs(&mock.AccountsStub{}, &marshal.GogoProtoMarshalizer{})
+
+	stateDiff, err := n.GetStateDiff("not hex", "bb")
+	assert.Nil(t, stateDiff)
+	assert.True(t, errors.Is(err, node.ErrInvalidValue))
+
+	stateDiff, err = n.GetStateDiff("aa", "not hex")
+	assert.Nil(t, stateDiff)
+	assert.True(t, errors.Is(err, node.ErrInvalidValue))
+}
+
+func TestNode_GetStateDiffAccountsAdapterErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	accounts := &mock.AccountsStub{
+		GetStateDiffCalled: func(_ []byte, _ []byte, _ int, _ func(state.AccountDiff) bool) (bool, error) {
+			return false, expectedErr
+		},
+	}
+	n := createNodeForProofs(accounts, &marshal.GogoProtoMarshalizer{})
+
+	stateDiff, err := n.GetStateDiff("aa", "bb")
+	assert.Nil(t, stateDiff)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetStateDiffShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	adb := createAccountsDBForProofs(t, marshalizer)
+	address := make([]byte, 32)
+	fromRootHash := saveAccountWithDataTrie(t, adb, address, []byte("key"), []byte("value"))
+	toRootHash := saveAccountWithDataTrie(t, adb, address, []byte("key"), []byte("new value"))
+
+	n := createNodeForProofs(adb, marshalizer)
+
+	stateDiff, err := n.GetStateDiff(hex.EncodeToString(fromRootHash), hex.EncodeToString(toRootHash))
+	require.Nil(t, err)
+	assert.False(t, stateDiff.Truncated)
+	require.Equal(t, 1, len(stateDiff.Accounts))
+
+	accountDiff := stateDiff.Accounts[0]
+	assert.Equal(t, data.LeafModified.String(), accountDiff.Change)
+	assert.Equal(t, hex.EncodeToString(address), accountDiff.Key)
+	assert.Equal(t, []*api.LeafDiff{
+		{
+			Change:   data.LeafModified.String(),
+			Key:      hex.EncodeToString([]byte("key")),
+			OldValue: hex.EncodeToString([]byte("value")),
+			NewValue: hex.EncodeToString([]byte("new value")),
+		},
+	}, accountDiff.DataTrieChanges)
+}
+
+func TestNode_GetStateDiffTooManyLeavesShouldTruncate(t *testing.T) {
+	t.Parallel()
+
+	accounts := &mock.AccountsStub{
+		GetStateDiffCalled: func(_ []byte, _ []byte, maxLeaves int, handler func(state.AccountDiff) bool) (bool, error) {
+			assert.Equal(t, node.MaxStateDiffLeaves, maxLeaves)
+			handler(state.AccountDiff{
+				TrieLeafDiff:      data.TrieLeafDiff{Change: data.LeafModified, Key: []byte("address")},
+				DataTrieTruncated: true,
+			})
+
+			return true, nil
+		},
+	}
+	n := createNodeForProofs(accounts, &marshal.GogoProtoMarshalizer{})
+
+	stateDiff, err := n.GetStateDiff("aa", "bb")
+	require.Nil(t, err)
+	assert.True(t, stateDiff.Truncated)
+	require.Equal(t, 1, len(stateDiff.Accounts))
+	assert.True(t, stateDiff.Accounts[0].DataTrieTruncated)
+}
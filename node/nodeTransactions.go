@@ -64,6 +64,23 @@ func (n *Node) lookupHistoricalTransaction(hash []byte, withResults bool) (*tran
 		return nil, fmt.Errorf("%s: %w", ErrCannotRetrieveTransaction.Error(), err)
 	}
 
+	tx, err := n.prepareHistoricalTransaction(hash, txBytes, txType, miniblockMetadata, withResults)
+	if err != nil {
+		return nil, err
+	}
+
+	n.putHopsInTransaction(hash, tx, miniblockMetadata.Epoch, maxTrackedHops)
+
+	return tx, nil
+}
+
+func (n *Node) prepareHistoricalTransaction(
+	hash []byte,
+	txBytes []byte,
+	txType transaction.TxType,
+	miniblockMetadata *dblookupext.MiniblockMetadata,
+	withResults bool,
+) (*transaction.ApiTransactionResult, error) {
 	// After looking up a transaction from storage, it's impossible to say whether it was successful or invalid
 	// (since both successful and invalid transactions are kept in the same storage unit),
 	// so we have to use our extra information from the "miniblockMetadata" to correct the txType if appropriate
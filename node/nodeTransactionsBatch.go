@@ -0,0 +1,204 @@
+package node
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+type txBytesWithType struct {
+	txBytes []byte
+	txType  transaction.TxType
+}
+
+// GetTransactions gets the transactions based on the given hashes. The transactions not found in the pool are fetched
+// from the storage in bulk, grouped by the epoch they were executed in. Their hops are followed up to
+// maxTrackedHopsInBatch for the whole batch. The hashes that cannot be found are reported in the NotFound list of the
+// result, in the order they were provided
+func (n *Node) GetTransactions(txHashes []string, withResults bool) (*transaction.ApiTransactionsBatch, error) {
+	hashes := make([][]byte, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		hash, err := hex.DecodeString(txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	batch := &transaction.ApiTransactionsBatch{
+		Transactions: make(map[string]*transaction.ApiTransactionResult),
+		NotFound:     make([]string, 0),
+	}
+
+	hashesToLookup := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		_, alreadyFound := batch.Transactions[hex.EncodeToString(hash)]
+		if alreadyFound {
+			continue
+		}
+
+		tx, err := n.optionallyGetTransactionFromPool(hash)
+		if err != nil {
+			log.Debug("GetTransactions: cannot get transaction from pool",
+				"hash", hex.EncodeToString(hash),
+				"error", err.Error())
+			continue
+		}
+		if tx != nil {
+			batch.Transactions[hex.EncodeToString(hash)] = tx
+			continue
+		}
+
+		hashesToLookup = append(hashesToLookup, hash)
+	}
+
+	if n.historyRepository.IsEnabled() {
+		n.lookupHistoricalTransactions(hashesToLookup, withResults, batch)
+	} else {
+		n.lookupTransactionsInStorage(hashesToLookup, batch)
+	}
+
+	notFound := make(map[string]struct{})
+	for _, hash := range hashes {
+		hexHash := hex.EncodeToString(hash)
+		_, found := batch.Transactions[hexHash]
+		_, alreadyAdded := notFound[hexHash]
+		if found || alreadyAdded {
+			continue
+		}
+
+		notFound[hexHash] = struct{}{}
+		batch.NotFound = append(batch.NotFound, hexHash)
+	}
+
+	return batch, nil
+}
+
+func (n *Node) lookupTransactionsInStorage(hashes [][]byte, batch *transaction.ApiTransactionsBatch) {
+	for _, hash := range hashes {
+		tx, err := n.getTransactionFromStorage(hash)
+		if err != nil {
+			continue
+		}
+
+		batch.Transactions[hex.EncodeToString(hash)] = tx
+	}
+}
+
+func (n *Node) lookupHistoricalTransactions(hashes [][]byte, withResults bool, batch *transaction.ApiTransactionsBatch) {
+	hashesByEpoch := make(map[uint32][][]byte)
+	added := make(map[string]struct{})
+	for _, hash := range hashes {
+		_, alreadyAdded := added[string(hash)]
+		if alreadyAdded {
+			continue
+		}
+
+		epoch, err := n.getEpochByTxHash(hash)
+		if err != nil {
+			continue
+		}
+
+		added[string(hash)] = struct{}{}
+		hashesByEpoch[epoch] = append(hashesByEpoch[epoch], hash)
+	}
+
+	remainingHops := maxTrackedHopsInBatch
+	for epoch, epochHashes := range hashesByEpoch {
+		txsBytes := n.getTxsBytesFromStorageByEpoch(epochHashes, epoch)
+		for _, hash := range epochHashes {
+			txBytesAndType, found := txsBytes[string(hash)]
+			if !found {
+				log.Debug("lookupHistoricalTransactions: cannot find transaction in storage",
+					"hash", hex.EncodeToString(hash),
+					"epoch", epoch)
+				continue
+			}
+
+			miniblockMetadata, err := n.historyRepository.GetMiniblockMetadataByTxHash(hash)
+			if err != nil {
+				log.Debug("lookupHistoricalTransactions: cannot get miniblock metadata",
+					"hash", hex.EncodeToString(hash),
+					"error", err.Error())
+				continue
+			}
+
+			tx, err := n.prepareHistoricalTransaction(hash, txBytesAndType.txBytes, txBytesAndType.txType, miniblockMetadata, withResults)
+			if err != nil {
+				log.Warn("lookupHistoricalTransactions: cannot prepare transaction",
+					"hash", hex.EncodeToString(hash),
+					"error", err.Error())
+				continue
+			}
+
+			remainingHops -= n.putHopsInTransaction(hash, tx, epoch, core.MinInt(maxTrackedHops, remainingHops))
+			batch.Transactions[hex.EncodeToString(hash)] = tx
+		}
+	}
+}
+
+// getEpochByTxHash returns the epoch of the provided transaction from the epoch by hash index of the history
+// repository. The transactions recorded before the index held transaction hashes are resolved through their
+// miniblock metadata
+func (n *Node) getEpochByTxHash(hash []byte) (uint32, error) {
+	epoch, err := n.historyRepository.GetEpochByHash(hash)
+	if err == nil {
+		return epoch, nil
+	}
+
+	miniblockMetadata, err := n.historyRepository.GetMiniblockMetadataByTxHash(hash)
+	if err != nil {
+		return 0, err
+	}
+
+	return miniblockMetadata.Epoch, nil
+}
+
+func (n *Node) getTxsBytesFromStorageByEpoch(hashes [][]byte, epoch uint32) map[string]*txBytesWithType {
+	storersWithTypes := []struct {
+		unit   dataRetriever.UnitType
+		txType transaction.TxType
+	}{
+		{unit: dataRetriever.TransactionUnit, txType: transaction.TxTypeNormal},
+		{unit: dataRetriever.RewardTransactionUnit, txType: transaction.TxTypeReward},
+		{unit: dataRetriever.UnsignedTransactionUnit, txType: transaction.TxTypeUnsigned},
+	}
+
+	result := make(map[string]*txBytesWithType)
+	remainingHashes := hashes
+	for _, storerWithType := range storersWithTypes {
+		if len(remainingHashes) == 0 {
+			break
+		}
+
+		storer := n.store.GetStorer(storerWithType.unit)
+		txsBytes, err := storer.GetBulkFromEpoch(remainingHashes, epoch)
+		if err != nil {
+			log.Debug("getTxsBytesFromStorageByEpoch: cannot get transactions from storage",
+				"unit", storerWithType.unit,
+				"epoch", epoch,
+				"error", err.Error())
+			continue
+		}
+
+		notFoundHashes := make([][]byte, 0, len(remainingHashes))
+		for _, hash := range remainingHashes {
+			txBytes, found := txsBytes[string(hash)]
+			if !found {
+				notFoundHashes = append(notFoundHashes, hash)
+				continue
+			}
+
+			result[string(hash)] = &txBytesWithType{
+				txBytes: txBytes,
+				txType:  storerWithType.txType,
+			}
+		}
+		remainingHashes = notFoundHashes
+	}
+
+	return result
+}
@@ -0,0 +1,187 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetTransactionsInvalidHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _, _, _ := createNode(t, 42, true)
+
+	batch, err := n.GetTransactions([]string{hex.EncodeToString([]byte("a")), "zzz"}, false)
+	assert.Nil(t, batch)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetTransactionsShouldReturnPartialResults(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, dataPool, historyRepo := createNode(t, 42, true)
+
+	// cross-shard, we are destination, executed in epoch 42
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("bob"), RcvAddr: []byte("alice")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, n.internalMarshalizer)
+	// reward, executed in epoch 41
+	txB := &rewardTx.RewardTx{Round: 50, RcvAddr: []byte("alice")}
+	txBBytes, _ := n.internalMarshalizer.Marshal(txB)
+	_ = chainStorer.Rewards.PutInEpoch([]byte("b"), txBBytes, 41)
+	// in pool
+	txC := &transaction.Transaction{Nonce: 8, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	dataPool.Transactions().AddData([]byte("c"), txC, 42, "1")
+
+	metadataByHash := map[string]*dblookupext.MiniblockMetadata{
+		"a": {Type: int32(block.TxBlock), SourceShardID: 2, DestinationShardID: 1, Epoch: 42},
+		"b": {Type: int32(block.RewardsBlock), SourceShardID: 2, DestinationShardID: 1, Epoch: 41, Round: 50},
+		// known by the history repository, but missing from the storage
+		"e": {Type: int32(block.TxBlock), SourceShardID: 1, DestinationShardID: 1, Epoch: 42},
+	}
+	historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+		metadata, ok := metadataByHash[string(hash)]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+
+		return metadata, nil
+	}
+	// "e" was recorded before the epoch by hash index held transaction hashes
+	epochByHash := map[string]uint32{
+		"a": 42,
+		"b": 41,
+	}
+	historyRepo.GetEpochByHashCalled = func(hash []byte) (uint32, error) {
+		epoch, ok := epochByHash[string(hash)]
+		if !ok {
+			return 0, errors.New("not found")
+		}
+
+		return epoch, nil
+	}
+
+	hashes := []string{
+		hex.EncodeToString([]byte("a")),
+		hex.EncodeToString([]byte("d")),
+		hex.EncodeToString([]byte("b")),
+		hex.EncodeToString([]byte("c")),
+		hex.EncodeToString([]byte("e")),
+		hex.EncodeToString([]byte("d")),
+	}
+	batch, err := n.GetTransactions(hashes, false)
+	require.Nil(t, err)
+
+	require.Equal(t, 3, len(batch.Transactions))
+	actualA := batch.Transactions[hex.EncodeToString([]byte("a"))]
+	require.NotNil(t, actualA)
+	assert.Equal(t, txA.Nonce, actualA.Nonce)
+	assert.Equal(t, uint32(42), actualA.Epoch)
+	assert.Equal(t, transaction.TxStatusSuccess, actualA.Status)
+
+	actualB := batch.Transactions[hex.EncodeToString([]byte("b"))]
+	require.NotNil(t, actualB)
+	assert.Equal(t, txB.Round, actualB.Round)
+	assert.Equal(t, uint32(41), actualB.Epoch)
+	assert.Equal(t, string(transaction.TxTypeReward), actualB.Type)
+
+	actualC := batch.Transactions[hex.EncodeToString([]byte("c"))]
+	require.NotNil(t, actualC)
+	assert.Equal(t, txC.Nonce, actualC.Nonce)
+	assert.Equal(t, transaction.TxStatusPending, actualC.Status)
+
+	expectedNotFound := []string{
+		hex.EncodeToString([]byte("d")),
+		hex.EncodeToString([]byte("e")),
+	}
+	assert.Equal(t, expectedNotFound, batch.NotFound)
+}
+
+func TestNode_GetTransactionsWithoutDbLookupExtensionsShouldSearchStorage(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, _ := createNode(t, 0, false)
+
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, n.internalMarshalizer)
+
+	batch, err := n.GetTransactions([]string{hex.EncodeToString([]byte("a")), hex.EncodeToString([]byte("b"))}, false)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(batch.Transactions))
+	assert.Equal(t, txA.Nonce, batch.Transactions[hex.EncodeToString([]byte("a"))].Nonce)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("b"))}, batch.NotFound)
+}
+
+func TestNode_GetTransactionsShouldFollowTheHopsWithinTheBatchLimit(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 42, true)
+
+	txHashes := []string{"a", "b", "c"}
+	resultsByTxHash := make(map[string][][]byte)
+	for i, txHash := range txHashes {
+		tx := &transaction.Transaction{Nonce: uint64(i), SndAddr: []byte("alice"), RcvAddr: []byte("contract"), Data: []byte("call")}
+		_ = chainStorer.Transactions.PutWithMarshalizer([]byte(txHash), tx, n.internalMarshalizer)
+
+		numResults := maxTrackedHopsInBatch / 2
+		if txHash == "c" {
+			numResults = 1
+		}
+		for j := 0; j < numResults; j++ {
+			scrHash := []byte(fmt.Sprintf("%s-scr%d", txHash, j))
+			resultsByTxHash[txHash] = append(resultsByTxHash[txHash], scrHash)
+			_ = chainStorer.Unsigned.PutWithMarshalizer(scrHash, &smartContractResult.SmartContractResult{}, n.internalMarshalizer)
+		}
+	}
+
+	historyRepo.GetEpochByHashCalled = func(hash []byte) (uint32, error) {
+		return 42, nil
+	}
+	historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+		return &dblookupext.MiniblockMetadata{
+			Type:                              int32(block.TxBlock),
+			Epoch:                             42,
+			NotarizedAtDestinationInMetaNonce: 100,
+		}, nil
+	}
+	historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+		resultsHashes, ok := resultsByTxHash[string(hash)]
+		if !ok {
+			return nil, errors.New("no results")
+		}
+
+		return &dblookupext.ResultsHashesByTxHash{
+			ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+				{Epoch: 42, ScResultsHashes: resultsHashes},
+			},
+		}, nil
+	}
+
+	hashes := make([]string, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		hashes = append(hashes, hex.EncodeToString([]byte(txHash)))
+	}
+	batch, err := n.GetTransactions(hashes, false)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(batch.Transactions))
+
+	for _, txHash := range []string{"a", "b"} {
+		actual := batch.Transactions[hex.EncodeToString([]byte(txHash))]
+		assert.Equal(t, maxTrackedHopsInBatch/2, len(actual.Hops))
+		assert.False(t, actual.HopsTruncated)
+		assert.Equal(t, transaction.TxStatusFinalized, actual.Status)
+	}
+
+	actualC := batch.Transactions[hex.EncodeToString([]byte("c"))]
+	assert.Equal(t, 0, len(actualC.Hops))
+	assert.True(t, actualC.HopsTruncated)
+	assert.Equal(t, transaction.TxStatusSuccess, actualC.Status)
+}
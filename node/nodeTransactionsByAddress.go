@@ -0,0 +1,50 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+var transactionRolesNames = map[dblookupext.TransactionRole]string{
+	dblookupext.RoleSender:                      "sender",
+	dblookupext.RoleReceiver:                    "receiver",
+	dblookupext.RoleSmartContractResultSender:   "scrSender",
+	dblookupext.RoleSmartContractResultReceiver: "scrReceiver",
+	dblookupext.RoleRewardReceiver:              "rewardReceiver",
+}
+
+// GetTransactionsByAddress returns at most limit transactions involving the given address, newest first, skipping the
+// first offset ones, alongside the total number of transactions known for that address
+func (n *Node) GetTransactionsByAddress(address string, offset uint64, limit uint64) ([]*transaction.ApiTransactionByAddress, uint64, error) {
+	if check.IfNil(n.addressPubkeyConverter) {
+		return nil, 0, ErrNilPubkeyConverter
+	}
+	if check.IfNil(n.historyRepository) || !n.historyRepository.IsEnabled() {
+		return nil, 0, dblookupext.ErrTransactionsByAddressIndexNotEnabled
+	}
+
+	addressBytes, err := n.addressPubkeyConverter.Decode(address)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w for address: %s", ErrInvalidAddress, err.Error())
+	}
+
+	transactions, total, err := n.historyRepository.GetTransactionsByAddress(addressBytes, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	apiTransactions := make([]*transaction.ApiTransactionByAddress, 0, len(transactions))
+	for _, tx := range transactions {
+		apiTransactions = append(apiTransactions, &transaction.ApiTransactionByAddress{
+			Hash:  hex.EncodeToString(tx.TxHash),
+			Epoch: tx.Epoch,
+			Role:  transactionRolesNames[tx.Role],
+		})
+	}
+
+	return apiTransactions, total, nil
+}
@@ -0,0 +1,91 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_GetTransactionsByAddressHistoryNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}),
+	)
+
+	transactions, total, err := n.GetTransactionsByAddress(hex.EncodeToString(addressWithManyKeys), 0, 10)
+	assert.Nil(t, transactions)
+	assert.Equal(t, uint64(0), total)
+	assert.Equal(t, dblookupext.ErrTransactionsByAddressIndexNotEnabled, err)
+}
+
+func TestNode_GetTransactionsByAddressInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{}),
+	)
+
+	transactions, _, err := n.GetTransactionsByAddress("invalid address", 0, 10)
+	assert.Nil(t, transactions)
+	assert.True(t, errors.Is(err, node.ErrInvalidAddress))
+}
+
+func TestNode_GetTransactionsByAddressRepositoryErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			GetTransactionsByAddressCalled: func(_ []byte, _ uint64, _ uint64) ([]*dblookupext.TransactionByAddress, uint64, error) {
+				return nil, 0, expectedErr
+			},
+		}),
+	)
+
+	transactions, _, err := n.GetTransactionsByAddress(hex.EncodeToString(addressWithManyKeys), 0, 10)
+	assert.Nil(t, transactions)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestNode_GetTransactionsByAddressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithAddressPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithHistoryRepository(&testscommon.HistoryRepositoryStub{
+			GetTransactionsByAddressCalled: func(address []byte, offset uint64, limit uint64) ([]*dblookupext.TransactionByAddress, uint64, error) {
+				assert.Equal(t, addressWithManyKeys, address)
+				assert.Equal(t, uint64(3), offset)
+				assert.Equal(t, uint64(2), limit)
+
+				return []*dblookupext.TransactionByAddress{
+					{Epoch: 4, TxHash: []byte("txB"), Role: dblookupext.RoleSmartContractResultReceiver},
+					{Epoch: 3, TxHash: []byte("txA"), Role: dblookupext.RoleRewardReceiver},
+				}, 7, nil
+			},
+		}),
+	)
+
+	transactions, total, err := n.GetTransactionsByAddress(hex.EncodeToString(addressWithManyKeys), 3, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), total)
+	assert.Equal(t, []*transaction.ApiTransactionByAddress{
+		{Hash: hex.EncodeToString([]byte("txB")), Epoch: 4, Role: "scrReceiver"},
+		{Hash: hex.EncodeToString([]byte("txA")), Epoch: 3, Role: "rewardReceiver"},
+	}, transactions)
+}
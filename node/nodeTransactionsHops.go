@@ -0,0 +1,171 @@
+package node
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/vmcommon"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+)
+
+// maxTrackedHops limits the number of smart contract results followed for a single transaction, so that a contract
+// generating a huge number of results will not make the transaction lookup too expensive
+const maxTrackedHops = 500
+
+// maxTrackedHopsInBatch limits the number of smart contract results followed for all the transactions of a batch
+// lookup. The transactions left without hops to follow are flagged as truncated if they generated any result
+const maxTrackedHopsInBatch = 1000
+
+const returnCodeSeparator = "@"
+
+// okReturnCode is the hex encoded "ok" return code that some smart contract results carry in their data field
+var okReturnCode = hex.EncodeToString([]byte(vmcommon.Ok.String()))
+
+// vmOkReturnCode is the hex encoded numeric return code carried by the asynchronous call back results
+var vmOkReturnCode = core.ConvertToEvenHex(int(vmcommon.Ok))
+
+type hopToVisit struct {
+	hash  []byte
+	epoch uint32
+}
+
+// putHopsInTransaction follows the chain of smart contract results generated by the provided transaction and adds
+// them as hops. A successful transaction becomes finalized when it is notarized on its destination shard and all
+// the resulting smart contract results are notarized on their destination shards as well. A transaction which can
+// generate smart contract results needs at least one of them to become finalized. A failed smart contract
+// result marks the whole transaction as failed, while a list of hops cut at maxHops is flagged as truncated and never
+// leads to a finalized transaction. It returns the number of hops added
+func (n *Node) putHopsInTransaction(hash []byte, tx *transaction.ApiTransactionResult, epoch uint32, maxHops int) int {
+	if tx.Status != transaction.TxStatusSuccess {
+		return 0
+	}
+
+	allHopsFinalized := true
+	anyHopFailed := false
+	visited := map[string]struct{}{string(hash): {}}
+	toVisit := []hopToVisit{{hash: hash, epoch: epoch}}
+	for len(toVisit) > 0 {
+		current := toVisit[0]
+		toVisit = toVisit[1:]
+
+		resultsHashes, err := n.historyRepository.GetResultsHashesByTxHash(current.hash, current.epoch)
+		if err != nil || resultsHashes == nil {
+			continue
+		}
+
+		for _, scrHashesEpoch := range resultsHashes.ScResultsHashesAndEpoch {
+			for _, scrHash := range scrHashesEpoch.ScResultsHashes {
+				_, alreadyVisited := visited[string(scrHash)]
+				if alreadyVisited {
+					continue
+				}
+				if len(visited) > maxHops {
+					log.Debug("putHopsInTransaction: too many smart contract results, finalization not computed",
+						"hash", hex.EncodeToString(hash))
+					tx.HopsTruncated = true
+					setStatusIfAnyHopFailed(tx, anyHopFailed)
+					return len(tx.Hops)
+				}
+				visited[string(scrHash)] = struct{}{}
+
+				hop := n.createTransactionHop(scrHash, scrHashesEpoch.Epoch)
+				tx.Hops = append(tx.Hops, hop)
+				allHopsFinalized = allHopsFinalized && hop.Status == transaction.TxStatusFinalized
+				anyHopFailed = anyHopFailed || hop.Status == transaction.TxStatusFail
+
+				toVisit = append(toVisit, hopToVisit{hash: scrHash, epoch: hop.Epoch})
+			}
+		}
+	}
+
+	if anyHopFailed {
+		setStatusIfAnyHopFailed(tx, anyHopFailed)
+		return len(tx.Hops)
+	}
+	if len(tx.Hops) == 0 && canGenerateHops(tx) {
+		// the smart contract results are not yet known by this node
+		return 0
+	}
+	if allHopsFinalized && tx.NotarizedAtDestinationInMetaNonce > 0 {
+		tx.Status = transaction.TxStatusFinalized
+	}
+
+	return len(tx.Hops)
+}
+
+// canGenerateHops returns true if the transaction calls a smart contract or carries data, so it can not be
+// finalized before at least one resulting smart contract result is known
+func canGenerateHops(tx *transaction.ApiTransactionResult) bool {
+	if len(tx.Data) > 0 {
+		return true
+	}
+
+	return !check.IfNil(tx.Tx) && core.IsSmartContractAddress(tx.Tx.GetRcvAddr())
+}
+
+func setStatusIfAnyHopFailed(tx *transaction.ApiTransactionResult, anyHopFailed bool) {
+	if anyHopFailed {
+		tx.Status = transaction.TxStatusFail
+	}
+}
+
+func (n *Node) createTransactionHop(scrHash []byte, epoch uint32) *transaction.ApiTransactionHop {
+	hop := &transaction.ApiTransactionHop{
+		Hash:   hex.EncodeToString(scrHash),
+		Epoch:  epoch,
+		Status: transaction.TxStatusPending,
+	}
+
+	miniblockMetadata, err := n.historyRepository.GetMiniblockMetadataByTxHash(scrHash)
+	if err != nil {
+		// the smart contract result was not yet included in a block known by this node
+		return hop
+	}
+
+	hop.Epoch = miniblockMetadata.Epoch
+	hop.SourceShard = miniblockMetadata.SourceShardID
+	hop.DestinationShard = miniblockMetadata.DestinationShardID
+	hop.MiniBlockHash = hex.EncodeToString(miniblockMetadata.MiniblockHash)
+	hop.BlockNonce = miniblockMetadata.HeaderNonce
+	hop.NotarizedAtDestinationInMetaNonce = miniblockMetadata.NotarizedAtDestinationInMetaNonce
+
+	if block.Type(miniblockMetadata.Type) == block.InvalidBlock {
+		hop.Status = transaction.TxStatusInvalid
+		return hop
+	}
+
+	scr, err := n.getScrFromStorage(scrHash, miniblockMetadata.Epoch)
+	if err != nil {
+		// the hop stays pending as its outcome can not be determined
+		log.Debug("createTransactionHop: cannot get smart contract result from storage",
+			"hash", hop.Hash, "error", err)
+		return hop
+	}
+
+	switch {
+	case isFailedSmartContractResult(scr):
+		hop.Status = transaction.TxStatusFail
+	case miniblockMetadata.NotarizedAtDestinationInMetaNonce > 0:
+		hop.Status = transaction.TxStatusFinalized
+	}
+
+	return hop
+}
+
+// isFailedSmartContractResult returns true if the data field of the provided smart contract result starts with a
+// return code other than the ok one
+func isFailedSmartContractResult(scr *smartContractResult.SmartContractResult) bool {
+	if !bytes.HasPrefix(scr.Data, []byte(returnCodeSeparator)) {
+		return false
+	}
+
+	tokens := strings.Split(string(scr.Data[len(returnCodeSeparator):]), returnCodeSeparator)
+	returnCode := tokens[0]
+
+	return len(returnCode) > 0 && returnCode != okReturnCode && returnCode != vmOkReturnCode
+}
@@ -3,6 +3,7 @@ package node
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"testing"
@@ -252,6 +253,12 @@ func TestNode_GetTransactionWithResultsFromStorage(t *testing.T) {
 				OriginalTxHash: txHash,
 			},
 		},
+		Hops: []*transaction.ApiTransactionHop{
+			{
+				Hash:   hex.EncodeToString(scResultHash),
+				Status: transaction.TxStatusPending,
+			},
+		},
 	}
 
 	apiTx, err := n.GetTransaction(txHash, true)
@@ -546,3 +553,198 @@ func TestPrepareUnsignedTx(t *testing.T) {
 	}
 	assert.Equal(t, scrResult2, expectedScr2)
 }
+
+func TestNode_lookupHistoricalTransactionShouldComputeHops(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 42, true)
+
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("contract")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, n.internalMarshalizer)
+	scr1 := &smartContractResult.SmartContractResult{Nonce: 8, Data: []byte("@6f6b")}
+	_ = chainStorer.Unsigned.PutWithMarshalizer([]byte("scr1"), scr1, n.internalMarshalizer)
+	scr2 := &smartContractResult.SmartContractResult{Nonce: 9, Data: []byte("@00")}
+	_ = chainStorer.Unsigned.PutWithMarshalizer([]byte("scr2"), scr2, n.internalMarshalizer)
+
+	notarizedAtDestination := map[string]uint64{
+		"a":    100,
+		"scr1": 101,
+		"scr2": 0,
+	}
+	historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+		nonce, ok := notarizedAtDestination[string(hash)]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+
+		return &dblookupext.MiniblockMetadata{
+			Type:                              int32(block.TxBlock),
+			SourceShardID:                     1,
+			DestinationShardID:                2,
+			Epoch:                             42,
+			MiniblockHash:                     []byte("mb-" + string(hash)),
+			NotarizedAtDestinationInMetaNonce: nonce,
+		}, nil
+	}
+	historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+		switch string(hash) {
+		case "a":
+			return &dblookupext.ResultsHashesByTxHash{
+				ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+					{Epoch: 42, ScResultsHashes: [][]byte{[]byte("scr1")}},
+				},
+			}, nil
+		case "scr1":
+			return &dblookupext.ResultsHashesByTxHash{
+				ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+					{Epoch: 42, ScResultsHashes: [][]byte{[]byte("scr2"), []byte("a")}},
+				},
+			}, nil
+		}
+		return nil, errors.New("no results")
+	}
+
+	actual, err := n.GetTransaction(hex.EncodeToString([]byte("a")), false)
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, actual.Status)
+	require.Equal(t, 2, len(actual.Hops))
+	assert.Equal(t, hex.EncodeToString([]byte("scr1")), actual.Hops[0].Hash)
+	assert.Equal(t, hex.EncodeToString([]byte("mb-scr1")), actual.Hops[0].MiniBlockHash)
+	assert.Equal(t, uint64(101), actual.Hops[0].NotarizedAtDestinationInMetaNonce)
+	assert.Equal(t, transaction.TxStatusFinalized, actual.Hops[0].Status)
+	assert.Equal(t, hex.EncodeToString([]byte("scr2")), actual.Hops[1].Hash)
+	assert.Equal(t, transaction.TxStatusPending, actual.Hops[1].Status)
+
+	notarizedAtDestination["scr2"] = 102
+
+	actual, err = n.GetTransaction(hex.EncodeToString([]byte("a")), false)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(actual.Hops))
+	assert.Equal(t, transaction.TxStatusFinalized, actual.Hops[1].Status)
+	assert.Equal(t, transaction.TxStatusFinalized, actual.Status)
+}
+
+func TestNode_lookupHistoricalTransactionWithFailedHopShouldNotFinalize(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 42, true)
+
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("contract")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, n.internalMarshalizer)
+	failedScr := &smartContractResult.SmartContractResult{
+		Nonce:         8,
+		Data:          []byte("@" + hex.EncodeToString([]byte("user error")) + "@" + hex.EncodeToString([]byte("a"))),
+		ReturnMessage: []byte("out of funds"),
+	}
+	_ = chainStorer.Unsigned.PutWithMarshalizer([]byte("scr1"), failedScr, n.internalMarshalizer)
+
+	historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+		return &dblookupext.MiniblockMetadata{
+			Type:                              int32(block.TxBlock),
+			SourceShardID:                     1,
+			DestinationShardID:                2,
+			Epoch:                             42,
+			NotarizedAtDestinationInMetaNonce: 100,
+		}, nil
+	}
+	historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+		if string(hash) != "a" {
+			return nil, errors.New("no results")
+		}
+
+		return &dblookupext.ResultsHashesByTxHash{
+			ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+				{Epoch: 42, ScResultsHashes: [][]byte{[]byte("scr1")}},
+			},
+		}, nil
+	}
+
+	actual, err := n.GetTransaction(hex.EncodeToString([]byte("a")), false)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(actual.Hops))
+	assert.Equal(t, transaction.TxStatusFail, actual.Hops[0].Status)
+	assert.Equal(t, transaction.TxStatusFail, actual.Status)
+}
+
+func TestNode_lookupHistoricalTransactionWithTooManyHopsShouldBeTruncated(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 42, true)
+
+	txA := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("contract")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("a"), txA, n.internalMarshalizer)
+
+	scrHashes := make([][]byte, 0, maxTrackedHops+1)
+	for i := 0; i <= maxTrackedHops; i++ {
+		scrHash := []byte(fmt.Sprintf("scr%d", i))
+		scrHashes = append(scrHashes, scrHash)
+		_ = chainStorer.Unsigned.PutWithMarshalizer(scrHash, &smartContractResult.SmartContractResult{}, n.internalMarshalizer)
+	}
+
+	historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+		return &dblookupext.MiniblockMetadata{
+			Type:                              int32(block.TxBlock),
+			Epoch:                             42,
+			NotarizedAtDestinationInMetaNonce: 100,
+		}, nil
+	}
+	historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+		if string(hash) != "a" {
+			return nil, errors.New("no results")
+		}
+
+		return &dblookupext.ResultsHashesByTxHash{
+			ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{
+				{Epoch: 42, ScResultsHashes: scrHashes},
+			},
+		}, nil
+	}
+
+	actual, err := n.GetTransaction(hex.EncodeToString([]byte("a")), false)
+	require.Nil(t, err)
+	assert.True(t, actual.HopsTruncated)
+	assert.Equal(t, maxTrackedHops, len(actual.Hops))
+	assert.Equal(t, transaction.TxStatusSuccess, actual.Status)
+}
+
+func TestNode_lookupHistoricalTransactionWithoutHopsShouldFinalizeOnlyIfNoHopsCanBeGenerated(t *testing.T) {
+	t.Parallel()
+
+	n, chainStorer, _, historyRepo := createNode(t, 42, true)
+
+	scAddress := append(make([]byte, core.NumInitCharactersForScAddress), []byte("contract-address-xxxxxx")...)
+	scCall := &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: scAddress}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("sc call"), scCall, n.internalMarshalizer)
+	txWithData := &transaction.Transaction{Nonce: 8, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Data: []byte("ESDTTransfer@01")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("with data"), txWithData, n.internalMarshalizer)
+	moveBalance := &transaction.Transaction{Nonce: 9, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+	_ = chainStorer.Transactions.PutWithMarshalizer([]byte("move balance"), moveBalance, n.internalMarshalizer)
+
+	historyRepo.GetMiniblockMetadataByTxHashCalled = func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+		return &dblookupext.MiniblockMetadata{
+			Type:                              int32(block.TxBlock),
+			SourceShardID:                     1,
+			DestinationShardID:                2,
+			Epoch:                             42,
+			NotarizedAtDestinationInMetaNonce: 100,
+		}, nil
+	}
+	historyRepo.GetEventsHashesByTxHashCalled = func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+		return nil, errors.New("no results")
+	}
+
+	actual, err := n.GetTransaction(hex.EncodeToString([]byte("sc call")), false)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(actual.Hops))
+	assert.Equal(t, transaction.TxStatusSuccess, actual.Status)
+
+	actual, err = n.GetTransaction(hex.EncodeToString([]byte("with data")), false)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(actual.Hops))
+	assert.Equal(t, transaction.TxStatusSuccess, actual.Status)
+
+	actual, err = n.GetTransaction(hex.EncodeToString([]byte("move balance")), false)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(actual.Hops))
+	assert.Equal(t, transaction.TxStatusFinalized, actual.Status)
+}
@@ -0,0 +1,38 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/api"
+	"github.com/ElrondNetwork/elrond-go/data/trie/analyzer"
+)
+
+// GetTrieAnalysis walks the accounts trie found at the hex encoded root hash, alongside all its data tries, and
+// returns their node statistics and the numTopAccounts accounts holding the biggest data tries
+func (n *Node) GetTrieAnalysis(rootHash string, numTopAccounts uint32) (*api.TrieAnalysis, error) {
+	if check.IfNil(n.accountsAPI) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for root hash: %s", ErrInvalidValue, err.Error())
+	}
+
+	trieAnalyzer, err := analyzer.NewTrieAnalyzer(analyzer.ArgsTrieAnalyzer{
+		Marshalizer:            n.internalMarshalizer,
+		AddressPubkeyConverter: n.addressPubkeyConverter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountsTrie, err := n.accountsAPI.GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return trieAnalyzer.Analyze(accountsTrie, rootHashBytes, numTopAccounts)
+}
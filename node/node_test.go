@@ -25,6 +25,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/api"
 	"github.com/ElrondNetwork/elrond-go/data/batch"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/esdt"
@@ -114,7 +115,7 @@ func TestGetBalance_NoAddrConverterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAccountsAdapter(&mock.AccountsStub{}),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", api.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -127,7 +128,7 @@ func TestGetBalance_NoAccAdapterShouldError(t *testing.T) {
 		node.WithHasher(getHasher()),
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
-	_, err := n.GetBalance("address")
+	_, err := n.GetBalance("address", api.AccountQueryOptions{})
 	assert.NotNil(t, err)
 	assert.Equal(t, "initialize AccountsAdapter and PubkeyConverter first", err.Error())
 }
@@ -147,7 +148,7 @@ func TestGetBalance_GetAccountFailsShouldError(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	_, err := n.GetBalance(createDummyHexAddress(64))
+	_, err := n.GetBalance(createDummyHexAddress(64), api.AccountQueryOptions{})
 	assert.Equal(t, expectedErr, err)
 }
 
@@ -176,7 +177,7 @@ func TestGetBalance_GetAccountReturnsNil(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), api.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(0), balance)
 }
@@ -191,11 +192,82 @@ func TestGetBalance(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 		node.WithAccountsAdapter(accAdapter),
 	)
-	balance, err := n.GetBalance(createDummyHexAddress(64))
+	balance, err := n.GetBalance(createDummyHexAddress(64), api.AccountQueryOptions{})
 	assert.Nil(t, err)
 	assert.Equal(t, big.NewInt(100), balance)
 }
 
+func createNodeForAccountQueryAtBlock(t *testing.T, accountsAPI state.AccountsAdapter, header *block.Header, headerHash []byte) *node.Node {
+	uint64Converter := mock.NewNonceHashConverterMock()
+	storer := mock.NewStorerMock()
+	headerBytes, _ := getMarshalizer().Marshal(header)
+	_ = storer.Put(headerHash, headerBytes)
+	_ = storer.Put(uint64Converter.ToByteSlice(header.Nonce), headerHash)
+
+	n, err := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithVmMarshalizer(getMarshalizer()),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+		node.WithUint64ByteSliceConverter(uint64Converter),
+		node.WithDataStore(&mock.ChainStorerMock{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return storer
+			},
+		}),
+		node.WithAccountsAdapter(&mock.AccountsStub{
+			GetExistingAccountCalled: func(address []byte) (state.AccountHandler, error) {
+				assert.Fail(t, "the processing accounts adapter should not be used")
+				return nil, nil
+			},
+		}),
+		node.WithAccountsAdapterAPI(accountsAPI),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestGetBalance_OnBlockNonceShouldUseHeaderRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	header := &block.Header{Nonce: 7, RootHash: rootHash}
+	recreatedRootHash := make([]byte, 0)
+	accountsAPI := getAccAdapter(big.NewInt(42))
+	accountsAPI.RecreateTrieCalled = func(rootHash []byte) error {
+		recreatedRootHash = rootHash
+		return nil
+	}
+	n := createNodeForAccountQueryAtBlock(t, accountsAPI, header, []byte("header hash"))
+
+	balance, err := n.GetBalance(createDummyHexAddress(64), api.AccountQueryOptions{OnBlockNonce: true, BlockNonce: 7})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(42), balance)
+	assert.Equal(t, rootHash, recreatedRootHash)
+
+	balance, err = n.GetBalance(createDummyHexAddress(64), api.AccountQueryOptions{OnBlockNonce: true, BlockNonce: 8})
+	assert.NotNil(t, err)
+	assert.Nil(t, balance)
+}
+
+func TestGetAccount_OnBlockHashWithPrunedRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	headerHash := []byte("header hash")
+	header := &block.Header{Nonce: 7, RootHash: []byte("root hash")}
+	accountsAPI := getAccAdapter(big.NewInt(42))
+	accountsAPI.RecreateTrieCalled = func(rootHash []byte) error {
+		return errors.New("trie node not found")
+	}
+	n := createNodeForAccountQueryAtBlock(t, accountsAPI, header, headerHash)
+
+	account, err := n.GetAccount(createDummyHexAddress(64), api.AccountQueryOptions{BlockHash: headerHash})
+	assert.True(t, errors.Is(err, node.ErrStateNotAvailableForBlock))
+	assert.Nil(t, account)
+}
+
 func TestGetUsername(t *testing.T) {
 	expectedUsername := []byte("elrond")
 
@@ -228,19 +300,12 @@ func TestNode_GetKeyValuePairs(t *testing.T) {
 	accDB := &mock.AccountsStub{}
 	acc.DataTrieTracker().SetDataTrie(
 		&mock.TrieStub{
-			GetAllLeavesOnChannelCalled: func(rootHash []byte) (chan core.KeyValueHolder, error) {
-				ch := make(chan core.KeyValueHolder)
-
-				go func() {
-					trieLeaf := keyValStorage.NewKeyValStorage(k1, v1)
-					ch <- trieLeaf
-
-					trieLeaf2 := keyValStorage.NewKeyValStorage(k2, v2)
-					ch <- trieLeaf2
-					close(ch)
-				}()
+			IterateLeavesFromCalled: func(rootHash []byte, startKey []byte, handler func(key []byte, value []byte) bool) error {
+				if handler(k1, v1) {
+					handler(k2, v2)
+				}
 
-				return ch, nil
+				return nil
 			},
 		})
 
@@ -264,7 +329,7 @@ func TestNode_GetKeyValuePairs(t *testing.T) {
 		}),
 	)
 
-	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64))
+	pairs, err := n.GetKeyValuePairs(createDummyHexAddress(64), api.AccountQueryOptions{})
 	assert.Nil(t, err)
 	resV1, ok := pairs[hex.EncodeToString(k1)]
 	assert.True(t, ok)
@@ -295,7 +360,7 @@ func TestNode_GetValueForKey(t *testing.T) {
 		node.WithAccountsAdapter(accDB),
 	)
 
-	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1))
+	value, err := n.GetValueForKey(createDummyHexAddress(64), hex.EncodeToString(k1), api.AccountQueryOptions{})
 	assert.NoError(t, err)
 	assert.Equal(t, hex.EncodeToString(v1), value)
 }
@@ -2350,7 +2415,7 @@ func TestNode_GetAccountWithNilAccountsAdapterShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), api.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilAccountsAdapter, err)
@@ -2369,7 +2434,7 @@ func TestNode_GetAccountWithNilPubkeyConverterShouldErr(t *testing.T) {
 		node.WithAccountsAdapter(accDB),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), api.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, node.ErrNilPubkeyConverter, err)
@@ -2395,7 +2460,7 @@ func TestNode_GetAccountPubkeyConverterFailsShouldErr(t *testing.T) {
 			}),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), api.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.Equal(t, errExpected, err)
@@ -2415,7 +2480,7 @@ func TestNode_GetAccountAccountDoesNotExistsShouldRetEmpty(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), api.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, uint64(0), recovAccnt.GetNonce())
@@ -2439,7 +2504,7 @@ func TestNode_GetAccountAccountsAdapterFailsShouldErr(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), api.AccountQueryOptions{})
 
 	assert.Nil(t, recovAccnt)
 	assert.NotNil(t, err)
@@ -2466,7 +2531,7 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
 	)
 
-	recovAccnt, err := n.GetAccount(createDummyHexAddress(64))
+	recovAccnt, err := n.GetAccount(createDummyHexAddress(64), api.AccountQueryOptions{})
 
 	assert.Nil(t, err)
 	assert.Equal(t, accnt, recovAccnt)
@@ -2997,6 +3062,7 @@ func TestNode_ValidateTransactionForSimulation_CheckSignatureFalse(t *testing.T)
 func TestNode_StartHeartbeat(t *testing.T) {
 	t.Parallel()
 
+	// the heartbeat sender go routine can fire after the test ended, while other tests are running
 	hbConfig := config.HeartbeatConfig{
 		MinTimeToWaitBetweenBroadcastsInSec: 2,
 		MaxTimeToWaitBetweenBroadcastsInSec: 3,
@@ -3013,14 +3079,24 @@ func TestNode_StartHeartbeat(t *testing.T) {
 
 	n, _ := node.NewNode(
 		node.WithInternalMarshalizer(&mock.MarshalizerMock{}, 100),
-		node.WithMessenger(&mock.MessengerStub{}),
+		node.WithMessenger(&mock.MessengerStub{
+			BroadcastCalled: func(_ string, _ []byte) {},
+		}),
 		node.WithShardCoordinator(&mock.ShardCoordinatorMock{}),
 		node.WithNodesCoordinator(&mock.NodesCoordinatorMock{}),
 		node.WithAppStatusHandler(&mock.AppStatusHandlerStub{}),
 		node.WithDataStore(&mock.ChainStorerMock{}),
 		node.WithValidatorStatistics(&mock.ValidatorStatisticsProcessorMock{}),
 		node.WithPeerSignatureHandler(&mock.PeerSignatureHandler{}),
-		node.WithPrivKey(&mock.PrivateKeyStub{}),
+		node.WithPrivKey(&mock.PrivateKeyStub{
+			GeneratePublicHandler: func() crypto.PublicKey {
+				return &mock.PublicKeyMock{
+					ToByteArrayHandler: func() ([]byte, error) {
+						return []byte("pubKey"), nil
+					},
+				}
+			},
+		}),
 		node.WithHardforkTrigger(&mock.HardforkTriggerStub{}),
 		node.WithInputAntifloodHandler(&mock.P2PAntifloodHandlerStub{}),
 		node.WithValidatorPubkeyConverter(&mock.PubkeyConverterMock{}),
@@ -3060,7 +3136,7 @@ func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 		}),
 	)
 
-	res, err := n.GetKeyValuePairs("addr")
+	res, err := n.GetKeyValuePairs("addr", api.AccountQueryOptions{})
 	require.Nil(t, res)
 	require.True(t, strings.Contains(fmt.Sprintf("%v", err), expectedErr.Error()))
 }
@@ -3085,7 +3161,7 @@ func TestGetKeyValuePairs_NilCurrentBlockHeader(t *testing.T) {
 		}),
 	)
 
-	res, err := n.GetKeyValuePairs("addr")
+	res, err := n.GetKeyValuePairs("addr", api.AccountQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, node.ErrAccountNotFound, err)
 }
@@ -3115,7 +3191,7 @@ func TestGetKeyValuePairs_CannotRecreateTree(t *testing.T) {
 		}),
 	)
 
-	res, err := n.GetKeyValuePairs("addr")
+	res, err := n.GetKeyValuePairs("addr", api.AccountQueryOptions{})
 	require.Nil(t, res)
 	require.Equal(t, expectedErr, err)
 }
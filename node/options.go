@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -764,6 +765,17 @@ func WithHistoryRepository(historyRepo dblookupext.HistoryRepository) Option {
 	}
 }
 
+// WithEventsHub sets up the events hub used by the web socket events subscriptions
+func WithEventsHub(eventsHub subscriptions.EventsHub) Option {
+	return func(n *Node) error {
+		if check.IfNil(eventsHub) {
+			return ErrNilEventsHub
+		}
+		n.eventsHub = eventsHub
+		return nil
+	}
+}
+
 // WithEnableSignTxWithHashEpoch sets up enableSignTxWithHashEpoch for the node
 func WithEnableSignTxWithHashEpoch(enableSignTxWithHashEpoch uint32) Option {
 	return func(n *Node) error {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/core/subscriptions"
 	"github.com/ElrondNetwork/elrond-go/core/versioning"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
@@ -1559,3 +1560,27 @@ func TestWithNodeRedundancyHandler_OkNodeRedundancyHandlerShouldWork(t *testing.
 	assert.Equal(t, nodeRedundancyHandler, node.nodeRedundancyHandler)
 	assert.Nil(t, err)
 }
+
+func TestWithEventsHub_NilEventsHubShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEventsHub(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilEventsHub, err)
+}
+
+func TestWithEventsHub_OkEventsHubShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	eventsHub := subscriptions.NewDisabledEventsHub()
+	opt := WithEventsHub(eventsHub)
+	err := opt(node)
+
+	assert.Equal(t, eventsHub, node.eventsHub)
+	assert.Nil(t, err)
+}
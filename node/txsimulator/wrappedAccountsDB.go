@@ -110,6 +110,16 @@ func (w *readOnlyAccountsDB) RecreateAllTries(_ []byte, _ context.Context) (map[
 	return nil, nil
 }
 
+// GetTrie will call the original accounts' function with the same name
+func (w *readOnlyAccountsDB) GetTrie(rootHash []byte) (data.Trie, error) {
+	return w.originalAccounts.GetTrie(rootHash)
+}
+
+// GetStateDiff will call the original accounts' function with the same name
+func (w *readOnlyAccountsDB) GetStateDiff(fromRootHash []byte, toRootHash []byte, maxLeaves int, handler func(state.AccountDiff) bool) (bool, error) {
+	return w.originalAccounts.GetStateDiff(fromRootHash, toRootHash, maxLeaves, handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (w *readOnlyAccountsDB) IsInterfaceNil() bool {
 	return w == nil
@@ -43,6 +43,8 @@ type ArgBaseProcessor struct {
 	HistoryRepository       dblookupext.HistoryRepository
 	EpochNotifier           process.EpochNotifier
 	HeaderIntegrityVerifier process.HeaderIntegrityVerifier
+	CommittedBlockNotifier  process.CommittedBlockNotifier
+	StorageGroupCommitter   dataRetriever.StorageGroupCommitter
 }
 
 // ArgShardProcessor holds all dependencies required by the process data factory in order to create
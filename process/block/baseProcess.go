@@ -75,10 +75,12 @@ type baseProcessor struct {
 	blockProcessor         blockProcessor
 	txCounter              *transactionCounter
 
-	indexer       process.Indexer
-	tpsBenchmark  statistics.TPSBenchmark
-	historyRepo   dblookupext.HistoryRepository
-	epochNotifier process.EpochNotifier
+	indexer                process.Indexer
+	tpsBenchmark           statistics.TPSBenchmark
+	historyRepo            dblookupext.HistoryRepository
+	epochNotifier          process.EpochNotifier
+	committedBlockNotifier process.CommittedBlockNotifier
+	storageGroupCommitter  dataRetriever.StorageGroupCommitter
 }
 
 type bootStorerDataArgs struct {
@@ -426,6 +428,12 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.EpochNotifier) {
 		return process.ErrNilEpochNotifier
 	}
+	if check.IfNil(arguments.CommittedBlockNotifier) {
+		return process.ErrNilCommittedBlockNotifier
+	}
+	if check.IfNil(arguments.StorageGroupCommitter) {
+		return process.ErrNilStorageGroupCommitter
+	}
 
 	return nil
 }
@@ -822,6 +830,23 @@ func (bp *baseProcessor) prepareDataForBootStorer(args bootStorerDataArgs) {
 	}
 }
 
+// commitStorageGroup persists the data saved for the committed block, including its bootstrap data, as one crash
+// consistent group. It is called right after the bootstrap data was saved and before the block data is read back
+// from the storage units, as the data of the group can not be read until the group is committed
+func (bp *baseProcessor) commitStorageGroup(epoch uint32) {
+	startTime := time.Now()
+
+	err := bp.storageGroupCommitter.Commit(epoch)
+	if err != nil {
+		log.Warn("cannot commit the storage group", "error", err.Error())
+	}
+
+	elapsedTime := time.Since(startTime)
+	if elapsedTime >= core.PutInStorerMaxTime {
+		log.Warn("commitStorageGroup", "elapsed time", elapsedTime)
+	}
+}
+
 func (bp *baseProcessor) getLastCrossNotarizedHeaders() []bootstrapStorage.BootstrapHeaderInfo {
 	lastCrossNotarizedHeaders := make([]bootstrapStorage.BootstrapHeaderInfo, 0, bp.shardCoordinator.NumberOfShards()+1)
 
@@ -991,7 +1016,7 @@ func (bp *baseProcessor) saveBody(body *block.Body, header data.HeaderHandler) {
 		}
 
 		miniBlockHash := bp.hasher.Compute(string(marshalizedMiniBlock))
-		errNotCritical = bp.store.Put(dataRetriever.MiniBlockUnit, miniBlockHash, marshalizedMiniBlock)
+		errNotCritical = bp.storageGroupCommitter.Put(dataRetriever.MiniBlockUnit, miniBlockHash, marshalizedMiniBlock)
 		if errNotCritical != nil {
 			log.Warn("saveBody.Put -> MiniBlockUnit", "error", errNotCritical.Error())
 		}
@@ -1003,7 +1028,7 @@ func (bp *baseProcessor) saveBody(body *block.Body, header data.HeaderHandler) {
 		log.Warn("saveBody.CreateMarshalizedReceipts", "error", errNotCritical.Error())
 	} else {
 		if len(marshalizedReceipts) > 0 {
-			errNotCritical = bp.store.Put(dataRetriever.ReceiptsUnit, header.GetReceiptsHash(), marshalizedReceipts)
+			errNotCritical = bp.storageGroupCommitter.Put(dataRetriever.ReceiptsUnit, header.GetReceiptsHash(), marshalizedReceipts)
 			if errNotCritical != nil {
 				log.Warn("saveBody.Put -> ReceiptsUnit", "error", errNotCritical.Error())
 			}
@@ -1022,14 +1047,14 @@ func (bp *baseProcessor) saveShardHeader(header data.HeaderHandler, headerHash [
 	nonceToByteSlice := bp.uint64Converter.ToByteSlice(header.GetNonce())
 	hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(header.GetShardID())
 
-	errNotCritical := bp.store.Put(hdrNonceHashDataUnit, nonceToByteSlice, headerHash)
+	errNotCritical := bp.storageGroupCommitter.Put(hdrNonceHashDataUnit, nonceToByteSlice, headerHash)
 	if errNotCritical != nil {
 		log.Warn(fmt.Sprintf("saveHeader.Put -> ShardHdrNonceHashDataUnit_%d", header.GetShardID()),
 			"error", errNotCritical.Error(),
 		)
 	}
 
-	errNotCritical = bp.store.Put(dataRetriever.BlockHeaderUnit, headerHash, marshalizedHeader)
+	errNotCritical = bp.storageGroupCommitter.Put(dataRetriever.BlockHeaderUnit, headerHash, marshalizedHeader)
 	if errNotCritical != nil {
 		log.Warn("saveHeader.Put -> BlockHeaderUnit", "error", errNotCritical.Error())
 	}
@@ -1045,12 +1070,12 @@ func (bp *baseProcessor) saveMetaHeader(header data.HeaderHandler, headerHash []
 
 	nonceToByteSlice := bp.uint64Converter.ToByteSlice(header.GetNonce())
 
-	errNotCritical := bp.store.Put(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice, headerHash)
+	errNotCritical := bp.storageGroupCommitter.Put(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice, headerHash)
 	if errNotCritical != nil {
 		log.Warn("saveMetaHeader.Put -> MetaHdrNonceHashDataUnit", "error", errNotCritical.Error())
 	}
 
-	errNotCritical = bp.store.Put(dataRetriever.MetaBlockUnit, headerHash, marshalizedHeader)
+	errNotCritical = bp.storageGroupCommitter.Put(dataRetriever.MetaBlockUnit, headerHash, marshalizedHeader)
 	if errNotCritical != nil {
 		log.Warn("saveMetaHeader.Put -> MetaBlockUnit", "error", errNotCritical.Error())
 	}
@@ -1230,16 +1255,50 @@ func (bp *baseProcessor) requestMiniBlocksIfNeeded(headerHandler data.HeaderHand
 	bp.txCoordinator.RequestMiniBlocks(headerHandler)
 }
 
+func (bp *baseProcessor) notifyCommittedBlock(
+	headerHash []byte,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+	highestFinalBlockNonce uint64,
+) {
+	if !bp.committedBlockNotifier.HasSubscribers() {
+		return
+	}
+
+	transactions := bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	for _, blockType := range []block.Type{block.SmartContractResultBlock, block.RewardsBlock, block.InvalidBlock} {
+		for hash, tx := range bp.txCoordinator.GetAllCurrentUsedTxs(blockType) {
+			transactions[hash] = tx
+		}
+	}
+
+	bp.committedBlockNotifier.NotifyCommittedBlock(headerHash, header, body, transactions, highestFinalBlockNonce)
+}
+
 func (bp *baseProcessor) recordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
 	scrResultsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.SmartContractResultBlock)
 	receiptsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.ReceiptBlock)
+	transactionsFromPool := bp.txCoordinator.GetAllCurrentUsedTxs(block.TxBlock)
+	for hash, rewardTx := range bp.txCoordinator.GetAllCurrentUsedTxs(block.RewardsBlock) {
+		transactionsFromPool[hash] = rewardTx
+	}
+	for hash, invalidTx := range bp.txCoordinator.GetAllCurrentUsedTxs(block.InvalidBlock) {
+		transactionsFromPool[hash] = invalidTx
+	}
 
-	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool)
+	err := bp.historyRepo.RecordBlock(blockHeaderHash, blockHeader, blockBody, scrResultsFromPool, receiptsFromPool, transactionsFromPool)
 	if err != nil {
 		log.Error("historyRepo.RecordBlock()", "blockHeaderHash", blockHeaderHash, "error", err.Error())
 	}
 }
 
+func (bp *baseProcessor) revertBlockInHistory(blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	err := bp.historyRepo.RevertBlock(blockHeader, blockBody)
+	if err != nil {
+		log.Debug("historyRepo.RevertBlock()", "nonce", blockHeader.GetNonce(), "error", err.Error())
+	}
+}
+
 func (bp *baseProcessor) addHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	headersPool := bp.dataPool.Headers()
 	headers, hashes, err := headersPool.GetHeadersByNonceAndShardId(nonce, shardID)
@@ -29,6 +29,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func haveTime() time.Duration {
@@ -316,6 +317,8 @@ func CreateMockArguments() blproc.ArgShardProcessor {
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			CommittedBlockNotifier:  &testscommon.CommittedBlockNotifierStub{},
+			StorageGroupCommitter:   &testscommon.StorageGroupCommitterStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 	}
@@ -1205,3 +1208,58 @@ func TestAddHeaderIntoTrackerPool_ShouldWork(t *testing.T) {
 	sp.AddHeaderIntoTrackerPool(nonce, shardID)
 	assert.True(t, wasCalled)
 }
+
+func TestBaseProcessor_NotifyCommittedBlockWithoutSubscribersShouldNotGatherTransactions(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(_ block.Type) map[string]data.TransactionHandler {
+			assert.Fail(t, "should not have gathered the transactions")
+			return nil
+		},
+	}
+	arguments.CommittedBlockNotifier = &testscommon.CommittedBlockNotifierStub{
+		HasSubscribersCalled: func() bool {
+			return false
+		},
+		NotifyCommittedBlockCalled: func(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _ map[string]data.TransactionHandler, _ uint64) {
+			assert.Fail(t, "should not have notified the committed block")
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	sp.NotifyCommittedBlock([]byte("hash"), &block.Header{Nonce: 1}, &block.Body{}, 0)
+}
+
+func TestBaseProcessor_RecordBlockInHistoryShouldIncludeTheInvalidTransactions(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.TxCoordinator = &mock.TransactionCoordinatorMock{
+		GetAllCurrentUsedTxsCalled: func(blockType block.Type) map[string]data.TransactionHandler {
+			switch blockType {
+			case block.TxBlock:
+				return map[string]data.TransactionHandler{"tx": &transaction.Transaction{Nonce: 1}}
+			case block.InvalidBlock:
+				return map[string]data.TransactionHandler{"invalidTx": &transaction.Transaction{Nonce: 2}}
+			default:
+				return make(map[string]data.TransactionHandler)
+			}
+		},
+	}
+	var recordedTxs map[string]data.TransactionHandler
+	arguments.HistoryRepository = &testscommon.HistoryRepositoryStub{
+		RecordBlockCalled: func(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _ map[string]data.TransactionHandler, _ map[string]data.TransactionHandler, txs map[string]data.TransactionHandler) error {
+			recordedTxs = txs
+			return nil
+		},
+	}
+	sp, _ := blproc.NewShardProcessor(arguments)
+
+	sp.RecordBlockInHistory([]byte("hash"), &block.Header{Nonce: 1}, &block.Body{})
+
+	require.Equal(t, 2, len(recordedTxs))
+	assert.Equal(t, uint64(1), recordedTxs["tx"].GetNonce())
+	assert.Equal(t, uint64(2), recordedTxs["invalidTx"].GetNonce())
+}
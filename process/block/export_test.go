@@ -107,6 +107,8 @@ func NewShardProcessorEmptyWith3shards(
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			CommittedBlockNotifier:  &testscommon.CommittedBlockNotifierStub{},
+			StorageGroupCommitter:   &testscommon.StorageGroupCommitterStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 	}
@@ -369,3 +371,11 @@ func (mp *metaProcessor) RequestShardHeadersIfNeeded(hdrsAddedForShard map[uint3
 func (bp *baseProcessor) AddHeaderIntoTrackerPool(nonce uint64, shardID uint32) {
 	bp.addHeaderIntoTrackerPool(nonce, shardID)
 }
+
+func (bp *baseProcessor) NotifyCommittedBlock(headerHash []byte, header data.HeaderHandler, body data.BodyHandler, highestFinalBlockNonce uint64) {
+	bp.notifyCommittedBlock(headerHash, header, body, highestFinalBlockNonce)
+}
+
+func (bp *baseProcessor) RecordBlockInHistory(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler) {
+	bp.recordBlockInHistory(blockHeaderHash, blockHeader, blockBody)
+}
@@ -110,6 +110,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		headerIntegrityVerifier: arguments.HeaderIntegrityVerifier,
 		historyRepo:             arguments.HistoryRepository,
 		epochNotifier:           arguments.EpochNotifier,
+		committedBlockNotifier:  arguments.CommittedBlockNotifier,
+		storageGroupCommitter:   arguments.StorageGroupCommitter,
 	}
 
 	mp := metaProcessor{
@@ -666,6 +668,7 @@ func (mp *metaProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler,
 	}
 
 	mp.restoreBlockBody(bodyHandler)
+	mp.revertBlockInHistory(headerHandler, bodyHandler)
 
 	mp.blockTracker.RemoveLastNotarizedHeaders()
 
@@ -1093,6 +1096,7 @@ func (mp *metaProcessor) CommitBlock(
 	var err error
 	defer func() {
 		if err != nil {
+			mp.storageGroupCommitter.Discard()
 			mp.RevertAccountState(headerHandler)
 		}
 	}()
@@ -1203,9 +1207,6 @@ func (mp *metaProcessor) CommitBlock(
 
 	mp.tpsBenchmark.Update(lastMetaBlock)
 
-	mp.indexBlock(header, headerHash, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
-	mp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
-
 	highestFinalBlockNonce := mp.forkDetector.GetHighestFinalBlockNonce()
 	saveMetricsForCommitMetachainBlock(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator, highestFinalBlockNonce)
 
@@ -1245,6 +1246,11 @@ func (mp *metaProcessor) CommitBlock(
 	}
 
 	mp.prepareDataForBootStorer(args)
+	mp.commitStorageGroup(header.GetEpoch())
+
+	mp.indexBlock(header, headerHash, body, lastMetaBlock, notarizedHeadersHashes, rewardsTxs)
+	mp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
+	mp.notifyCommittedBlock(headerHash, headerHandler, bodyHandler, highestFinalBlockNonce)
 
 	mp.blockSizeThrottler.Succeed(header.Round)
 
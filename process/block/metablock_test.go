@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
 	"github.com/ElrondNetwork/elrond-go/process"
 	blproc "github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
@@ -79,6 +80,8 @@ func createMockMetaArguments() blproc.ArgMetaProcessor {
 			TpsBenchmark:            &testscommon.TpsBenchmarkMock{},
 			HeaderIntegrityVerifier: &mock.HeaderIntegrityVerifierStub{},
 			HistoryRepository:       &testscommon.HistoryRepositoryStub{},
+			CommittedBlockNotifier:  &testscommon.CommittedBlockNotifierStub{},
+			StorageGroupCommitter:   &testscommon.StorageGroupCommitterStub{},
 			EpochNotifier:           &mock.EpochNotifierStub{},
 		},
 		SCToProtocol:                 &mock.SCToProtocolStub{},
@@ -673,6 +676,7 @@ func TestMetaProcessor_CommitBlockStorageFailsForHeaderShouldErr(t *testing.T) {
 	arguments.AccountsDB[state.UserAccountsState] = accounts
 	arguments.AccountsDB[state.PeerAccountsState] = accounts
 	arguments.Store = store
+	arguments.StorageGroupCommitter, _ = storageGroup.NewDirectStorageGroupCommitter(store)
 	arguments.ForkDetector = &mock.ForkDetectorMock{
 		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
 			return nil
@@ -81,6 +81,8 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		headerIntegrityVerifier: arguments.HeaderIntegrityVerifier,
 		historyRepo:             arguments.HistoryRepository,
 		epochNotifier:           arguments.EpochNotifier,
+		committedBlockNotifier:  arguments.CommittedBlockNotifier,
+		storageGroupCommitter:   arguments.StorageGroupCommitter,
 	}
 
 	sp := shardProcessor{
@@ -617,6 +619,7 @@ func (sp *shardProcessor) RestoreBlockIntoPools(headerHandler data.HeaderHandler
 	}
 
 	sp.restoreBlockBody(bodyHandler)
+	sp.revertBlockInHistory(headerHandler, bodyHandler)
 
 	sp.blockTracker.RemoveLastNotarizedHeaders()
 
@@ -751,6 +754,7 @@ func (sp *shardProcessor) CommitBlock(
 	var err error
 	defer func() {
 		if err != nil {
+			sp.storageGroupCommitter.Discard()
 			sp.RevertAccountState(headerHandler)
 		}
 	}()
@@ -872,8 +876,6 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.blockChain.SetCurrentBlockHeaderHash(headerHash)
-	sp.indexBlockIfNeeded(bodyHandler, headerHash, headerHandler, lastBlockHeader)
-	sp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
 
 	lastCrossNotarizedHeader, _, err := sp.blockTracker.GetLastCrossNotarizedHeader(core.MetachainShardId)
 	if err != nil {
@@ -910,6 +912,11 @@ func (sp *shardProcessor) CommitBlock(
 	}
 
 	sp.prepareDataForBootStorer(args)
+	sp.commitStorageGroup(header.GetEpoch())
+
+	sp.indexBlockIfNeeded(bodyHandler, headerHash, headerHandler, lastBlockHeader)
+	sp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
+	sp.notifyCommittedBlock(headerHash, headerHandler, bodyHandler, highestFinalBlockNonce)
 
 	// write data to log
 	go sp.txCounter.displayLogInfo(
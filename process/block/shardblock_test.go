@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"reflect"
 	"sync"
@@ -22,10 +23,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/storageGroup"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	blproc "github.com/ElrondNetwork/elrond-go/process/block"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/coordinator"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -226,6 +229,28 @@ func TestNewShardProcessor_NilTxCoordinatorShouldErr(t *testing.T) {
 	assert.Nil(t, sp)
 }
 
+func TestNewShardProcessor_NilCommittedBlockNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.CommittedBlockNotifier = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilCommittedBlockNotifier, err)
+	assert.Nil(t, sp)
+}
+
+func TestNewShardProcessor_NilStorageGroupCommitterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := CreateMockArguments()
+	arguments.StorageGroupCommitter = nil
+	sp, err := blproc.NewShardProcessor(arguments)
+
+	assert.Equal(t, process.ErrNilStorageGroupCommitter, err)
+	assert.Nil(t, sp)
+}
+
 func TestNewShardProcessor_NilUint64ConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1569,6 +1594,7 @@ func TestShardProcessor_CommitBlockStorageFailsForHeaderShouldErr(t *testing.T)
 	arguments := CreateMockArgumentsMultiShard()
 	arguments.DataPool = tdp
 	arguments.Store = store
+	arguments.StorageGroupCommitter, _ = storageGroup.NewDirectStorageGroupCommitter(store)
 	arguments.AccountsDB[state.UserAccountsState] = accounts
 	arguments.ForkDetector = &mock.ForkDetectorMock{
 		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
@@ -1645,6 +1671,7 @@ func TestShardProcessor_CommitBlockStorageFailsForBodyShouldWork(t *testing.T) {
 	arguments := CreateMockArgumentsMultiShard()
 	arguments.DataPool = tdp
 	arguments.Store = store
+	arguments.StorageGroupCommitter, _ = storageGroup.NewDirectStorageGroupCommitter(store)
 	arguments.AccountsDB[state.UserAccountsState] = accounts
 	arguments.ForkDetector = &mock.ForkDetectorMock{
 		AddHeaderCalled: func(header data.HeaderHandler, hash []byte, state process.BlockHeaderState, selfNotarizedHeaders []data.HeaderHandler, selfNotarizedHeadersHashes [][]byte) error {
@@ -1751,12 +1778,32 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	}
 	store := initStore()
 
+	groupedUnits := make(map[dataRetriever.UnitType]int)
+	committedEpoch := uint32(math.MaxUint32)
+	bootDataSavedBeforeCommit := false
+
 	arguments := CreateMockArgumentsMultiShard()
 	arguments.DataPool = tdp
 	arguments.Store = store
 	arguments.Hasher = hasher
 	arguments.AccountsDB[state.UserAccountsState] = accounts
 	arguments.ForkDetector = fd
+	arguments.StorageGroupCommitter = &testscommon.StorageGroupCommitterStub{
+		PutCalled: func(unitType dataRetriever.UnitType, _ []byte, _ []byte) error {
+			groupedUnits[unitType]++
+			return nil
+		},
+		CommitCalled: func(epoch uint32) error {
+			committedEpoch = epoch
+			return nil
+		},
+	}
+	arguments.BootStorer = &mock.BoostrapStorerMock{
+		PutCalled: func(_ int64, _ bootstrapStorage.BootstrapData) error {
+			bootDataSavedBeforeCommit = committedEpoch == math.MaxUint32
+			return nil
+		},
+	}
 	blockTrackerMock := mock.NewBlockTrackerMock(mock.NewOneShardCoordinatorMock(), createGenesisBlocks(mock.NewOneShardCoordinatorMock()))
 	blockTrackerMock.GetCrossNotarizedHeaderCalled = func(shardID uint32, offset uint64) (data.HeaderHandler, []byte, error) {
 		return &block.MetaBlock{}, []byte("hash"), nil
@@ -1778,6 +1825,11 @@ func TestShardProcessor_CommitBlockOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, forkDetectorAddCalled)
 	assert.Equal(t, hdrHash, blkc.GetCurrentBlockHeaderHash())
+	assert.Equal(t, 1, groupedUnits[dataRetriever.BlockHeaderUnit])
+	assert.Equal(t, 1, groupedUnits[dataRetriever.ShardHdrNonceHashDataUnit])
+	assert.Equal(t, 1, groupedUnits[dataRetriever.MiniBlockUnit])
+	assert.Equal(t, hdr.Epoch, committedEpoch)
+	assert.True(t, bootDataSavedBeforeCommit)
 	//this should sleep as there is an async call to display current hdr and block in CommitBlock
 	time.Sleep(time.Second)
 }
@@ -1862,6 +1914,17 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 		},
 	}
 
+	var notifiedTransactions map[string]data.TransactionHandler
+	var notifiedHeaderHash []byte
+	arguments.CommittedBlockNotifier = &testscommon.CommittedBlockNotifierStub{
+		HasSubscribersCalled: func() bool {
+			return true
+		},
+		NotifyCommittedBlockCalled: func(headerHash []byte, _ data.HeaderHandler, _ data.BodyHandler, transactions map[string]data.TransactionHandler, _ uint64) {
+			notifiedHeaderHash = headerHash
+			notifiedTransactions = transactions
+		},
+	}
 	arguments.DataPool = tdp
 	arguments.Store = store
 	arguments.Hasher = hasher
@@ -1910,6 +1973,8 @@ func TestShardProcessor_CommitBlockCallsIndexerMethods(t *testing.T) {
 
 	assert.Equal(t, 2, len(txsPool.Txs))
 	assert.Equal(t, 2, len(txsPool.Scrs))
+	assert.Equal(t, hdrHash, notifiedHeaderHash)
+	assert.Equal(t, 4, len(notifiedTransactions))
 }
 
 func TestShardProcessor_CreateTxBlockBodyWithDirtyAccStateShouldReturnEmptyBody(t *testing.T) {
@@ -2187,6 +2252,26 @@ func TestShardProcessor_CommitBlockShouldRevertAccountStateWhenErr(t *testing.T)
 	assert.Equal(t, 0, journalEntries)
 }
 
+func TestShardProcessor_CommitBlockShouldDiscardTheStorageGroupWhenErr(t *testing.T) {
+	t.Parallel()
+
+	discardCalled := false
+	arguments := CreateMockArgumentsMultiShard()
+	arguments.StorageGroupCommitter = &testscommon.StorageGroupCommitterStub{
+		CommitCalled: func(_ uint32) error {
+			assert.Fail(t, "should not have committed the storage group")
+			return nil
+		},
+		DiscardCalled: func() {
+			discardCalled = true
+		},
+	}
+	bp, _ := blproc.NewShardProcessor(arguments)
+	err := bp.CommitBlock(nil, nil)
+	assert.NotNil(t, err)
+	assert.True(t, discardCalled)
+}
+
 func TestShardProcessor_MarshalizedDataToBroadcastShouldWork(t *testing.T) {
 	t.Parallel()
 	tdp := initDataPool([]byte("tx_hash1"))
@@ -3195,6 +3280,7 @@ func TestShardProcessor_RemoveAndSaveLastNotarizedMetaHdrNoDstMB(t *testing.T) {
 	arguments := CreateMockArgumentsMultiShard()
 	arguments.DataPool = datapool
 	arguments.Store = store
+	arguments.StorageGroupCommitter, _ = storageGroup.NewDirectStorageGroupCommitter(store)
 	arguments.Hasher = hasher
 	arguments.Marshalizer = marshalizer
 	arguments.ShardCoordinator = mock.NewMultiShardsCoordinatorMock(shardNr)
@@ -3350,6 +3436,7 @@ func TestShardProcessor_RemoveAndSaveLastNotarizedMetaHdrNotAllMBFinished(t *tes
 	arguments := CreateMockArgumentsMultiShard()
 	arguments.DataPool = datapool
 	arguments.Store = store
+	arguments.StorageGroupCommitter, _ = storageGroup.NewDirectStorageGroupCommitter(store)
 	arguments.Hasher = hasher
 	arguments.Marshalizer = marshalizer
 	arguments.ShardCoordinator = mock.NewMultiShardsCoordinatorMock(shardNr)
@@ -3483,6 +3570,7 @@ func TestShardProcessor_RemoveAndSaveLastNotarizedMetaHdrAllMBFinished(t *testin
 	arguments := CreateMockArgumentsMultiShard()
 	arguments.DataPool = datapool
 	arguments.Store = store
+	arguments.StorageGroupCommitter, _ = storageGroup.NewDirectStorageGroupCommitter(store)
 	arguments.Hasher = hasher
 	arguments.Marshalizer = marshalizer
 	arguments.ShardCoordinator = mock.NewMultiShardsCoordinatorMock(shardNr)
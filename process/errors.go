@@ -946,3 +946,9 @@ var ErrMaxAccumulatedFeesExceeded = errors.New("max accumulated fees has been ex
 
 // ErrMaxDeveloperFeesExceeded signals that max developer fees has been exceeded
 var ErrMaxDeveloperFeesExceeded = errors.New("max developer fees has been exceeded")
+
+// ErrNilCommittedBlockNotifier signals that a nil committed block notifier has been provided
+var ErrNilCommittedBlockNotifier = errors.New("nil committed block notifier")
+
+// ErrNilStorageGroupCommitter signals that a nil storage group committer has been provided
+var ErrNilStorageGroupCommitter = errors.New("nil storage group committer")
@@ -19,6 +19,10 @@ const (
 	AccountTrieNodesTopic = "accountTrieNodes"
 	// ValidatorTrieNodesTopic is used for sharding validator state trie nodes
 	ValidatorTrieNodesTopic = "validatorTrieNodes"
+	// AccountStateChunksTopic is used for sharing chunks of state trie leaves, served from the trie snapshots
+	AccountStateChunksTopic = "accountStateChunks"
+	// ValidatorStateChunksTopic is used for sharing chunks of validator state trie leaves, served from the trie snapshots
+	ValidatorStateChunksTopic = "validatorStateChunks"
 )
 
 // SystemVirtualMachine is a byte array identifier for the smart contract address created for system VM
@@ -492,6 +492,35 @@ func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topi
 	return bicf.createTopicAndAssignHandler(topic, interceptor, true)
 }
 
+func (bicf *baseInterceptorsContainerFactory) createOneStateChunksInterceptor(topic string) (process.Interceptor, error) {
+	stateChunksProcessor, err := processor.NewTrieNodesInterceptorProcessor(bicf.dataPool.TrieNodes())
+	if err != nil {
+		return nil, err
+	}
+
+	stateChunksFactory, err := interceptorFactory.NewInterceptedStateChunkDataFactory(bicf.argInterceptorFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	interceptor, err := interceptors.NewSingleDataInterceptor(
+		interceptors.ArgSingleDataInterceptor{
+			Topic:            topic,
+			DataFactory:      stateChunksFactory,
+			Processor:        stateChunksProcessor,
+			Throttler:        bicf.globalThrottler,
+			AntifloodHandler: bicf.antifloodHandler,
+			WhiteListRequest: bicf.whiteListHandler,
+			CurrentPeerId:    bicf.messenger.ID(),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return bicf.createTopicAndAssignHandler(topic, interceptor, true)
+}
+
 func (bicf *baseInterceptorsContainerFactory) generateUnsignedTxsInterceptors() error {
 	shardC := bicf.shardCoordinator
 
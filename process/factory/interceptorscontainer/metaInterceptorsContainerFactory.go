@@ -188,7 +188,7 @@ func (micf *metaInterceptorsContainerFactory) Create() (process.InterceptorsCont
 	return micf.container, nil
 }
 
-// AddShardTrieNodeInterceptors will add the shard trie node interceptors into the existing container
+// AddShardTrieNodeInterceptors will add the shard trie node and state chunk interceptors into the existing container
 func (micf *metaInterceptorsContainerFactory) AddShardTrieNodeInterceptors(container process.InterceptorsContainer) error {
 	if check.IfNil(container) {
 		return process.ErrNilInterceptorContainer
@@ -208,6 +208,15 @@ func (micf *metaInterceptorsContainerFactory) AddShardTrieNodeInterceptors(conta
 
 		keys = append(keys, identifierTrieNodes)
 		trieInterceptors = append(trieInterceptors, interceptor)
+
+		identifierStateChunks := factory.AccountStateChunksTopic + shardC.CommunicationIdentifier(i)
+		interceptor, err = micf.createOneStateChunksInterceptor(identifierStateChunks)
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, identifierStateChunks)
+		trieInterceptors = append(trieInterceptors, interceptor)
 	}
 
 	return container.AddMultiple(keys, trieInterceptors)
@@ -299,6 +308,24 @@ func (micf *metaInterceptorsContainerFactory) generateTrieNodesInterceptors() er
 	keys = append(keys, identifierTrieNodes)
 	trieInterceptors = append(trieInterceptors, interceptor)
 
+	identifierStateChunks := factory.ValidatorStateChunksTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	interceptor, err = micf.createOneStateChunksInterceptor(identifierStateChunks)
+	if err != nil {
+		return err
+	}
+
+	keys = append(keys, identifierStateChunks)
+	trieInterceptors = append(trieInterceptors, interceptor)
+
+	identifierStateChunks = factory.AccountStateChunksTopic + core.CommunicationIdentifierBetweenShards(core.MetachainShardId, core.MetachainShardId)
+	interceptor, err = micf.createOneStateChunksInterceptor(identifierStateChunks)
+	if err != nil {
+		return err
+	}
+
+	keys = append(keys, identifierStateChunks)
+	trieInterceptors = append(trieInterceptors, interceptor)
+
 	return micf.container.AddMultiple(keys, trieInterceptors)
 }
 
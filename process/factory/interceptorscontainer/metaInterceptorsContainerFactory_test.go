@@ -485,16 +485,17 @@ func TestMetaInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numInterceptorsUnsignedTxsForMetachain := noOfShards
 	numInterceptorsRewardsTxsForMetachain := noOfShards
 	numInterceptorsTrieNodes := 2
+	numInterceptorsStateChunks := 2
 	totalInterceptors := numInterceptorsMetablock + numInterceptorsShardHeadersForMetachain + numInterceptorsTrieNodes +
 		numInterceptorsTransactionsForMetachain + numInterceptorsUnsignedTxsForMetachain + numInterceptorsMiniBlocksForMetachain +
-		numInterceptorsRewardsTxsForMetachain
+		numInterceptorsRewardsTxsForMetachain + numInterceptorsStateChunks
 
 	assert.Nil(t, err)
 	assert.Equal(t, totalInterceptors, container.Len())
 
 	err = icf.AddShardTrieNodeInterceptors(container)
 	assert.Nil(t, err)
-	assert.Equal(t, totalInterceptors+noOfShards, container.Len())
+	assert.Equal(t, totalInterceptors+2*noOfShards, container.Len())
 }
 
 func getArgumentsMeta() interceptorscontainer.MetaInterceptorsContainerFactoryArgs {
@@ -224,6 +224,15 @@ func (sicf *shardInterceptorsContainerFactory) generateTrieNodesInterceptors() e
 	keys = append(keys, identifierTrieNodes)
 	interceptorsSlice = append(interceptorsSlice, interceptor)
 
+	identifierStateChunks := factory.AccountStateChunksTopic + shardC.CommunicationIdentifier(core.MetachainShardId)
+	interceptor, err = sicf.createOneStateChunksInterceptor(identifierStateChunks)
+	if err != nil {
+		return err
+	}
+
+	keys = append(keys, identifierStateChunks)
+	interceptorsSlice = append(interceptorsSlice, interceptor)
+
 	return sicf.container.AddMultiple(keys, interceptorsSlice)
 }
 
@@ -540,8 +540,10 @@ func TestShardInterceptorsContainerFactory_With4ShardsShouldWork(t *testing.T) {
 	numInterceptorMiniBlocks := noOfShards + 2
 	numInterceptorMetachainHeaders := 1
 	numInterceptorTrieNodes := 1
+	numInterceptorStateChunks := 1
 	totalInterceptors := numInterceptorTxs + numInterceptorsUnsignedTxs + numInterceptorsRewardTxs +
-		numInterceptorHeaders + numInterceptorMiniBlocks + numInterceptorMetachainHeaders + numInterceptorTrieNodes
+		numInterceptorHeaders + numInterceptorMiniBlocks + numInterceptorMetachainHeaders + numInterceptorTrieNodes +
+		numInterceptorStateChunks
 
 	assert.Nil(t, err)
 	assert.Equal(t, totalInterceptors, container.Len())
@@ -0,0 +1,47 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.InterceptedDataFactory = (*interceptedStateChunkDataFactory)(nil)
+
+type interceptedStateChunkDataFactory struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// NewInterceptedStateChunkDataFactory creates an instance of interceptedStateChunkDataFactory
+func NewInterceptedStateChunkDataFactory(
+	argument *ArgInterceptedDataFactory,
+) (*interceptedStateChunkDataFactory, error) {
+
+	if argument == nil {
+		return nil, process.ErrNilArgumentStruct
+	}
+	if check.IfNil(argument.ProtoMarshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(argument.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+
+	return &interceptedStateChunkDataFactory{
+		marshalizer: argument.ProtoMarshalizer,
+		hasher:      argument.Hasher,
+	}, nil
+}
+
+// Create creates instances of InterceptedData by unmarshalling provided buffer
+func (sidf *interceptedStateChunkDataFactory) Create(buff []byte) (process.InterceptedData, error) {
+	return trie.NewInterceptedStateChunk(buff, sidf.marshalizer, sidf.hasher)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sidf *interceptedStateChunkDataFactory) IsInterfaceNil() bool {
+	return sidf == nil
+}
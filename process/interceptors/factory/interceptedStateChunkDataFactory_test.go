@@ -0,0 +1,48 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInterceptedStateChunkDataFactory_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	iscf, err := NewInterceptedStateChunkDataFactory(nil)
+
+	assert.Nil(t, iscf)
+	assert.Equal(t, process.ErrNilArgumentStruct, err)
+}
+
+func TestNewInterceptedStateChunkDataFactory_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.ProtoMarshalizer = nil
+
+	iscf, err := NewInterceptedStateChunkDataFactory(arg)
+	assert.Nil(t, iscf)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewInterceptedStateChunkDataFactory_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.Hasher = nil
+
+	iscf, err := NewInterceptedStateChunkDataFactory(arg)
+	assert.Nil(t, iscf)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewInterceptedStateChunkDataFactory_OkValsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	iscf, err := NewInterceptedStateChunkDataFactory(createMockArgument())
+	assert.NotNil(t, iscf)
+	assert.Nil(t, err)
+	assert.False(t, iscf.IsInterfaceNil())
+}
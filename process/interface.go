@@ -491,6 +491,7 @@ type RequestHandler interface {
 	RequestMiniBlock(destShardID uint32, miniblockHash []byte)
 	RequestMiniBlocks(destShardID uint32, miniblocksHashes [][]byte)
 	RequestTrieNodes(destShardID uint32, hashes [][]byte, topic string)
+	RequestStateChunk(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string)
 	RequestStartOfEpochMetaBlock(epoch uint32)
 	RequestInterval() time.Duration
 	SetNumPeersToQuery(key string, intra int, cross int) error
@@ -1040,3 +1041,17 @@ type Indexer interface {
 	IsInterfaceNil() bool
 	IsNilIndexer() bool
 }
+
+// CommittedBlockNotifier defines a component that is notified each time a block is committed, alongside the
+// transactions used by that block and the highest final block nonce at that moment
+type CommittedBlockNotifier interface {
+	HasSubscribers() bool
+	NotifyCommittedBlock(
+		headerHash []byte,
+		header data.HeaderHandler,
+		body data.BodyHandler,
+		transactions map[string]data.TransactionHandler,
+		highestFinalBlockNonce uint64,
+	)
+	IsInterfaceNil() bool
+}
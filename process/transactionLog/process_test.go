@@ -162,3 +162,22 @@ func TestTxLogProcessor_GetLogUnmarshalErr(t *testing.T) {
 
 	require.Equal(t, retErr, err)
 }
+
+func TestTxLogProcessor_GetLogShouldReturnTheSavedLog(t *testing.T) {
+	txLogProcessor, _ := transactionLog.NewTxLogProcessor(transactionLog.ArgTxLogProcessor{
+		Storer:      mock.NewStorerMock(),
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+
+	logs := []*vmcommon.LogEntry{
+		{Address: []byte("contract"), Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+	}
+	err := txLogProcessor.SaveLog([]byte("txhash"), &transaction.Transaction{RcvAddr: []byte("receiver")}, logs)
+	require.Nil(t, err)
+
+	txLog, err := txLogProcessor.GetLog([]byte("txhash"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("receiver"), txLog.GetAddress())
+	require.Equal(t, 1, len(txLog.GetLogEvents()))
+	require.Equal(t, []byte("transfer"), txLog.GetLogEvents()[0].GetIdentifier())
+}
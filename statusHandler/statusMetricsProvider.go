@@ -95,12 +95,13 @@ func (sm *statusMetrics) SetStringValue(key string, value string) {
 func (sm *statusMetrics) Close() {
 }
 
-// StatusMetricsMapWithoutP2P will return the non-p2p metrics in a map
+// StatusMetricsMapWithoutP2P will return the non-p2p metrics in a map. The labeled metrics, such as the
+// per storer ones, are only published in the prometheus format
 func (sm *statusMetrics) StatusMetricsMapWithoutP2P() map[string]interface{} {
 	statusMetricsMap := make(map[string]interface{})
 	sm.nodeMetrics.Range(func(key, value interface{}) bool {
 		keyString := key.(string)
-		if strings.Contains(keyString, "_p2p_") {
+		if strings.Contains(keyString, "_p2p_") || isLabeledMetric(keyString) {
 			return true
 		}
 
@@ -130,20 +131,41 @@ func (sm *statusMetrics) StatusP2pMetricsMap() map[string]interface{} {
 // StatusMetricsWithoutP2PPrometheusString returns the metrics in a string format which respects prometheus style
 func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() string {
 	shardID := sm.loadUint64Metric(core.MetricShardId)
-	metrics := sm.StatusMetricsMapWithoutP2P()
 	stringBuilder := strings.Builder{}
-	for key, value := range metrics {
+	sm.nodeMetrics.Range(func(key, value interface{}) bool {
+		keyString := key.(string)
+		if strings.Contains(keyString, "_p2p_") {
+			return true
+		}
+
 		_, isUint64 := value.(uint64)
 		_, isInt64 := value.(int64)
 		isNumericValue := isUint64 || isInt64
 		if isNumericValue {
-			stringBuilder.WriteString(fmt.Sprintf("%s{%s=\"%d\"} %v\n", key, core.MetricShardId, shardID, value))
+			stringBuilder.WriteString(fmt.Sprintf("%s %v\n", prometheusMetricName(keyString, shardID), value))
 		}
-	}
+
+		return true
+	})
 
 	return stringBuilder.String()
 }
 
+func isLabeledMetric(key string) bool {
+	return strings.Contains(key, "{") && strings.HasSuffix(key, "}")
+}
+
+// prometheusMetricName adds the shard label to the metric key. The keys that already contain labels,
+// such as erd_storer_gets{unit="TxStorage"}, will have the shard label appended to their own labels
+func prometheusMetricName(key string, shardID uint64) string {
+	shardLabel := fmt.Sprintf("%s=\"%d\"", core.MetricShardId, shardID)
+	if isLabeledMetric(key) {
+		return fmt.Sprintf("%s,%s}", key[:len(key)-1], shardLabel)
+	}
+
+	return fmt.Sprintf("%s{%s}", key, shardLabel)
+}
+
 // EconomicsMetrics returns the economics related metrics
 func (sm *statusMetrics) EconomicsMetrics() map[string]interface{} {
 	economicsMetrics := make(map[string]interface{})
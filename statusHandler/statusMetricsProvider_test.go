@@ -144,6 +144,36 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
 
+func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldAppendShardIDLabelToExistingLabels(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	key1, value1 := "test-key9{unit=\"TxStorage\"}", uint64(100)
+	sm.SetUInt64Value(key1, value1)
+	sm.SetUInt64Value(core.MetricShardId, 2)
+
+	strRes := sm.StatusMetricsWithoutP2PPrometheusString()
+
+	expectedMetricOutput := fmt.Sprintf("test-key9{unit=\"TxStorage\",%s=\"%d\"} %v", core.MetricShardId, 2, value1)
+	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
+}
+
+func TestStatusMetrics_StatusMetricsMapWithoutP2PShouldNotContainLabeledMetrics(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	labeledKey := "test-key10{unit=\"TxStorage\"}"
+	sm.SetUInt64Value(labeledKey, 100)
+	sm.SetUInt64Value("test-key11", 200)
+
+	metricsMap := sm.StatusMetricsMapWithoutP2P()
+
+	_, found := metricsMap[labeledKey]
+	assert.False(t, found)
+	assert.Equal(t, uint64(200), metricsMap["test-key11"])
+	assert.True(t, strings.Contains(sm.StatusMetricsWithoutP2PPrometheusString(), "test-key10{unit=\"TxStorage\""))
+}
+
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	t.Parallel()
 
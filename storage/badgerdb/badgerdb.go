@@ -0,0 +1,329 @@
+package badgerdb
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v3"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+var log = logger.GetOrCreate("storage/badgerdb")
+
+// DB holds a pointer to the badger database and the path to where it is stored.
+// Badger is an embedded LSM key-value store written in pure Go which keeps the large values
+// separated from the keys, lowering the write amplification caused by compactions
+type DB struct {
+	db                *badger.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	dbClosed          chan struct{}
+}
+
+// NewDB is a constructor for the badger persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (s *DB, err error) {
+	err = os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := openBadgerDB(path, createOptions(path))
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		batch:             NewBatch(),
+		dbClosed:          make(chan struct{}),
+	}
+
+	go dbStore.batchTimeoutHandle()
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle() {
+	for {
+		select {
+		case <-time.After(time.Duration(s.batchDelaySeconds) * time.Second):
+			s.mutBatch.Lock()
+			err := s.putBatch(s.batch)
+			if err != nil {
+				log.Warn("badgerdb putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-s.dbClosed:
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("badgerdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	data, removed, found := s.batch.lookup(key)
+	if found {
+		if removed {
+			return nil, storage.ErrKeyNotFound
+		}
+		return data, nil
+	}
+
+	if s.db.IsClosed() {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		data, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	_, removed, found := s.batch.lookup(key)
+	if found {
+		if removed {
+			return storage.ErrKeyNotFound
+		}
+		return nil
+	}
+
+	if s.db.IsClosed() {
+		return storage.ErrDBIsClosed
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// Init initializes the storage medium and prepares it for usage
+func (s *DB) Init() error {
+	// no special initialization needed
+	return nil
+}
+
+// putBatch writes the Batch data into the database
+func (s *DB) putBatch(b *batch) error {
+	if b.isEmpty() {
+		return nil
+	}
+	if s.db.IsClosed() {
+		return storage.ErrDBIsClosed
+	}
+
+	writeBatch := s.db.NewWriteBatch()
+	err := b.writeTo(writeBatch)
+	if err != nil {
+		writeBatch.Cancel()
+		return err
+	}
+
+	return writeBatch.Flush()
+}
+
+// RangeKeys will call the handler function for each (key, value) pair, in the keys order
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	s.rangeKeysInInterval(nil, nil, false, handler)
+}
+
+// RangeKeysWithPrefix will call the handler function for each (key, value) pair whose key starts with the prefix,
+// in the keys order or in the reversed keys order. If the handler returns true, the iteration will continue,
+// otherwise will stop
+func (s *DB) RangeKeysWithPrefix(prefix []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	s.rangeKeysInInterval(prefix, storage.PrefixUpperBound(prefix), reverse, handler)
+}
+
+// RangeKeysFrom will call the handler function for each (key, value) pair whose key is in the [start, end)
+// interval, in the keys order or in the reversed keys order. A nil start or end means that the interval is not
+// bounded on that side. If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeysFrom(start []byte, end []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	s.rangeKeysInInterval(start, end, reverse, handler)
+}
+
+func (s *DB) rangeKeysInInterval(start []byte, end []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+	if s.db.IsClosed() {
+		return
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		options := badger.DefaultIteratorOptions
+		options.Reverse = reverse
+		iterator := txn.NewIterator(options)
+		defer iterator.Close()
+
+		// in reverse mode, seek finds the largest key lower or equal to the provided one
+		seekKey := start
+		if reverse {
+			seekKey = end
+		}
+		if seekKey != nil {
+			iterator.Seek(seekKey)
+		} else {
+			iterator.Rewind()
+		}
+
+		for ; iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			clonedKey := item.KeyCopy(nil)
+			if !storage.IsKeyInRange(clonedKey, start, end) {
+				if reverse && end != nil && bytes.Equal(clonedKey, end) {
+					continue
+				}
+				return nil
+			}
+
+			clonedVal, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			shouldContinue := handler(clonedKey, clonedVal)
+			if !shouldContinue {
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Warn("badgerdb RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	select {
+	case s.dbClosed <- struct{}{}:
+	default:
+	}
+
+	return s.db.Close()
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	_ = s.batch.Delete(key)
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	select {
+	case s.dbClosed <- struct{}{}:
+	default:
+	}
+
+	err := s.db.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
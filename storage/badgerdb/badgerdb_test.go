@@ -0,0 +1,482 @@
+package badgerdb_test
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBadgerDb(t *testing.T, batchDelaySeconds int, maxBatchSize int) (p *badgerdb.DB) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb, err := badgerdb.NewDB(dir, batchDelaySeconds, maxBatchSize)
+
+	assert.Nil(t, err, "Failed creating badgerdb database file")
+	return bdb
+}
+
+func TestDB_InitNoError(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Init()
+
+	assert.Nil(t, err, "error initializing DB")
+}
+
+func TestDB_CorruptdeDBShouldRecover(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	db, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	key := []byte("key")
+	val := []byte("val")
+	err = db.Put(key, val)
+	require.Nil(t, err)
+	_ = db.Close()
+
+	// simulate a MANIFEST change that was only partially written when the node stopped
+	manifest, err := os.OpenFile(path.Join(dir, "MANIFEST"), os.O_APPEND|os.O_WRONLY, 0)
+	require.Nil(t, err)
+	_, err = manifest.Write([]byte{0, 0, 0, 200, 1, 2, 3})
+	require.Nil(t, err)
+	_ = manifest.Close()
+
+	dbRecovered, err := badgerdb.NewDB(dir, 10, 1)
+	if err != nil {
+		assert.Fail(t, fmt.Sprintf("should have not errored %s", err.Error()))
+		return
+	}
+
+	valRecovered, err := dbRecovered.Get(key)
+	assert.Nil(t, err)
+	_ = dbRecovered.Close()
+
+	assert.Equal(t, val, valRecovered)
+}
+
+func TestDB_DoubleOpenShouldError(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb1, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	_, err = badgerdb.NewDB(dir, 10, 1)
+	assert.NotNil(t, err)
+}
+
+func TestDB_DoubleOpenButClosedInTimeShouldWork(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	bdb1, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+		_ = os.RemoveAll(dir)
+	}()
+
+	go func() {
+		time.Sleep(time.Second * 3)
+		_ = bdb1.Close()
+	}()
+
+	bdb2, err := badgerdb.NewDB(dir, 10, 1)
+	assert.Nil(t, err)
+	assert.NotNil(t, bdb2)
+
+	_ = bdb2.Close()
+}
+
+func TestDB_PutNoError(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Put(key, val)
+
+	assert.Nil(t, err, "error saving in DB")
+}
+
+func TestDB_GetErrorAfterPutBeforeTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	v, err := bdb.Get(key)
+	assert.Equal(t, val, v)
+	assert.Nil(t, err)
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 3)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_GetErrorOnFail(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 100)
+	_ = bdb.Close()
+
+	v, err := bdb.Get([]byte("key"))
+	assert.Nil(t, v)
+	assert.NotNil(t, err)
+}
+
+func TestDB_RemoveBeforeTimeoutOK(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	_ = bdb.Remove(key)
+	time.Sleep(time.Second * 2)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_RemoveAfterTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 2)
+
+	_ = bdb.Remove(key)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_GetPresent(t *testing.T) {
+	key, val := []byte("key1"), []byte("value1")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Put(key, val)
+
+	assert.Nil(t, err, "error saving in DB")
+
+	v, err := bdb.Get(key)
+
+	assert.Nil(t, err, "error not expected, but got %s", err)
+	assert.Equalf(t, v, val, "read:%s but expected: %s", v, val)
+}
+
+func TestDB_GetNotPresent(t *testing.T) {
+	key := []byte("key2")
+	bdb := createBadgerDb(t, 10, 1)
+
+	v, err := bdb.Get(key)
+
+	assert.NotNil(t, err, "error expected but got nil, value %s", v)
+}
+
+func TestDB_HasPresent(t *testing.T) {
+	key, val := []byte("key3"), []byte("value3")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Put(key, val)
+
+	assert.Nil(t, err, "error saving in DB")
+
+	err = bdb.Has(key)
+
+	assert.Nil(t, err)
+}
+
+func TestDB_HasNotPresent(t *testing.T) {
+	key := []byte("key4")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Has(key)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, err, storage.ErrKeyNotFound)
+}
+
+func TestDB_RemovePresent(t *testing.T) {
+	key, val := []byte("key5"), []byte("value5")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Put(key, val)
+
+	assert.Nil(t, err, "error saving in DB")
+
+	err = bdb.Remove(key)
+
+	assert.Nil(t, err, "no error expected but got %s", err)
+
+	err = bdb.Has(key)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, err, storage.ErrKeyNotFound)
+}
+
+func TestDB_RemoveNotPresent(t *testing.T) {
+	key := []byte("key6")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Remove(key)
+
+	assert.Nil(t, err, "no error expected but got %s", err)
+}
+
+func TestDB_Close(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Close()
+
+	assert.Nil(t, err, "no error expected but got %s", err)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Destroy()
+
+	assert.Nil(t, err, "no error expected but got %s", err)
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+		"key5": []byte("value5"),
+		"key6": []byte("value6"),
+		"key7": []byte("value7"),
+	}
+
+	for key, val := range keysVals {
+		_ = bdb.Put([]byte(key), val)
+	}
+
+	time.Sleep(time.Second * 2)
+
+	recovered := make(map[string][]byte)
+
+	handler := func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	}
+
+	bdb.RangeKeys(handler)
+
+	assert.Equal(t, keysVals, recovered)
+}
+
+func TestDB_PutGetLargeValue(t *testing.T) {
+	t.Parallel()
+
+	buffLargeValue := make([]byte, 32*1000000) //equivalent to ~1000000 hashes
+	key := []byte("key")
+	_, _ = rand.Read(buffLargeValue)
+
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	err := bdb.Put(key, buffLargeValue)
+	assert.Nil(t, err)
+
+	time.Sleep(time.Second * 2)
+
+	recovered, err := bdb.Get(key)
+	assert.Nil(t, err)
+
+	assert.Equal(t, buffLargeValue, recovered)
+}
+
+func TestDB_RangeKeysShouldIterateInOrderAndStopWhenRequested(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	for _, key := range []string{"key3", "key1", "key4", "key2"} {
+		_ = bdb.Put([]byte(key), []byte("value"))
+	}
+
+	recovered := make([]string, 0)
+	handler := func(key []byte, val []byte) bool {
+		recovered = append(recovered, string(key))
+		return len(recovered) < 3
+	}
+
+	bdb.RangeKeys(handler)
+
+	assert.Equal(t, []string{"key1", "key2", "key3"}, recovered)
+}
+
+func TestDB_RemovedKeyShouldNotBeFoundAfterReopen(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "badgerdb_temp")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	bdb, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+
+	key := []byte("key")
+	_ = bdb.Put(key, []byte("value"))
+	_ = bdb.Put([]byte("other key"), []byte("other value"))
+	_ = bdb.Remove(key)
+	_ = bdb.Close()
+
+	bdbReopened, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = bdbReopened.Close()
+	}()
+
+	assert.Equal(t, storage.ErrKeyNotFound, bdbReopened.Has(key))
+	val, err := bdbReopened.Get([]byte("other key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("other value"), val)
+}
+
+func TestDB_HasAfterCloseShouldError(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 100)
+	_ = bdb.Close()
+
+	err := bdb.Has([]byte("key"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+}
+
+func TestDB_RangeKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	testRangeKeysWithPrefix(t, bdb)
+}
+
+func TestDB_RangeKeysFrom(t *testing.T) {
+	t.Parallel()
+
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Destroy()
+	}()
+
+	testRangeKeysFrom(t, bdb)
+}
+
+func putRangeTestData(t *testing.T, persister storage.Persister) {
+	keys := [][]byte{[]byte("a1"), []byte("a2"), []byte("a3"), []byte("b1"), []byte("b2")}
+	for nonce := uint64(1); nonce <= 10; nonce++ {
+		keys = append(keys, nonceKey(nonce))
+	}
+
+	for _, key := range keys {
+		require.Nil(t, persister.Put(key, append([]byte("val-"), key...)))
+	}
+}
+
+func nonceKey(nonce uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, nonce)
+
+	return key
+}
+
+func collectRangedKeys(t *testing.T, rangeFunc func(handler func(key []byte, val []byte) bool), maxKeys int) [][]byte {
+	keys := make([][]byte, 0)
+	rangeFunc(func(key []byte, val []byte) bool {
+		assert.Equal(t, append([]byte("val-"), key...), val)
+		keys = append(keys, key)
+		return len(keys) < maxKeys
+	})
+
+	return keys
+}
+
+func testRangeKeysWithPrefix(t *testing.T, persister storage.Persister) {
+	putRangeTestData(t, persister)
+
+	keys := collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysWithPrefix([]byte("a"), false, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{[]byte("a1"), []byte("a2"), []byte("a3")}, keys)
+
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysWithPrefix([]byte("b"), true, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{[]byte("b2"), []byte("b1")}, keys)
+
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysWithPrefix([]byte("c"), false, handler)
+	}, 100)
+	assert.Empty(t, keys)
+}
+
+func testRangeKeysFrom(t *testing.T, persister storage.Persister) {
+	putRangeTestData(t, persister)
+
+	keys := collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysFrom(nonceKey(3), nonceKey(7), false, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{nonceKey(3), nonceKey(4), nonceKey(5), nonceKey(6)}, keys)
+
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysFrom(nonceKey(3), nonceKey(7), true, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{nonceKey(6), nonceKey(5), nonceKey(4), nonceKey(3)}, keys)
+
+	// unbounded end, stopped by the handler
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysFrom(nonceKey(9), nil, false, handler)
+	}, 3)
+	assert.Equal(t, [][]byte{nonceKey(9), nonceKey(10), []byte("a1")}, keys)
+
+	// unbounded start, in reverse order
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysFrom(nil, nonceKey(3), true, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{nonceKey(2), nonceKey(1)}, keys)
+
+	assert.NotPanics(t, func() {
+		persister.RangeKeysFrom(nil, nil, false, nil)
+		persister.RangeKeysWithPrefix(nil, false, nil)
+	})
+}
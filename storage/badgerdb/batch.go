@@ -0,0 +1,110 @@
+package badgerdb
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v3"
+)
+
+var _ storage.Batcher = (*batch)(nil)
+
+type batchEntry struct {
+	value   []byte
+	removed bool
+}
+
+type batch struct {
+	entries  map[string]*batchEntry
+	mutBatch sync.RWMutex
+}
+
+// NewBatch creates a batch
+func NewBatch() *batch {
+	return &batch{
+		entries: make(map[string]*batchEntry),
+	}
+}
+
+// Put inserts one entry - key, value pair - into the batch
+func (b *batch) Put(key []byte, val []byte) error {
+	b.mutBatch.Lock()
+	b.entries[string(key)] = &batchEntry{value: val}
+	b.mutBatch.Unlock()
+
+	return nil
+}
+
+// Delete marks the entry for the provided key as removed
+func (b *batch) Delete(key []byte) error {
+	b.mutBatch.Lock()
+	b.entries[string(key)] = &batchEntry{removed: true}
+	b.mutBatch.Unlock()
+
+	return nil
+}
+
+// Reset clears the contents of the batch
+func (b *batch) Reset() {
+	b.mutBatch.Lock()
+	b.entries = make(map[string]*batchEntry)
+	b.mutBatch.Unlock()
+}
+
+// Get returns the value. Returns nil if the key is not in the batch or if it was marked as removed
+func (b *batch) Get(key []byte) []byte {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	entry, ok := b.entries[string(key)]
+	if !ok || entry.removed {
+		return nil
+	}
+
+	return entry.value
+}
+
+// lookup returns the value of the key, whether the key was marked as removed and whether the key is in the batch
+func (b *batch) lookup(key []byte) (value []byte, removed bool, found bool) {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	entry, ok := b.entries[string(key)]
+	if !ok {
+		return nil, false, false
+	}
+
+	return entry.value, entry.removed, true
+}
+
+// writeTo adds all the entries of the batch to the provided badger write batch
+func (b *batch) writeTo(writeBatch *badger.WriteBatch) error {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	for key, entry := range b.entries {
+		var err error
+		if entry.removed {
+			err = writeBatch.Delete([]byte(key))
+		} else {
+			err = writeBatch.Set([]byte(key), entry.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *batch) isEmpty() bool {
+	b.mutBatch.RLock()
+	defer b.mutBatch.RUnlock()
+
+	return len(b.entries) == 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (b *batch) IsInterfaceNil() bool {
+	return b == nil
+}
@@ -0,0 +1,87 @@
+package badgerdb
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/badger/v3/options"
+)
+
+const resourceUnavailable = "resource temporarily unavailable"
+const maxRetries = 10
+const timeBetweenRetries = time.Second
+
+const memTableSize = 16 * 1024 * 1024
+const numMemTables = 2
+const numCompactors = 2
+const valueLogFileSize = 256 * 1024 * 1024
+
+func createOptions(path string) badger.Options {
+	return badger.DefaultOptions(path).
+		WithLogger(&badgerLogger{}).
+		WithSyncWrites(true).
+		WithMetricsEnabled(false).
+		WithMemTableSize(memTableSize).
+		WithNumMemtables(numMemTables).
+		WithNumCompactors(numCompactors).
+		WithValueLogFileSize(valueLogFileSize).
+		// disable internal cache, the storer units have their own caches. Compression requires the block cache
+		WithCompression(options.None).
+		WithBlockCacheSize(0).
+		WithIndexCacheSize(0)
+}
+
+func openBadgerDB(path string, options badger.Options) (*badger.DB, error) {
+	retries := 0
+	for {
+		db, err := badger.Open(options)
+		if err == nil {
+			return db, nil
+		}
+		if !strings.Contains(err.Error(), resourceUnavailable) {
+			return nil, err
+		}
+
+		log.Debug("error opening DB",
+			"error", err,
+			"path", path,
+			"retry", retries,
+		)
+
+		time.Sleep(timeBetweenRetries)
+		retries++
+		if retries > maxRetries {
+			return nil, fmt.Errorf("%w, retried %d number of times", err, maxRetries)
+		}
+	}
+}
+
+// badgerLogger redirects the badger internal logs to the node's logger
+type badgerLogger struct {
+}
+
+// Errorf logs the provided message on the error level
+func (bl *badgerLogger) Errorf(format string, args ...interface{}) {
+	log.Error(formatBadgerMessage(format, args...))
+}
+
+// Warningf logs the provided message on the warn level
+func (bl *badgerLogger) Warningf(format string, args ...interface{}) {
+	log.Warn(formatBadgerMessage(format, args...))
+}
+
+// Infof logs the provided message on the debug level as badger is quite verbose on the info level
+func (bl *badgerLogger) Infof(format string, args ...interface{}) {
+	log.Debug(formatBadgerMessage(format, args...))
+}
+
+// Debugf logs the provided message on the trace level
+func (bl *badgerLogger) Debugf(format string, args ...interface{}) {
+	log.Trace(formatBadgerMessage(format, args...))
+}
+
+func formatBadgerMessage(format string, args ...interface{}) string {
+	return strings.TrimSpace(fmt.Sprintf(format, args...))
+}
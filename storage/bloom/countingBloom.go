@@ -0,0 +1,163 @@
+package bloom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.CountingBloomFilter = (*CountingBloom)(nil)
+
+const (
+	countingBloomVersion = byte(1)
+	fingerprintLength    = 8
+)
+
+// CountingBloom is a bloom filter variant which holds a small counter for each position, instead of a single bit.
+// Adding a value increments the counters of its positions and removing it decrements them, so values can be deleted
+// from the filter. A counter that reached its maximum value is never decremented, as it is not known how many
+// values were added on that position. This only increases the false positive rate and never hides a present value
+type CountingBloom struct {
+	counters []uint8
+	hashFunc []hashing.Hasher
+	mutex    sync.RWMutex
+}
+
+// NewCountingFilter returns a new CountingBloom object with the given number of counters and hashing functions.
+// Each counter uses one byte of memory
+func NewCountingFilter(size uint, h []hashing.Hasher) (*CountingBloom, error) {
+	if size <= uint(len(h)) {
+		return nil, errors.New("filter size is too low")
+	}
+	if len(h) == 0 {
+		return nil, errors.New("too few hashing functions")
+	}
+
+	return &CountingBloom{
+		counters: make([]uint8, size),
+		hashFunc: h,
+	}, nil
+}
+
+// Add increments the counters that correspond to the hashes of the data
+func (cb *CountingBloom) Add(data []byte) {
+	indexes := cb.getCountersIndexes(data)
+
+	cb.mutex.Lock()
+	for _, idx := range indexes {
+		if cb.counters[idx] < math.MaxUint8 {
+			cb.counters[idx]++
+		}
+	}
+	cb.mutex.Unlock()
+}
+
+// Remove decrements the counters that correspond to the hashes of the data. It should be called only for
+// values that were previously added, otherwise the filter might report other added values as missing
+func (cb *CountingBloom) Remove(data []byte) {
+	indexes := cb.getCountersIndexes(data)
+
+	cb.mutex.Lock()
+	for _, idx := range indexes {
+		if cb.counters[idx] > 0 && cb.counters[idx] < math.MaxUint8 {
+			cb.counters[idx]--
+		}
+	}
+	cb.mutex.Unlock()
+}
+
+// MayContain checks if the counters that correspond to the hashes of the data are set.
+// If all the counters are set, it returns true, otherwise it returns false
+func (cb *CountingBloom) MayContain(data []byte) bool {
+	indexes := cb.getCountersIndexes(data)
+
+	cb.mutex.RLock()
+	defer cb.mutex.RUnlock()
+
+	for _, idx := range indexes {
+		if cb.counters[idx] == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Clear resets the counters of the filter
+func (cb *CountingBloom) Clear() {
+	cb.mutex.Lock()
+	for i := range cb.counters {
+		cb.counters[i] = 0
+	}
+	cb.mutex.Unlock()
+}
+
+// MarshalBinary serializes the filter. The hashing functions are identified by a fingerprint, so that the data
+// will not be loaded in a filter created with other hashing functions
+func (cb *CountingBloom) MarshalBinary() ([]byte, error) {
+	cb.mutex.RLock()
+	defer cb.mutex.RUnlock()
+
+	buff := bytes.NewBuffer(make([]byte, 0, 1+8+len(cb.hashFunc)*fingerprintLength+len(cb.counters)))
+	buff.WriteByte(countingBloomVersion)
+	_ = binary.Write(buff, binary.BigEndian, uint64(len(cb.counters)))
+	buff.Write(cb.hashFunctionsFingerprint())
+	buff.Write(cb.counters)
+
+	return buff.Bytes(), nil
+}
+
+// UnmarshalBinary loads the counters from the provided data, if the data was produced by a filter with the same
+// size and hashing functions
+func (cb *CountingBloom) UnmarshalBinary(data []byte) error {
+	fingerprint := cb.hashFunctionsFingerprint()
+	headerLength := 1 + 8 + len(fingerprint)
+	if len(data) != headerLength+len(cb.counters) {
+		return storage.ErrInvalidBloomFilterData
+	}
+	if data[0] != countingBloomVersion {
+		return storage.ErrInvalidBloomFilterData
+	}
+	if binary.BigEndian.Uint64(data[1:9]) != uint64(len(cb.counters)) {
+		return storage.ErrInvalidBloomFilterData
+	}
+	if !bytes.Equal(data[9:headerLength], fingerprint) {
+		return storage.ErrInvalidBloomFilterData
+	}
+
+	cb.mutex.Lock()
+	copy(cb.counters, data[headerLength:])
+	cb.mutex.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cb *CountingBloom) IsInterfaceNil() bool {
+	return cb == nil
+}
+
+func (cb *CountingBloom) hashFunctionsFingerprint() []byte {
+	fingerprint := make([]byte, 0, len(cb.hashFunc)*fingerprintLength)
+	for _, h := range cb.hashFunc {
+		hash := h.Compute("")
+		fingerprint = append(fingerprint, hash[:fingerprintLength]...)
+	}
+
+	return fingerprint
+}
+
+func (cb *CountingBloom) getCountersIndexes(data []byte) []uint64 {
+	indexes := make([]uint64, len(cb.hashFunc))
+	for i, h := range cb.hashFunc {
+		hash := h.Compute(string(data))
+		indexes[i] = binary.BigEndian.Uint64(hash) % uint64(len(cb.counters))
+	}
+
+	return indexes
+}
@@ -0,0 +1,148 @@
+package bloom_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
+	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCountingFilter(t *testing.T, size uint) *bloom.CountingBloom {
+	cb, err := bloom.NewCountingFilter(size, []hashing.Hasher{keccak.Keccak{}, &blake2b.Blake2b{}, fnv.Fnv{}})
+	require.Nil(t, err)
+
+	return cb
+}
+
+func TestNewCountingFilter_WrongArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cb, err := bloom.NewCountingFilter(1, []hashing.Hasher{keccak.Keccak{}, &blake2b.Blake2b{}})
+	assert.Nil(t, cb)
+	assert.NotNil(t, err)
+
+	cb, err = bloom.NewCountingFilter(2048, []hashing.Hasher{})
+	assert.Nil(t, cb)
+	assert.NotNil(t, err)
+}
+
+func TestCountingBloom_AddRemoveShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cb := createCountingFilter(t, 2048)
+	for i := 0; i < 100; i++ {
+		cb.Add([]byte(fmt.Sprintf("key%d", i)))
+	}
+
+	for i := 0; i < 50; i++ {
+		cb.Remove([]byte(fmt.Sprintf("key%d", i)))
+	}
+
+	for i := 50; i < 100; i++ {
+		assert.True(t, cb.MayContain([]byte(fmt.Sprintf("key%d", i))))
+	}
+	numFalsePositives := 0
+	for i := 0; i < 50; i++ {
+		if cb.MayContain([]byte(fmt.Sprintf("key%d", i))) {
+			numFalsePositives++
+		}
+	}
+	assert.True(t, numFalsePositives < 5, "too many false positives: %d", numFalsePositives)
+
+	cb.Clear()
+	for i := 50; i < 100; i++ {
+		assert.False(t, cb.MayContain([]byte(fmt.Sprintf("key%d", i))))
+	}
+}
+
+func TestCountingBloom_ValueAddedTwiceShouldRemainAfterOneRemove(t *testing.T) {
+	t.Parallel()
+
+	cb := createCountingFilter(t, 2048)
+	cb.Add([]byte("key"))
+	cb.Add([]byte("key"))
+	cb.Remove([]byte("key"))
+
+	assert.True(t, cb.MayContain([]byte("key")))
+}
+
+func TestCountingBloom_SaturatedCountersShouldNotBeDecremented(t *testing.T) {
+	t.Parallel()
+
+	cb := createCountingFilter(t, 2048)
+	for i := 0; i < 300; i++ {
+		cb.Add([]byte("key"))
+	}
+	for i := 0; i < 300; i++ {
+		cb.Remove([]byte("key"))
+	}
+
+	assert.True(t, cb.MayContain([]byte("key")))
+}
+
+func TestCountingBloom_MarshalUnmarshalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cb := createCountingFilter(t, 2048)
+	cb.Add([]byte("key1"))
+	cb.Add([]byte("key2"))
+	data, err := cb.MarshalBinary()
+	require.Nil(t, err)
+
+	loaded := createCountingFilter(t, 2048)
+	err = loaded.UnmarshalBinary(data)
+	require.Nil(t, err)
+	assert.True(t, loaded.MayContain([]byte("key1")))
+	assert.True(t, loaded.MayContain([]byte("key2")))
+	assert.False(t, loaded.MayContain([]byte("key3")))
+}
+
+func TestCountingBloom_UnmarshalWithDifferentConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cb := createCountingFilter(t, 2048)
+	cb.Add([]byte("key"))
+	data, _ := cb.MarshalBinary()
+
+	otherSize := createCountingFilter(t, 1024)
+	assert.Equal(t, storage.ErrInvalidBloomFilterData, otherSize.UnmarshalBinary(data))
+
+	otherHashers, _ := bloom.NewCountingFilter(2048, []hashing.Hasher{fnv.Fnv{}, keccak.Keccak{}, &blake2b.Blake2b{}})
+	assert.Equal(t, storage.ErrInvalidBloomFilterData, otherHashers.UnmarshalBinary(data))
+	assert.False(t, otherHashers.MayContain([]byte("key")))
+
+	assert.Equal(t, storage.ErrInvalidBloomFilterData, cb.UnmarshalBinary(data[:len(data)-1]))
+}
+
+func TestCountingBloom_ConcurrentAccessesShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cb := createCountingFilter(t, 2048)
+	numGoroutines := 50
+	wg := sync.WaitGroup{}
+	wg.Add(numGoroutines)
+	for i := 0; i < numGoroutines; i++ {
+		go func(idx int) {
+			key := []byte(fmt.Sprintf("key%d", idx))
+			cb.Add(key)
+			_ = cb.MayContain(key)
+			if idx%2 == 0 {
+				cb.Remove(key)
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 1; i < numGoroutines; i += 2 {
+		assert.True(t, cb.MayContain([]byte(fmt.Sprintf("key%d", i))))
+	}
+}
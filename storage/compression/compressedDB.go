@@ -0,0 +1,252 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+var log = logger.GetOrCreate("storage/compression")
+
+// FormatMarkerKey is the reserved key under which a persister keeps the format marker of its compressed values.
+// The marker is randomly generated when the compression is first enabled on the persister and prefixes, together
+// with one byte holding the Algorithm, all the values written compressed from then on. Being unique per persister,
+// it can not be mistaken for the beginning of a value written before the compression was enabled
+var FormatMarkerKey = []byte("@compressionFormatMarker")
+
+const formatMarkerLength = 8
+
+// DB is a persister wrapper that compresses the values before writing them to the wrapped persister
+type DB struct {
+	db        storage.Persister
+	algorithm Algorithm
+	decoder   *Decoder
+}
+
+// NewDB creates a new compression layer over the provided persister. The values are written compressed
+// with the provided algorithm, while the existing uncompressed values are returned as they are
+func NewDB(db storage.Persister, algorithm Algorithm) (*DB, error) {
+	if check.IfNil(db) {
+		return nil, storage.ErrNilPersister
+	}
+
+	switch algorithm {
+	case Snappy:
+	case Zstd:
+		_, _, err := getZstdCodecs()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrNotSupportedAlgorithm
+	}
+
+	formatMarker, err := loadOrCreateFormatMarker(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{
+		db:        db,
+		algorithm: algorithm,
+		decoder:   NewDecoder(formatMarker),
+	}, nil
+}
+
+func loadOrCreateFormatMarker(db storage.Persister) ([]byte, error) {
+	formatMarker, err := db.Get(FormatMarkerKey)
+	if err == nil {
+		if len(formatMarker) != formatMarkerLength {
+			return nil, fmt.Errorf("%w: format marker of length %d", ErrInvalidFormatMarker, len(formatMarker))
+		}
+		return formatMarker, nil
+	}
+	// any other error might hide an existing marker, which must never be replaced
+	if !errors.Is(err, storage.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	formatMarker = make([]byte, formatMarkerLength)
+	_, err = rand.Read(formatMarker)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Put(FormatMarkerKey, formatMarker)
+	if err != nil {
+		return nil, err
+	}
+
+	return formatMarker, nil
+}
+
+// Put compresses the value and adds it to the wrapped persister. The value is written uncompressed
+// if the compression does not reduce its size
+func (cdb *DB) Put(key, val []byte) error {
+	encoded, err := cdb.encode(val)
+	if err != nil {
+		return err
+	}
+
+	return cdb.db.Put(key, encoded)
+}
+
+// Get returns the decompressed value associated to the key. An error is returned if the value was written
+// compressed but can not be decompressed
+func (cdb *DB) Get(key []byte) ([]byte, error) {
+	val, err := cdb.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdb.decoder.Decode(val)
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (cdb *DB) Has(key []byte) error {
+	return cdb.db.Has(key)
+}
+
+// Init initializes the wrapped persister
+func (cdb *DB) Init() error {
+	return cdb.db.Init()
+}
+
+// Close closes the wrapped persister
+func (cdb *DB) Close() error {
+	return cdb.db.Close()
+}
+
+// Remove removes the data associated to the given key
+func (cdb *DB) Remove(key []byte) error {
+	return cdb.db.Remove(key)
+}
+
+// Destroy removes the wrapped persister stored data
+func (cdb *DB) Destroy() error {
+	return cdb.db.Destroy()
+}
+
+// DestroyClosed removes the already closed wrapped persister stored data
+func (cdb *DB) DestroyClosed() error {
+	return cdb.db.DestroyClosed()
+}
+
+// RangeKeys will iterate over all contained (key, value) pairs calling the handler with the decompressed values.
+// The format marker and the values that can not be decompressed are skipped
+func (cdb *DB) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	cdb.db.RangeKeys(cdb.decodingHandler(handler))
+}
+
+// RangeKeysWithPrefix will iterate over the pairs whose keys start with the prefix, calling the handler with
+// the decompressed values. The format marker and the values that can not be decompressed are skipped
+func (cdb *DB) RangeKeysWithPrefix(prefix []byte, reverse bool, handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	cdb.db.RangeKeysWithPrefix(prefix, reverse, cdb.decodingHandler(handler))
+}
+
+// RangeKeysFrom will iterate over the pairs whose keys are in the [start, end) interval, calling the handler with
+// the decompressed values. The format marker and the values that can not be decompressed are skipped
+func (cdb *DB) RangeKeysFrom(start []byte, end []byte, reverse bool, handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	cdb.db.RangeKeysFrom(start, end, reverse, cdb.decodingHandler(handler))
+}
+
+func (cdb *DB) decodingHandler(handler func(key []byte, val []byte) bool) func(key []byte, val []byte) bool {
+	return func(key []byte, val []byte) bool {
+		if bytes.Equal(key, FormatMarkerKey) {
+			return true
+		}
+
+		decoded, err := cdb.decoder.Decode(val)
+		if err != nil {
+			log.Warn("skipping value that can not be decompressed", "key", key, "error", err)
+			return true
+		}
+
+		return handler(key, decoded)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cdb *DB) IsInterfaceNil() bool {
+	return cdb == nil
+}
+
+func (cdb *DB) encode(val []byte) ([]byte, error) {
+	compressed, err := compress(cdb.algorithm, val)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(compressed)+cdb.decoder.headerLength() < len(val) {
+		return cdb.decoder.withHeader(cdb.algorithm, compressed), nil
+	}
+	// an uncompressed value starting with the format marker needs the header, otherwise it will be misread
+	if bytes.HasPrefix(val, cdb.decoder.formatMarker) {
+		return cdb.decoder.withHeader(stored, val), nil
+	}
+
+	return val, nil
+}
+
+// Decoder restores the original values of a persister, as written through its compression layer
+type Decoder struct {
+	formatMarker []byte
+}
+
+// NewDecoder creates a decoder for the values of a persister having the provided format marker. An empty format
+// marker means that the compression was never enabled on the persister, so all its values are returned as they are
+func NewDecoder(formatMarker []byte) *Decoder {
+	return &Decoder{
+		formatMarker: formatMarker,
+	}
+}
+
+// Decode returns the original value of a value read directly from the persister. A value without the format
+// marker was written uncompressed and is returned as it is, while a value with the format marker that can not be
+// decompressed is reported as corrupted
+func (d *Decoder) Decode(val []byte) ([]byte, error) {
+	if len(d.formatMarker) == 0 || !bytes.HasPrefix(val, d.formatMarker) {
+		return val, nil
+	}
+	if len(val) < d.headerLength() {
+		return nil, fmt.Errorf("%w: missing algorithm", ErrCorruptedValue)
+	}
+
+	decompressed, err := decompress(Algorithm(val[len(d.formatMarker)]), val[d.headerLength():])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptedValue, err)
+	}
+
+	return decompressed, nil
+}
+
+func (d *Decoder) headerLength() int {
+	return len(d.formatMarker) + 1
+}
+
+func (d *Decoder) withHeader(algorithm Algorithm, payload []byte) []byte {
+	result := make([]byte, 0, d.headerLength()+len(payload))
+	result = append(result, d.formatMarker...)
+	result = append(result, byte(algorithm))
+
+	return append(result, payload...)
+}
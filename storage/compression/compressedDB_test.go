@@ -0,0 +1,235 @@
+package compression_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var compressibleValue = bytes.Repeat([]byte("block body "), 100)
+
+func TestNewDB_NilPersisterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := compression.NewDB(nil, compression.Snappy)
+
+	assert.True(t, check.IfNil(cdb))
+	assert.Equal(t, storage.ErrNilPersister, err)
+}
+
+func TestNewDB_UnknownAlgorithmShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cdb, err := compression.NewDB(memorydb.New(), compression.Algorithm(37))
+
+	assert.True(t, check.IfNil(cdb))
+	assert.Equal(t, compression.ErrNotSupportedAlgorithm, err)
+}
+
+func TestDB_PutGetShouldCompress(t *testing.T) {
+	t.Parallel()
+
+	for _, algorithm := range []compression.Algorithm{compression.Snappy, compression.Zstd} {
+		db := memorydb.New()
+		cdb, err := compression.NewDB(db, algorithm)
+		require.Nil(t, err)
+
+		key := []byte("key")
+		err = cdb.Put(key, compressibleValue)
+		assert.Nil(t, err)
+
+		rawValue, _ := db.Get(key)
+		assert.True(t, len(rawValue) < len(compressibleValue))
+
+		value, err := cdb.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, compressibleValue, value)
+	}
+}
+
+func TestDB_PutIncompressibleValueShouldWriteItAsItIs(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cdb, _ := compression.NewDB(db, compression.Snappy)
+
+	key, val := []byte("key"), []byte("short")
+	_ = cdb.Put(key, val)
+
+	rawValue, _ := db.Get(key)
+	assert.Equal(t, val, rawValue)
+
+	value, _ := cdb.Get(key)
+	assert.Equal(t, val, value)
+}
+
+func TestDB_PutValueStartingWithTheFormatMarkerShouldBeReadBack(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cdb, _ := compression.NewDB(db, compression.Snappy)
+
+	formatMarker, err := db.Get(compression.FormatMarkerKey)
+	require.Nil(t, err)
+
+	key, val := []byte("key"), append(append([]byte{}, formatMarker...), 1, 2, 3)
+	_ = cdb.Put(key, val)
+
+	rawValue, _ := db.Get(key)
+	assert.NotEqual(t, val, rawValue)
+
+	value, _ := cdb.Get(key)
+	assert.Equal(t, val, value)
+}
+
+func TestDB_GetShouldReadUncompressedAndOtherAlgorithmsValues(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	legacyKey := []byte("legacy")
+	_ = db.Put(legacyKey, compressibleValue)
+
+	zstdDB, _ := compression.NewDB(db, compression.Zstd)
+	zstdKey := []byte("zstd")
+	_ = zstdDB.Put(zstdKey, compressibleValue)
+
+	snappyDB, _ := compression.NewDB(db, compression.Snappy)
+	for _, key := range [][]byte{legacyKey, zstdKey} {
+		value, err := snappyDB.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, compressibleValue, value)
+	}
+}
+
+func TestDB_GetMissingKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cdb, _ := compression.NewDB(memorydb.New(), compression.Snappy)
+
+	value, err := cdb.Get([]byte("missing"))
+	assert.Nil(t, value)
+	assert.NotNil(t, err)
+}
+
+func TestDB_RangeKeysShouldReturnDecompressedValues(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	_ = db.Put([]byte("legacy"), compressibleValue)
+	cdb, _ := compression.NewDB(db, compression.Zstd)
+	_ = cdb.Put([]byte("compressed"), compressibleValue)
+
+	numValues := 0
+	cdb.RangeKeys(func(key []byte, val []byte) bool {
+		numValues++
+		assert.Equal(t, compressibleValue, val)
+		return true
+	})
+	assert.Equal(t, 2, numValues)
+}
+
+func TestDB_OrderedRangesShouldReturnDecompressedValues(t *testing.T) {
+	t.Parallel()
+
+	cdb, _ := compression.NewDB(memorydb.New(), compression.Snappy)
+	_ = cdb.Put([]byte("a1"), compressibleValue)
+	_ = cdb.Put([]byte("a2"), compressibleValue)
+	_ = cdb.Put([]byte("b1"), compressibleValue)
+
+	keys := make([]string, 0)
+	handler := func(key []byte, val []byte) bool {
+		keys = append(keys, string(key))
+		assert.Equal(t, compressibleValue, val)
+		return true
+	}
+
+	cdb.RangeKeysWithPrefix([]byte("a"), true, handler)
+	assert.Equal(t, []string{"a2", "a1"}, keys)
+
+	keys = keys[:0]
+	cdb.RangeKeysFrom([]byte("a2"), nil, false, handler)
+	assert.Equal(t, []string{"a2", "b1"}, keys)
+}
+
+func TestNewDB_ShouldReuseTheStoredFormatMarker(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	_, _ = compression.NewDB(db, compression.Snappy)
+	formatMarker, err := db.Get(compression.FormatMarkerKey)
+	require.Nil(t, err)
+	assert.Equal(t, 8, len(formatMarker))
+
+	_, _ = compression.NewDB(db, compression.Zstd)
+	reloadedFormatMarker, _ := db.Get(compression.FormatMarkerKey)
+	assert.Equal(t, formatMarker, reloadedFormatMarker)
+
+	otherDB := memorydb.New()
+	_, _ = compression.NewDB(otherDB, compression.Snappy)
+	otherFormatMarker, _ := otherDB.Get(compression.FormatMarkerKey)
+	assert.NotEqual(t, formatMarker, otherFormatMarker)
+}
+
+func TestNewDB_InvalidStoredFormatMarkerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	_ = db.Put(compression.FormatMarkerKey, []byte("short"))
+
+	cdb, err := compression.NewDB(db, compression.Snappy)
+	assert.True(t, check.IfNil(cdb))
+	assert.True(t, errors.Is(err, compression.ErrInvalidFormatMarker))
+}
+
+func TestDB_LegacyValueStartingWithAFixedMarkerShouldBeReturnedAsItIs(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	key, val := []byte("legacy"), []byte{0xC0, 0x3D, 0xEC, 0x00, 0xFF, 0xFF, 0xFF}
+	_ = db.Put(key, val)
+
+	cdb, _ := compression.NewDB(db, compression.Snappy)
+	value, err := cdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, value)
+}
+
+func TestDB_GetCorruptedCompressedValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	cdb, _ := compression.NewDB(db, compression.Snappy)
+	formatMarker, _ := db.Get(compression.FormatMarkerKey)
+
+	key := []byte("corrupted")
+	_ = db.Put(key, append(append([]byte{}, formatMarker...), byte(compression.Snappy), 0xFF, 0xFF, 0xFF))
+	_ = cdb.Put([]byte("valid"), compressibleValue)
+
+	value, err := cdb.Get(key)
+	assert.Nil(t, value)
+	assert.True(t, errors.Is(err, compression.ErrCorruptedValue))
+
+	keys := make([]string, 0)
+	cdb.RangeKeys(func(key []byte, val []byte) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+	assert.Equal(t, []string{"valid"}, keys)
+}
+
+func TestDecoder_EmptyFormatMarkerShouldReturnTheValuesAsTheyAre(t *testing.T) {
+	t.Parallel()
+
+	val := []byte{0xC0, 0x3D, 0xEC, byte(compression.Snappy), 0xFF, 0xFF, 0xFF}
+	value, err := compression.NewDecoder(nil).Decode(val)
+
+	assert.Nil(t, err)
+	assert.Equal(t, val, value)
+}
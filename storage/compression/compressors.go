@@ -0,0 +1,85 @@
+package compression
+
+import (
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Algorithm is the identifier of a compression algorithm, as written in the format marker of the compressed values
+type Algorithm byte
+
+const (
+	// stored marks a value that is kept as it is, behind the format marker
+	stored Algorithm = 0
+	// Snappy identifies the snappy compression, fast but with a lower compression ratio
+	Snappy Algorithm = 1
+	// Zstd identifies the zstd compression, slower but with a better compression ratio
+	Zstd Algorithm = 2
+)
+
+var (
+	mutZstd     sync.Mutex
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+)
+
+// getZstdCodecs returns the zstd encoder and decoder shared by all the persisters. Both of them are safe for
+// concurrent use when calling EncodeAll and DecodeAll
+func getZstdCodecs() (*zstd.Encoder, *zstd.Decoder, error) {
+	mutZstd.Lock()
+	defer mutZstd.Unlock()
+
+	if zstdEncoder != nil && zstdDecoder != nil {
+		return zstdEncoder, zstdDecoder, nil
+	}
+
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	if err != nil {
+		return nil, nil, err
+	}
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	zstdEncoder = encoder
+	zstdDecoder = decoder
+
+	return zstdEncoder, zstdDecoder, nil
+}
+
+func compress(algorithm Algorithm, data []byte) ([]byte, error) {
+	switch algorithm {
+	case Snappy:
+		return snappy.Encode(nil, data), nil
+	case Zstd:
+		encoder, _, err := getZstdCodecs()
+		if err != nil {
+			return nil, err
+		}
+
+		return encoder.EncodeAll(data, nil), nil
+	default:
+		return nil, ErrNotSupportedAlgorithm
+	}
+}
+
+func decompress(algorithm Algorithm, data []byte) ([]byte, error) {
+	switch algorithm {
+	case stored:
+		return data, nil
+	case Snappy:
+		return snappy.Decode(nil, data)
+	case Zstd:
+		_, decoder, err := getZstdCodecs()
+		if err != nil {
+			return nil, err
+		}
+
+		return decoder.DecodeAll(data, nil)
+	default:
+		return nil, ErrNotSupportedAlgorithm
+	}
+}
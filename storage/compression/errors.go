@@ -0,0 +1,12 @@
+package compression
+
+import "errors"
+
+// ErrNotSupportedAlgorithm signals that an unknown compression algorithm has been provided
+var ErrNotSupportedAlgorithm = errors.New("not supported compression algorithm")
+
+// ErrCorruptedValue signals that a value having the format marker could not be decompressed
+var ErrCorruptedValue = errors.New("corrupted compressed value")
+
+// ErrInvalidFormatMarker signals that the format marker stored in a persister is invalid
+var ErrInvalidFormatMarker = errors.New("invalid compression format marker")
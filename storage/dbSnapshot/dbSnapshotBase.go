@@ -0,0 +1,247 @@
+package dbSnapshot
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+)
+
+var log = logger.GetOrCreate("storage/dbSnapshot")
+
+// ArgsDBSnapshot holds the arguments needed for exporting or importing a database snapshot
+type ArgsDBSnapshot struct {
+	GeneralConfig         config.Config
+	Marshalizer           marshal.Marshalizer
+	Hasher                hashing.Hasher
+	DirectoryReader       storage.DirectoryReaderHandler
+	DbPathWithChainID     string
+	DefaultEpochString    string
+	DefaultShardString    string
+	DefaultStaticDbString string
+}
+
+type epochStartData struct {
+	metaBlockHash []byte
+	rootHashes    map[string][]byte
+}
+
+type dbSnapshotBase struct {
+	generalConfig         config.Config
+	marshalizer           marshal.Marshalizer
+	hasher                hashing.Hasher
+	directoryReader       storage.DirectoryReaderHandler
+	dbPathWithChainID     string
+	chainID               string
+	defaultEpochString    string
+	defaultShardString    string
+	defaultStaticDbString string
+}
+
+func newDBSnapshotBase(args ArgsDBSnapshot) (*dbSnapshotBase, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(args.DirectoryReader) {
+		return nil, ErrNilDirectoryReader
+	}
+	if len(args.DbPathWithChainID) == 0 {
+		return nil, ErrEmptyDbPath
+	}
+
+	return &dbSnapshotBase{
+		generalConfig:         args.GeneralConfig,
+		marshalizer:           args.Marshalizer,
+		hasher:                args.Hasher,
+		directoryReader:       args.DirectoryReader,
+		dbPathWithChainID:     args.DbPathWithChainID,
+		chainID:               filepath.Base(args.DbPathWithChainID),
+		defaultEpochString:    args.DefaultEpochString,
+		defaultShardString:    args.DefaultShardString,
+		defaultStaticDbString: args.DefaultStaticDbString,
+	}, nil
+}
+
+func (base *dbSnapshotBase) epochDirName(epoch uint32) string {
+	return fmt.Sprintf("%s_%d", base.defaultEpochString, epoch)
+}
+
+func (base *dbSnapshotBase) shardDirName(shardID string) string {
+	return fmt.Sprintf("%s_%s", base.defaultShardString, shardID)
+}
+
+func (base *dbSnapshotBase) epochShardPath(epoch uint32, shardID string) string {
+	return filepath.Join(base.dbPathWithChainID, base.epochDirName(epoch), base.shardDirName(shardID))
+}
+
+func (base *dbSnapshotBase) staticShardPath(shardID string) string {
+	return filepath.Join(base.dbPathWithChainID, base.defaultStaticDbString, base.shardDirName(shardID))
+}
+
+// readEpochStartData reads the epoch start meta block of the provided epoch and extracts the root hashes
+// of the tries that belong to the provided shard
+func (base *dbSnapshotBase) readEpochStartData(epoch uint32, shardID string) (*epochStartData, error) {
+	metaBlockPath := filepath.Join(base.epochShardPath(epoch, shardID), base.generalConfig.MetaBlockStorage.DB.FilePath)
+	if !core.DoesFileExist(metaBlockPath) {
+		return nil, fmt.Errorf("%w, missing meta blocks unit %s", storage.ErrKeyNotFound, metaBlockPath)
+	}
+
+	persister, err := factory.NewPersisterFactory(base.generalConfig.MetaBlockStorage.DB).Create(metaBlockPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := persister.Close()
+		log.LogIfError(errClose, "path", metaBlockPath)
+	}()
+
+	buff, err := persister.Get([]byte(core.EpochStartIdentifier(epoch)))
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the epoch start meta block of epoch %d", err, epoch)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err = base.marshalizer.Unmarshal(metaBlock, buff)
+	if err != nil {
+		return nil, err
+	}
+	if !metaBlock.IsStartOfEpochBlock() || metaBlock.Epoch != epoch {
+		return nil, ErrNotEpochStartMetaBlock
+	}
+
+	rootHashes, err := rootHashesFromMetaBlock(metaBlock, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &epochStartData{
+		metaBlockHash: base.hasher.Compute(string(buff)),
+		rootHashes:    rootHashes,
+	}, nil
+}
+
+func rootHashesFromMetaBlock(metaBlock *block.MetaBlock, shardID string) (map[string][]byte, error) {
+	if shardID == core.GetShardIDString(core.MetachainShardId) {
+		return map[string][]byte{
+			AccountsTrie:     metaBlock.RootHash,
+			PeerAccountsTrie: metaBlock.ValidatorStatsRootHash,
+		}, nil
+	}
+
+	shardIDUint32, err := core.ConvertShardIDToUint32(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		if shardData.ShardID == shardIDUint32 {
+			return map[string][]byte{AccountsTrie: shardData.RootHash}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w, shard %s", ErrRootHashNotFound, shardID)
+}
+
+// verifyTries checks that all the nodes of the tries with the provided root hashes can be loaded from
+// the trie main storage or from its snapshots
+func (base *dbSnapshotBase) verifyTries(shardID string, rootHashes map[string][]byte) error {
+	for name, rootHash := range rootHashes {
+		err := base.verifyTrie(shardID, name, rootHash)
+		if err != nil {
+			return fmt.Errorf("%w for the %s trie with root hash %s", err, name, hex.EncodeToString(rootHash))
+		}
+	}
+
+	return nil
+}
+
+func (base *dbSnapshotBase) verifyTrie(shardID string, name string, rootHash []byte) error {
+	storageConfig := base.generalConfig.AccountsTrieStorage
+	maxTrieLevelInMemory := base.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory
+	if name == PeerAccountsTrie {
+		storageConfig = base.generalConfig.PeerAccountsTrieStorage
+		maxTrieLevelInMemory = base.generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory
+	}
+
+	trieDB, err := base.openTrieDatabase(shardID, storageConfig.DB)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := trieDB.Close()
+		log.LogIfError(errClose, "trie", name)
+	}()
+
+	storageManager, err := trie.NewTrieStorageManagerWithoutPruning(trieDB)
+	if err != nil {
+		return err
+	}
+	tr, err := trie.NewTrie(storageManager, base.marshalizer, base.hasher, maxTrieLevelInMemory)
+	if err != nil {
+		return err
+	}
+	recreatedTrie, err := tr.Recreate(rootHash)
+	if err != nil {
+		return err
+	}
+	hashes, err := recreatedTrie.GetAllHashes()
+	if err != nil {
+		return err
+	}
+
+	log.Info("trie verified", "shard", shardID, "trie", name, "root hash", rootHash, "num nodes", len(hashes))
+
+	return nil
+}
+
+func (base *dbSnapshotBase) openTrieDatabase(shardID string, mainDBConfig config.DBConfig) (*trieDatabase, error) {
+	mainDBPath := filepath.Join(base.staticShardPath(shardID), mainDBConfig.FilePath)
+	paths := []string{mainDBPath}
+
+	snapshotsPath := filepath.Join(filepath.Dir(mainDBPath), base.generalConfig.TrieSnapshotDB.FilePath)
+	if core.DoesFileExist(snapshotsPath) {
+		snapshots, err := base.directoryReader.ListDirectoriesAsString(snapshotsPath)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, snapshot := range snapshots {
+			paths = append(paths, filepath.Join(snapshotsPath, snapshot))
+		}
+	}
+
+	trieDB := &trieDatabase{}
+	for i, path := range paths {
+		if !core.DoesFileExist(path) {
+			continue
+		}
+
+		dbConfig := base.generalConfig.TrieSnapshotDB
+		if i == 0 {
+			dbConfig = mainDBConfig
+		}
+
+		persister, err := factory.NewPersisterFactory(dbConfig).Create(path)
+		if err != nil {
+			_ = trieDB.Close()
+			return nil, err
+		}
+
+		trieDB.persisters = append(trieDB.persisters, persister)
+	}
+
+	return trieDB, nil
+}
@@ -0,0 +1,57 @@
+package dbSnapshot
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilDirectoryReader signals that a nil directory reader has been provided
+var ErrNilDirectoryReader = errors.New("nil directory reader")
+
+// ErrEmptyDbPath signals that an empty database path has been provided
+var ErrEmptyDbPath = errors.New("empty database path")
+
+// ErrNoEpochDirectory signals that the database does not contain any epoch directory
+var ErrNoEpochDirectory = errors.New("no epoch directory found")
+
+// ErrCannotDetectShard signals that the shard of the snapshot can not be detected from the database directories
+var ErrCannotDetectShard = errors.New("cannot detect the shard, it should be provided explicitly")
+
+// ErrNotEpochStartMetaBlock signals that the stored epoch start meta block is not a start of epoch block
+var ErrNotEpochStartMetaBlock = errors.New("the stored block is not an epoch start meta block")
+
+// ErrRootHashNotFound signals that the root hash of the shard can not be found in the epoch start meta block
+var ErrRootHashNotFound = errors.New("root hash not found in the epoch start meta block")
+
+// ErrDatabaseNotEmpty signals that the snapshot can not be imported over an existing database
+var ErrDatabaseNotEmpty = errors.New("the database directory is not empty")
+
+// ErrInvalidManifest signals that the snapshot archive does not start with a valid manifest
+var ErrInvalidManifest = errors.New("invalid snapshot manifest")
+
+// ErrChainIDMismatch signals that the snapshot belongs to another chain
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// ErrInvalidArchiveEntry signals that the snapshot archive holds an entry outside the database directories
+var ErrInvalidArchiveEntry = errors.New("invalid archive entry")
+
+// ErrEmptyTrustedMetaBlockHash signals that the trusted epoch start meta block hash was not provided
+var ErrEmptyTrustedMetaBlockHash = errors.New("empty trusted epoch start meta block hash")
+
+// ErrInvalidMaxUnpackedBytes signals that an invalid maximum size of the unpacked data has been provided
+var ErrInvalidMaxUnpackedBytes = errors.New("invalid maximum number of unpacked bytes")
+
+// ErrUntrustedMetaBlock signals that the snapshot was taken for another epoch start meta block than the trusted one
+var ErrUntrustedMetaBlock = errors.New("the snapshot epoch start meta block is not the trusted one")
+
+// ErrUnpackedSizeTooLarge signals that the unpacked snapshot exceeds the allowed size
+var ErrUnpackedSizeTooLarge = errors.New("the unpacked snapshot is too large")
+
+// ErrValidationFailed signals that the imported data does not match the snapshot manifest
+var ErrValidationFailed = errors.New("snapshot validation failed")
+
+// ErrReadOnlyDatabase signals that a write was attempted on a database opened for reading
+var ErrReadOnlyDatabase = errors.New("read only database")
@@ -0,0 +1,225 @@
+package dbSnapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// lockFileName is the name of the lock file held by the LevelDB and Badger databases while opened
+const lockFileName = "LOCK"
+
+type exporter struct {
+	*dbSnapshotBase
+}
+
+// NewExporter returns a component able to pack the static and the current epoch storage units of a shard in
+// a snapshot archive. The node using the database must be stopped while exporting
+func NewExporter(args ArgsDBSnapshot) (*exporter, error) {
+	base, err := newDBSnapshotBase(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exporter{
+		dbSnapshotBase: base,
+	}, nil
+}
+
+// Export writes the snapshot archive of the provided shard to the output. The snapshot is taken at the start of
+// the last epoch found in the database, as the trie snapshots hold the state from the epoch start meta block.
+// If the shard ID is empty, it is detected from the directories of the last epoch
+func (e *exporter) Export(shardID string, output io.Writer) (*Manifest, error) {
+	epoch, err := e.lastEpoch()
+	if err != nil {
+		return nil, err
+	}
+	if len(shardID) == 0 {
+		shardID, err = e.detectShard(epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := e.readEpochStartData(epoch, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Version:                 ManifestVersion,
+		ChainID:                 e.chainID,
+		ShardID:                 shardID,
+		Epoch:                   epoch,
+		EpochStartMetaBlockHash: hex.EncodeToString(data.metaBlockHash),
+		RootHashes:              make(map[string]string),
+	}
+	for name, rootHash := range data.rootHashes {
+		manifest.RootHashes[name] = hex.EncodeToString(rootHash)
+	}
+
+	err = e.writeArchive(manifest, output)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("database snapshot exported", "chain ID", manifest.ChainID, "shard", shardID, "epoch", epoch,
+		"epoch start meta block hash", manifest.EpochStartMetaBlockHash)
+
+	return manifest, nil
+}
+
+func (e *exporter) lastEpoch() (uint32, error) {
+	directories, err := e.directoryReader.ListDirectoriesAsString(e.dbPathWithChainID)
+	if err != nil {
+		return 0, err
+	}
+
+	found := false
+	lastEpoch := uint32(0)
+	for _, directory := range directories {
+		epoch, ok := e.parseEpochDirName(directory)
+		if !ok {
+			continue
+		}
+
+		if !found || epoch > lastEpoch {
+			lastEpoch = epoch
+			found = true
+		}
+	}
+	if !found {
+		return 0, ErrNoEpochDirectory
+	}
+
+	return lastEpoch, nil
+}
+
+func (e *exporter) parseEpochDirName(directory string) (uint32, bool) {
+	prefix := e.defaultEpochString + "_"
+	if !strings.HasPrefix(directory, prefix) {
+		return 0, false
+	}
+
+	epoch, err := strconv.ParseUint(strings.TrimPrefix(directory, prefix), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+
+	return uint32(epoch), true
+}
+
+func (e *exporter) detectShard(epoch uint32) (string, error) {
+	epochPath := filepath.Join(e.dbPathWithChainID, e.epochDirName(epoch))
+	directories, err := e.directoryReader.ListDirectoriesAsString(epochPath)
+	if err != nil {
+		return "", err
+	}
+
+	prefix := e.defaultShardString + "_"
+	shardIDs := make([]string, 0, len(directories))
+	for _, directory := range directories {
+		if strings.HasPrefix(directory, prefix) {
+			shardIDs = append(shardIDs, strings.TrimPrefix(directory, prefix))
+		}
+	}
+	if len(shardIDs) != 1 {
+		return "", ErrCannotDetectShard
+	}
+
+	return shardIDs[0], nil
+}
+
+func (e *exporter) writeArchive(manifest *Manifest, output io.Writer) error {
+	gzipWriter := gzip.NewWriter(output)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = tarWriter.WriteHeader(&tar.Header{
+		Name: manifestFileName,
+		Mode: 0644,
+		Size: int64(len(manifestBytes)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tarWriter.Write(manifestBytes)
+	if err != nil {
+		return err
+	}
+
+	directories := []string{
+		e.staticShardPath(manifest.ShardID),
+		e.epochShardPath(manifest.Epoch, manifest.ShardID),
+	}
+	for _, directory := range directories {
+		err = e.addDirectory(tarWriter, directory)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
+
+func (e *exporter) addDirectory(tarWriter *tar.Writer, directory string) error {
+	return filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() || info.Name() == lockFileName {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(e.dbPathWithChainID, path)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relativePath)
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		return copyFileContent(tarWriter, path)
+	})
+}
+
+func copyFileContent(writer io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = io.Copy(writer, file)
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (e *exporter) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,86 @@
+package dbSnapshot_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/dbSnapshot"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewExporter_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs("")
+	args.Marshalizer = nil
+	exp, err := dbSnapshot.NewExporter(args)
+
+	assert.Nil(t, exp)
+	assert.Equal(t, dbSnapshot.ErrNilMarshalizer, err)
+}
+
+func TestNewExporter_NilDirectoryReaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createArgs("")
+	args.DirectoryReader = nil
+	exp, err := dbSnapshot.NewExporter(args)
+
+	assert.Nil(t, exp)
+	assert.Equal(t, dbSnapshot.ErrNilDirectoryReader, err)
+}
+
+func TestExporter_ExportWithoutEpochDirectoriesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	workingDir, _ := ioutil.TempDir("", "dbSnapshotExport")
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+	args := createArgs(workingDir)
+	_ = os.MkdirAll(filepath.Join(args.DbPathWithChainID, "Static"), os.ModePerm)
+	exp, _ := dbSnapshot.NewExporter(args)
+
+	manifest, err := exp.Export("", &bytes.Buffer{})
+	assert.Nil(t, manifest)
+	assert.Equal(t, dbSnapshot.ErrNoEpochDirectory, err)
+}
+
+func TestExporter_ExportWithMultipleShardsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	workingDir, _ := ioutil.TempDir("", "dbSnapshotExport")
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+	args := createArgs(workingDir)
+	_ = os.MkdirAll(filepath.Join(args.DbPathWithChainID, "Epoch_3", "Shard_0"), os.ModePerm)
+	_ = os.MkdirAll(filepath.Join(args.DbPathWithChainID, "Epoch_3", "Shard_1"), os.ModePerm)
+	exp, _ := dbSnapshot.NewExporter(args)
+
+	manifest, err := exp.Export("", &bytes.Buffer{})
+	assert.Nil(t, manifest)
+	assert.Equal(t, dbSnapshot.ErrCannotDetectShard, err)
+}
+
+func TestExporter_ExportShouldUseTheLastEpoch(t *testing.T) {
+	t.Parallel()
+
+	workingDir, _ := ioutil.TempDir("", "dbSnapshotExport")
+	defer func() {
+		_ = os.RemoveAll(workingDir)
+	}()
+	args := createArgs(workingDir)
+	createTestDatabase(t, args, nil)
+	_ = os.MkdirAll(filepath.Join(args.DbPathWithChainID, "Epoch_10", "Shard_0"), os.ModePerm)
+	exp, _ := dbSnapshot.NewExporter(args)
+
+	manifest, err := exp.Export("0", &bytes.Buffer{})
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+}
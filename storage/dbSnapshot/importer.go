@@ -0,0 +1,245 @@
+package dbSnapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const maxManifestSize = 1 << 20
+
+// ArgsImporter holds the arguments needed to create a snapshot importer. The trusted epoch start meta block hash
+// is the anchor the snapshot is checked against, as the archive itself can not be trusted
+type ArgsImporter struct {
+	ArgsDBSnapshot
+	TrustedEpochStartMetaBlockHash []byte
+	MaxUnpackedBytes               uint64
+}
+
+type importer struct {
+	*dbSnapshotBase
+	trustedEpochStartMetaBlockHash []byte
+	maxUnpackedBytes               uint64
+}
+
+// NewImporter returns a component able to unpack a snapshot archive in an empty database directory
+func NewImporter(args ArgsImporter) (*importer, error) {
+	base, err := newDBSnapshotBase(args.ArgsDBSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	if len(args.TrustedEpochStartMetaBlockHash) == 0 {
+		return nil, ErrEmptyTrustedMetaBlockHash
+	}
+	if args.MaxUnpackedBytes == 0 {
+		return nil, ErrInvalidMaxUnpackedBytes
+	}
+
+	return &importer{
+		dbSnapshotBase:                 base,
+		trustedEpochStartMetaBlockHash: args.TrustedEpochStartMetaBlockHash,
+		maxUnpackedBytes:               args.MaxUnpackedBytes,
+	}, nil
+}
+
+// Import unpacks the snapshot archive and validates the unpacked data against the trusted epoch start meta block
+// hash and against the root hashes from that meta block. The database directory is removed if the import fails,
+// so the node will not start from partial data
+func (i *importer) Import(input io.Reader) (*Manifest, error) {
+	isEmpty, err := i.isDatabaseEmpty()
+	if err != nil {
+		return nil, err
+	}
+	if !isEmpty {
+		return nil, fmt.Errorf("%w, path %s", ErrDatabaseNotEmpty, i.dbPathWithChainID)
+	}
+
+	manifest, err := i.unpackAndValidate(input)
+	if err != nil {
+		errRemove := os.RemoveAll(i.dbPathWithChainID)
+		log.LogIfError(errRemove, "path", i.dbPathWithChainID)
+
+		return nil, err
+	}
+
+	log.Info("database snapshot imported", "chain ID", manifest.ChainID, "shard", manifest.ShardID,
+		"epoch", manifest.Epoch, "epoch start meta block hash", manifest.EpochStartMetaBlockHash)
+
+	return manifest, nil
+}
+
+func (i *importer) isDatabaseEmpty() (bool, error) {
+	entries, err := ioutil.ReadDir(i.dbPathWithChainID)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return len(entries) == 0, nil
+}
+
+func (i *importer) unpackAndValidate(input io.Reader) (*Manifest, error) {
+	gzipReader, err := gzip.NewReader(input)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+	tarReader := tar.NewReader(gzipReader)
+
+	manifest, err := i.readManifest(tarReader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.unpack(tarReader)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.validate(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (i *importer) readManifest(tarReader *tar.Reader) (*Manifest, error) {
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidManifest, err.Error())
+	}
+	if header.Name != manifestFileName || header.Size > maxManifestSize {
+		return nil, fmt.Errorf("%w, the archive should start with %s", ErrInvalidManifest, manifestFileName)
+	}
+
+	manifest := &Manifest{}
+	err = json.NewDecoder(tarReader).Decode(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidManifest, err.Error())
+	}
+	if manifest.Version != ManifestVersion {
+		return nil, fmt.Errorf("%w, unknown version %d", ErrInvalidManifest, manifest.Version)
+	}
+	if manifest.ChainID != i.chainID {
+		return nil, fmt.Errorf("%w, snapshot chain ID %s, node chain ID %s", ErrChainIDMismatch, manifest.ChainID, i.chainID)
+	}
+	trustedHash := hex.EncodeToString(i.trustedEpochStartMetaBlockHash)
+	if manifest.EpochStartMetaBlockHash != trustedHash {
+		return nil, fmt.Errorf("%w, manifest hash %s, trusted hash %s",
+			ErrUntrustedMetaBlock, manifest.EpochStartMetaBlockHash, trustedHash)
+	}
+
+	return manifest, nil
+}
+
+func (i *importer) unpack(tarReader *tar.Reader) error {
+	unpackedBytes := uint64(0)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			return fmt.Errorf("%w, %s is not a regular file", ErrInvalidArchiveEntry, header.Name)
+		}
+
+		destination, err := i.destinationPath(header.Name)
+		if err != nil {
+			return err
+		}
+
+		if header.Size < 0 || uint64(header.Size) > i.maxUnpackedBytes-unpackedBytes {
+			return fmt.Errorf("%w, maximum %d bytes", ErrUnpackedSizeTooLarge, i.maxUnpackedBytes)
+		}
+		unpackedBytes += uint64(header.Size)
+
+		err = writeFile(destination, tarReader, header.Size)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// destinationPath returns the path of an archive entry, accepting only the entries from the static and epoch directories
+func (i *importer) destinationPath(entryName string) (string, error) {
+	cleanName := path.Clean(entryName)
+	isInStaticDir := strings.HasPrefix(cleanName, i.defaultStaticDbString+"/")
+	isInEpochDir := strings.HasPrefix(cleanName, i.defaultEpochString+"_")
+	if path.IsAbs(cleanName) || strings.Contains(cleanName, "..") || !(isInStaticDir || isInEpochDir) {
+		return "", fmt.Errorf("%w, %s", ErrInvalidArchiveEntry, entryName)
+	}
+
+	return filepath.Join(i.dbPathWithChainID, filepath.FromSlash(cleanName)), nil
+}
+
+// writeFile writes exactly size bytes from the provided reader in the destination file
+func writeFile(destination string, content io.Reader, size int64) error {
+	err := os.MkdirAll(filepath.Dir(destination), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(destination, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(file, content, size)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func (i *importer) validate(manifest *Manifest) error {
+	data, err := i.readEpochStartData(manifest.Epoch, manifest.ShardID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrValidationFailed, err.Error())
+	}
+
+	if !bytes.Equal(data.metaBlockHash, i.trustedEpochStartMetaBlockHash) {
+		return fmt.Errorf("%w, epoch start meta block hash %s, trusted hash %s", ErrValidationFailed,
+			hex.EncodeToString(data.metaBlockHash), hex.EncodeToString(i.trustedEpochStartMetaBlockHash))
+	}
+
+	if len(data.rootHashes) != len(manifest.RootHashes) {
+		return fmt.Errorf("%w, the manifest root hashes do not match the epoch start meta block", ErrValidationFailed)
+	}
+	for name, rootHash := range data.rootHashes {
+		if hex.EncodeToString(rootHash) != manifest.RootHashes[name] {
+			return fmt.Errorf("%w, the manifest root hash of the %s trie does not match the epoch start meta block",
+				ErrValidationFailed, name)
+		}
+	}
+
+	err = i.verifyTries(manifest.ShardID, data.rootHashes)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrValidationFailed, err.Error())
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (i *importer) IsInterfaceNil() bool {
+	return i == nil
+}
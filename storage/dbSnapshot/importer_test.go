@@ -0,0 +1,317 @@
+package dbSnapshot_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage/dbSnapshot"
+	"github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "1"
+const testEpoch = uint32(2)
+
+func createTestConfig() config.Config {
+	dbConfig := func(filePath string) config.DBConfig {
+		return config.DBConfig{
+			FilePath:          filePath,
+			Type:              string(storageUnit.LvlDBSerial),
+			BatchDelaySeconds: 1,
+			MaxBatchSize:      1,
+			MaxOpenFiles:      10,
+		}
+	}
+
+	return config.Config{
+		MetaBlockStorage:        config.StorageConfig{DB: dbConfig("MetaBlock")},
+		AccountsTrieStorage:     config.StorageConfig{DB: dbConfig("AccountsTrie/MainDB")},
+		PeerAccountsTrieStorage: config.StorageConfig{DB: dbConfig("PeerAccountsTrie/MainDB")},
+		TrieSnapshotDB:          dbConfig("TrieSnapshot"),
+		StateTriesConfig: config.StateTriesConfig{
+			MaxStateTrieLevelInMemory: 5,
+			MaxPeerTrieLevelInMemory:  5,
+		},
+	}
+}
+
+func createArgs(workingDir string) dbSnapshot.ArgsDBSnapshot {
+	return dbSnapshot.ArgsDBSnapshot{
+		GeneralConfig:         createTestConfig(),
+		Marshalizer:           &marshal.GogoProtoMarshalizer{},
+		Hasher:                &blake2b.Blake2b{},
+		DirectoryReader:       factory.NewDirectoryReader(),
+		DbPathWithChainID:     filepath.Join(workingDir, "db", testChainID),
+		DefaultEpochString:    "Epoch",
+		DefaultShardString:    "Shard",
+		DefaultStaticDbString: "Static",
+	}
+}
+
+// createTestDatabase writes a trie in the trie snapshot storage and the epoch start meta block notarizing it
+func createTestDatabase(t *testing.T, args dbSnapshot.ArgsDBSnapshot, metaBlockRootHash []byte) {
+	snapshotPath := filepath.Join(args.DbPathWithChainID, "Static", "Shard_0", "AccountsTrie", "TrieSnapshot", "0")
+	persister, err := factory.NewPersisterFactory(args.GeneralConfig.TrieSnapshotDB).Create(snapshotPath)
+	require.Nil(t, err)
+	cache, _ := lrucache.NewCache(10)
+	unit, _ := storageUnit.NewStorageUnit(cache, persister)
+	storageManager, _ := trie.NewTrieStorageManagerWithoutPruning(unit)
+	tr, _ := trie.NewTrie(storageManager, args.Marshalizer, args.Hasher, 5)
+	for i := 0; i < 100; i++ {
+		_ = tr.Update([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	require.Nil(t, tr.Commit())
+	rootHash, _ := tr.RootHash()
+	require.Nil(t, unit.Close())
+
+	if metaBlockRootHash == nil {
+		metaBlockRootHash = rootHash
+	}
+	metaBlock := &block.MetaBlock{
+		Epoch: testEpoch,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: []block.EpochStartShardData{{ShardID: 0, RootHash: metaBlockRootHash}},
+		},
+	}
+	metaBlockBytes, _ := args.Marshalizer.Marshal(metaBlock)
+	metaBlockPath := filepath.Join(args.DbPathWithChainID, fmt.Sprintf("Epoch_%d", testEpoch), "Shard_0", "MetaBlock")
+	persister, err = factory.NewPersisterFactory(args.GeneralConfig.MetaBlockStorage.DB).Create(metaBlockPath)
+	require.Nil(t, err)
+	require.Nil(t, persister.Put([]byte(core.EpochStartIdentifier(testEpoch)), metaBlockBytes))
+	require.Nil(t, persister.Close())
+}
+
+func exportTestDatabase(t *testing.T, metaBlockRootHash []byte) (*bytes.Buffer, *dbSnapshot.Manifest) {
+	sourceDir, _ := ioutil.TempDir("", "dbSnapshotSource")
+	defer func() {
+		_ = os.RemoveAll(sourceDir)
+	}()
+
+	args := createArgs(sourceDir)
+	createTestDatabase(t, args, metaBlockRootHash)
+	exp, _ := dbSnapshot.NewExporter(args)
+
+	archive := &bytes.Buffer{}
+	manifest, err := exp.Export("", archive)
+	require.Nil(t, err)
+
+	return archive, manifest
+}
+
+func createImporterArgs(workingDir string, trustedMetaBlockHash string) dbSnapshot.ArgsImporter {
+	trustedHash, _ := hex.DecodeString(trustedMetaBlockHash)
+
+	return dbSnapshot.ArgsImporter{
+		ArgsDBSnapshot:                 createArgs(workingDir),
+		TrustedEpochStartMetaBlockHash: trustedHash,
+		MaxUnpackedBytes:               1 << 30,
+	}
+}
+
+func writeArchiveEntry(tarWriter *tar.Writer, name string, content []byte) {
+	_ = tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+	_, _ = tarWriter.Write(content)
+}
+
+func TestNewImporter_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createImporterArgs("", "aa")
+	args.Hasher = nil
+	imp, err := dbSnapshot.NewImporter(args)
+
+	assert.Nil(t, imp)
+	assert.Equal(t, dbSnapshot.ErrNilHasher, err)
+}
+
+func TestNewImporter_EmptyDbPathShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createImporterArgs("", "aa")
+	args.DbPathWithChainID = ""
+	imp, err := dbSnapshot.NewImporter(args)
+
+	assert.Nil(t, imp)
+	assert.Equal(t, dbSnapshot.ErrEmptyDbPath, err)
+}
+
+func TestNewImporter_EmptyTrustedMetaBlockHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	imp, err := dbSnapshot.NewImporter(createImporterArgs("", ""))
+
+	assert.Nil(t, imp)
+	assert.Equal(t, dbSnapshot.ErrEmptyTrustedMetaBlockHash, err)
+}
+
+func TestNewImporter_ZeroMaxUnpackedBytesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createImporterArgs("", "aa")
+	args.MaxUnpackedBytes = 0
+	imp, err := dbSnapshot.NewImporter(args)
+
+	assert.Nil(t, imp)
+	assert.Equal(t, dbSnapshot.ErrInvalidMaxUnpackedBytes, err)
+}
+
+func TestImporter_ImportExportedSnapshotShouldWork(t *testing.T) {
+	t.Parallel()
+
+	archive, exportedManifest := exportTestDatabase(t, nil)
+	assert.Equal(t, testChainID, exportedManifest.ChainID)
+	assert.Equal(t, "0", exportedManifest.ShardID)
+	assert.Equal(t, testEpoch, exportedManifest.Epoch)
+
+	destinationDir, _ := ioutil.TempDir("", "dbSnapshotDestination")
+	defer func() {
+		_ = os.RemoveAll(destinationDir)
+	}()
+	args := createImporterArgs(destinationDir, exportedManifest.EpochStartMetaBlockHash)
+	imp, _ := dbSnapshot.NewImporter(args)
+
+	manifest, err := imp.Import(archive)
+	require.Nil(t, err)
+	assert.Equal(t, exportedManifest, manifest)
+	assert.True(t, core.DoesFileExist(filepath.Join(args.DbPathWithChainID, "Static", "Shard_0", "AccountsTrie", "TrieSnapshot", "0")))
+	assert.True(t, core.DoesFileExist(filepath.Join(args.DbPathWithChainID, "Epoch_2", "Shard_0", "MetaBlock")))
+}
+
+func TestImporter_ImportWithMissingTrieNodesShouldErrAndCleanup(t *testing.T) {
+	t.Parallel()
+
+	archive, exportedManifest := exportTestDatabase(t, []byte("root hash of a missing trie"))
+
+	destinationDir, _ := ioutil.TempDir("", "dbSnapshotDestination")
+	defer func() {
+		_ = os.RemoveAll(destinationDir)
+	}()
+	args := createImporterArgs(destinationDir, exportedManifest.EpochStartMetaBlockHash)
+	imp, _ := dbSnapshot.NewImporter(args)
+
+	manifest, err := imp.Import(archive)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, dbSnapshot.ErrValidationFailed))
+	assert.False(t, core.DoesFileExist(args.DbPathWithChainID))
+}
+
+func TestImporter_ImportOverExistingDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	archive, exportedManifest := exportTestDatabase(t, nil)
+
+	destinationDir, _ := ioutil.TempDir("", "dbSnapshotDestination")
+	defer func() {
+		_ = os.RemoveAll(destinationDir)
+	}()
+	args := createImporterArgs(destinationDir, exportedManifest.EpochStartMetaBlockHash)
+	_ = os.MkdirAll(filepath.Join(args.DbPathWithChainID, "Epoch_0"), os.ModePerm)
+	imp, _ := dbSnapshot.NewImporter(args)
+
+	manifest, err := imp.Import(archive)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, dbSnapshot.ErrDatabaseNotEmpty))
+	assert.True(t, core.DoesFileExist(filepath.Join(args.DbPathWithChainID, "Epoch_0")))
+}
+
+func TestImporter_ImportFromAnotherChainShouldErr(t *testing.T) {
+	t.Parallel()
+
+	archive, exportedManifest := exportTestDatabase(t, nil)
+
+	destinationDir, _ := ioutil.TempDir("", "dbSnapshotDestination")
+	defer func() {
+		_ = os.RemoveAll(destinationDir)
+	}()
+	args := createImporterArgs(destinationDir, exportedManifest.EpochStartMetaBlockHash)
+	args.DbPathWithChainID = filepath.Join(destinationDir, "db", "another chain")
+	imp, _ := dbSnapshot.NewImporter(args)
+
+	manifest, err := imp.Import(archive)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, dbSnapshot.ErrChainIDMismatch))
+}
+
+func TestImporter_ImportUntrustedSnapshotShouldErr(t *testing.T) {
+	t.Parallel()
+
+	archive, _ := exportTestDatabase(t, nil)
+
+	destinationDir, _ := ioutil.TempDir("", "dbSnapshotDestination")
+	defer func() {
+		_ = os.RemoveAll(destinationDir)
+	}()
+	args := createImporterArgs(destinationDir, "aabbcc")
+	imp, _ := dbSnapshot.NewImporter(args)
+
+	manifest, err := imp.Import(archive)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, dbSnapshot.ErrUntrustedMetaBlock))
+	assert.False(t, core.DoesFileExist(args.DbPathWithChainID))
+}
+
+func TestImporter_ImportTooLargeSnapshotShouldErrAndCleanup(t *testing.T) {
+	t.Parallel()
+
+	archive, exportedManifest := exportTestDatabase(t, nil)
+
+	destinationDir, _ := ioutil.TempDir("", "dbSnapshotDestination")
+	defer func() {
+		_ = os.RemoveAll(destinationDir)
+	}()
+	args := createImporterArgs(destinationDir, exportedManifest.EpochStartMetaBlockHash)
+	args.MaxUnpackedBytes = 100
+	imp, _ := dbSnapshot.NewImporter(args)
+
+	manifest, err := imp.Import(archive)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, dbSnapshot.ErrUnpackedSizeTooLarge))
+	assert.False(t, core.DoesFileExist(args.DbPathWithChainID))
+}
+
+func TestImporter_ImportEntryOutsideTheDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	archive := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	manifestBytes, _ := json.Marshal(&dbSnapshot.Manifest{
+		Version:                 dbSnapshot.ManifestVersion,
+		ChainID:                 testChainID,
+		EpochStartMetaBlockHash: "aa",
+	})
+	writeArchiveEntry(tarWriter, "manifest.json", manifestBytes)
+	writeArchiveEntry(tarWriter, "../escaped", []byte("data"))
+	_ = tarWriter.Close()
+	_ = gzipWriter.Close()
+
+	destinationDir, _ := ioutil.TempDir("", "dbSnapshotDestination")
+	defer func() {
+		_ = os.RemoveAll(destinationDir)
+	}()
+	args := createImporterArgs(destinationDir, "aa")
+	imp, _ := dbSnapshot.NewImporter(args)
+
+	manifest, err := imp.Import(archive)
+	assert.Nil(t, manifest)
+	assert.True(t, errors.Is(err, dbSnapshot.ErrInvalidArchiveEntry))
+	assert.False(t, core.DoesFileExist(filepath.Join(destinationDir, "db", "escaped")))
+}
@@ -0,0 +1,22 @@
+package dbSnapshot
+
+// ManifestVersion is the version of the snapshot archive format
+const ManifestVersion = 1
+
+const manifestFileName = "manifest.json"
+
+// AccountsTrie and PeerAccountsTrie are the names of the tries whose root hashes are recorded in the manifest
+const (
+	AccountsTrie     = "accounts"
+	PeerAccountsTrie = "peerAccounts"
+)
+
+// Manifest describes the content of a snapshot archive. It is the first entry of the archive
+type Manifest struct {
+	Version                 uint32            `json:"version"`
+	ChainID                 string            `json:"chainID"`
+	ShardID                 string            `json:"shardID"`
+	Epoch                   uint32            `json:"epoch"`
+	EpochStartMetaBlockHash string            `json:"epochStartMetaBlockHash"`
+	RootHashes              map[string]string `json:"rootHashes"`
+}
@@ -0,0 +1,53 @@
+package dbSnapshot
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ data.DBWriteCacher = (*trieDatabase)(nil)
+
+// trieDatabase searches the trie nodes in the trie main storage and in all its snapshots
+type trieDatabase struct {
+	persisters []storage.Persister
+}
+
+// Put returns ErrReadOnlyDatabase as the trie is only read during the validation
+func (td *trieDatabase) Put(_, _ []byte) error {
+	return ErrReadOnlyDatabase
+}
+
+// Get returns the value from the first persister that holds the key
+func (td *trieDatabase) Get(key []byte) ([]byte, error) {
+	for _, persister := range td.persisters {
+		val, err := persister.Get(key)
+		if err == nil {
+			return val, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Remove returns ErrReadOnlyDatabase as the trie is only read during the validation
+func (td *trieDatabase) Remove(_ []byte) error {
+	return ErrReadOnlyDatabase
+}
+
+// Close closes all the persisters
+func (td *trieDatabase) Close() error {
+	var lastErr error
+	for _, persister := range td.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (td *trieDatabase) IsInterfaceNil() bool {
+	return td == nil
+}
@@ -13,12 +13,21 @@ var ErrNilCacher = errors.New("expected not nil cacher")
 // ErrNilBloomFilter is raised when a nil bloom filter is provided
 var ErrNilBloomFilter = errors.New("expected not nil bloom filter")
 
+// ErrNotSupportedBloomFilterType is used when an unsupported bloom filter type is provided
+var ErrNotSupportedBloomFilterType = errors.New("bloom filter type not supported")
+
+// ErrInvalidBloomFilterData signals that the serialized bloom filter data does not match the filter configuration
+var ErrInvalidBloomFilterData = errors.New("invalid bloom filter data")
+
 // ErrNotSupportedCacheType is raised when an unsupported cache type is provided
 var ErrNotSupportedCacheType = errors.New("not supported cache type")
 
 // ErrNotSupportedDBType is raised when an unsupported database type is provided
 var ErrNotSupportedDBType = errors.New("not supported db type")
 
+// ErrNotSupportedCompressionType is raised when an unsupported compression type is provided
+var ErrNotSupportedCompressionType = errors.New("not supported compression type")
+
 // ErrNotSupportedHashType is raised when an unsupported hasher is provided
 var ErrNotSupportedHashType = errors.New("hash type not supported")
 
@@ -28,6 +37,9 @@ var ErrKeyNotFound = errors.New("key not found")
 // ErrSerialDBIsClosed is raised when the serialDB is closed
 var ErrSerialDBIsClosed = errors.New("serialDB is closed")
 
+// ErrDBIsClosed is raised when the DB is closed
+var ErrDBIsClosed = errors.New("DB is closed")
+
 // ErrInvalidBatch is raised when the used batch is invalid
 var ErrInvalidBatch = errors.New("batch is invalid")
 
@@ -79,6 +91,9 @@ var ErrInvalidNumberOfEpochsToSave = errors.New("invalid number of epochs to sav
 // ErrInvalidNumberOfActivePersisters signals that an invalid number of active persisters has been provided
 var ErrInvalidNumberOfActivePersisters = errors.New("invalid number of active persisters")
 
+// ErrInvalidNumberOfEpochsBeforeArchiving signals that an invalid number of epochs before archiving has been provided
+var ErrInvalidNumberOfEpochsBeforeArchiving = errors.New("invalid number of epochs before archiving")
+
 // ErrClosingPersisters signals that not all persisters were closed
 var ErrClosingPersisters = errors.New("cannot close all the persisters")
 
@@ -27,6 +27,7 @@ func GetDBFromConfig(cfg config.DBConfig) storageUnit.DBConfig {
 		MaxBatchSize:      cfg.MaxBatchSize,
 		BatchDelaySeconds: cfg.BatchDelaySeconds,
 		MaxOpenFiles:      cfg.MaxOpenFiles,
+		Compression:       storageUnit.CompressionType(cfg.Compression),
 	}
 }
 
@@ -43,5 +44,6 @@ func GetBloomFromConfig(cfg config.BloomFilterConfig) storageUnit.BloomConfig {
 	return storageUnit.BloomConfig{
 		Size:     cfg.Size,
 		HashFunc: hashFuncs,
+		Type:     storageUnit.BloomType(cfg.Type),
 	}
 }
@@ -51,6 +51,14 @@ func (dp *disabledPersister) DestroyClosed() error {
 func (dp *disabledPersister) RangeKeys(handler func(key []byte, val []byte) bool) {
 }
 
+// RangeKeysWithPrefix does nothing
+func (dp *disabledPersister) RangeKeysWithPrefix(_ []byte, _ bool, _ func(key []byte, val []byte) bool) {
+}
+
+// RangeKeysFrom does nothing
+func (dp *disabledPersister) RangeKeysFrom(_ []byte, _ []byte, _ bool, _ func(key []byte, val []byte) bool) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dp *disabledPersister) IsInterfaceNil() bool {
 	return dp == nil
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -16,6 +17,7 @@ type PersisterFactory struct {
 	batchDelaySeconds int
 	maxBatchSize      int
 	maxOpenFiles      int
+	compression       string
 }
 
 // NewPersisterFactory will return a new instance of a PersisterFactory
@@ -25,6 +27,7 @@ func NewPersisterFactory(config config.DBConfig) *PersisterFactory {
 		batchDelaySeconds: config.BatchDelaySeconds,
 		maxBatchSize:      config.MaxBatchSize,
 		maxOpenFiles:      config.MaxOpenFiles,
+		compression:       config.Compression,
 	}
 }
 
@@ -34,6 +37,15 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 		return nil, errors.New("invalid file path")
 	}
 
+	db, err := pf.createDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return storageUnit.NewCompressedDB(db, storageUnit.CompressionType(pf.compression))
+}
+
+func (pf *PersisterFactory) createDB(path string) (storage.Persister, error) {
 	switch storageUnit.DBType(pf.dbType) {
 	case storageUnit.LvlDB:
 		return leveldb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
@@ -41,6 +53,8 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 		return leveldb.NewSerialDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
 	case storageUnit.MemoryDB:
 		return memorydb.New(), nil
+	case storageUnit.BadgerDB:
+		return badgerdb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize)
 	default:
 		return nil, storage.ErrNotSupportedDBType
 	}
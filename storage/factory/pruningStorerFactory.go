@@ -47,6 +47,10 @@ func NewStorageServiceFactory(
 	if config.StoragePruning.NumActivePersisters < minimumNumberOfActivePersisters {
 		return nil, storage.ErrInvalidNumberOfActivePersisters
 	}
+	isArchivingEnabled := len(config.StoragePruning.ArchivePath) > 0
+	if isArchivingEnabled && config.StoragePruning.NumEpochsBeforeArchiving < config.StoragePruning.NumActivePersisters {
+		return nil, storage.ErrInvalidNumberOfEpochsBeforeArchiving
+	}
 	if check.IfNil(shardCoordinator) {
 		return nil, storage.ErrNilShardCoordinator
 	}
@@ -467,6 +471,24 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	*createdStorers = append(*createdStorers, epochByHashUnit)
 	chainStorer.AddStorer(dataRetriever.EpochByHashUnit, epochByHashUnit)
 
+	if !psf.generalConfig.DbLookupExtensions.TransactionsByAddressEnabled {
+		return nil
+	}
+
+	// Create the transactionsByAddress (STATIC) storer
+	transactionsByAddressConfig := psf.generalConfig.DbLookupExtensions.TransactionsByAddressStorageConfig
+	transactionsByAddressDbConfig := GetDBFromConfig(transactionsByAddressConfig.DB)
+	transactionsByAddressDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, transactionsByAddressConfig.DB.FilePath)
+	transactionsByAddressCacherConfig := GetCacherFromConfig(transactionsByAddressConfig.Cache)
+	transactionsByAddressBloomFilter := GetBloomFromConfig(transactionsByAddressConfig.Bloom)
+	transactionsByAddressUnit, err := storageUnit.NewStorageUnitFromConf(transactionsByAddressCacherConfig, transactionsByAddressDbConfig, transactionsByAddressBloomFilter)
+	if err != nil {
+		return err
+	}
+
+	*createdStorers = append(*createdStorers, transactionsByAddressUnit)
+	chainStorer.AddStorer(dataRetriever.TransactionsByAddressUnit, transactionsByAddressUnit)
+
 	return nil
 }
 
@@ -490,6 +512,8 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(storageConfig config.S
 		BloomFilterConf:           GetBloomFromConfig(storageConfig.Bloom),
 		NumOfEpochsToKeep:         numOfEpochsToKeep,
 		NumOfActivePersisters:     numOfActivePersisters,
+		ArchiveDbPath:             psf.generalConfig.StoragePruning.ArchivePath,
+		NumOfEpochsBeforeArchive:  uint32(psf.generalConfig.StoragePruning.NumEpochsBeforeArchiving),
 		Notifier:                  psf.epochStartNotifier,
 		MaxBatchSize:              storageConfig.DB.MaxBatchSize,
 		EnabledDbLookupExtensions: psf.generalConfig.DbLookupExtensions.Enabled,
@@ -25,6 +25,13 @@ type Persister interface {
 	// DestroyClosed removes the already closed persistence medium stored data
 	DestroyClosed() error
 	RangeKeys(handler func(key []byte, val []byte) bool)
+	// RangeKeysWithPrefix iterates, in the keys order or in the reversed keys order, over the (key, value) pairs
+	// whose keys start with the provided prefix. The iteration stops when the handler returns false
+	RangeKeysWithPrefix(prefix []byte, reverse bool, handler func(key []byte, val []byte) bool)
+	// RangeKeysFrom iterates, in the keys order or in the reversed keys order, over the (key, value) pairs whose keys
+	// are in the [start, end) interval. A nil start or end means that the interval is not bounded on that side.
+	// The iteration stops when the handler returns false
+	RangeKeysFrom(start []byte, end []byte, reverse bool, handler func(key []byte, val []byte) bool)
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -91,6 +98,16 @@ type BloomFilter interface {
 	IsInterfaceNil() bool
 }
 
+// CountingBloomFilter is a bloom filter which supports removing values and can be serialized, so it
+// can be saved when the storage unit is closed and loaded when it is opened again
+type CountingBloomFilter interface {
+	BloomFilter
+	// Remove removes a previously added value from the bloom filter
+	Remove([]byte)
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary(data []byte) error
+}
+
 // Storer provides storage services in a two layered storage construct, where the first layer is
 // represented by a cache and second layer by a persitent storage (DB-like)
 type Storer interface {
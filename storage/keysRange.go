@@ -0,0 +1,34 @@
+package storage
+
+import "bytes"
+
+// IsKeyInRange returns true if the key is in the [start, end) interval. A nil start or end means that the interval
+// is not bounded on that side
+func IsKeyInRange(key []byte, start []byte, end []byte) bool {
+	if start != nil && bytes.Compare(key, start) < 0 {
+		return false
+	}
+	if end != nil && bytes.Compare(key, end) >= 0 {
+		return false
+	}
+
+	return true
+}
+
+// PrefixUpperBound returns the smallest key which is greater than all the keys starting with the provided prefix.
+// It returns nil if there is no such key, as it happens for an empty prefix or a prefix made only of 0xFF bytes
+func PrefixUpperBound(prefix []byte) []byte {
+	for idx := len(prefix) - 1; idx >= 0; idx-- {
+		if prefix[idx] == 0xFF {
+			continue
+		}
+
+		upperBound := make([]byte, idx+1)
+		copy(upperBound, prefix)
+		upperBound[idx]++
+
+		return upperBound
+	}
+
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const resourceUnavailable = "resource temporarily unavailable"
@@ -101,3 +103,54 @@ func (bldb *baseLevelDb) RangeKeys(handler func(key []byte, value []byte) bool)
 
 	iterator.Release()
 }
+
+// RangeKeysWithPrefix will call the handler function for each (key, value) pair whose key starts with the prefix,
+// in the keys order or in the reversed keys order. If the handler returns true, the iteration will continue,
+// otherwise will stop
+func (bldb *baseLevelDb) RangeKeysWithPrefix(prefix []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	bldb.rangeKeysInSlice(util.BytesPrefix(prefix), reverse, handler)
+}
+
+// RangeKeysFrom will call the handler function for each (key, value) pair whose key is in the [start, end)
+// interval, in the keys order or in the reversed keys order. A nil start or end means that the interval is not
+// bounded on that side. If the handler returns true, the iteration will continue, otherwise will stop
+func (bldb *baseLevelDb) RangeKeysFrom(start []byte, end []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	bldb.rangeKeysInSlice(&util.Range{Start: start, Limit: end}, reverse, handler)
+}
+
+func (bldb *baseLevelDb) rangeKeysInSlice(slice *util.Range, reverse bool, handler func(key []byte, value []byte) bool) {
+	it := bldb.db.NewIterator(slice, nil)
+	defer it.Release()
+
+	moveFirst, moveNext := it.First, it.Next
+	if reverse {
+		moveFirst, moveNext = it.Last, it.Prev
+	}
+
+	for ok := moveFirst(); ok; ok = moveNext() {
+		shouldContinue := handler(cloneKeyValue(it))
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+func cloneKeyValue(it iterator.Iterator) ([]byte, []byte) {
+	key := it.Key()
+	clonedKey := make([]byte, len(key))
+	copy(clonedKey, key)
+
+	val := it.Value()
+	clonedVal := make([]byte, len(val))
+	copy(clonedVal, val)
+
+	return clonedKey, clonedVal
+}
@@ -195,3 +195,25 @@ func TestSerialDB_Destroy(t *testing.T) {
 
 	assert.Nil(t, err, "no error expected but got %s", err)
 }
+
+func TestSerialDB_RangeKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	ldb := createSerialLevelDb(t, 1, 1, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	testRangeKeysWithPrefix(t, ldb)
+}
+
+func TestSerialDB_RangeKeysFrom(t *testing.T) {
+	t.Parallel()
+
+	ldb := createSerialLevelDb(t, 1, 1, 10)
+	defer func() {
+		_ = ldb.Destroy()
+	}()
+
+	testRangeKeysFrom(t, ldb)
+}
@@ -106,6 +106,40 @@ func (l *lruDB) RangeKeys(handler func(key []byte, value []byte) bool) {
 	}
 }
 
+// RangeKeysWithPrefix will iterate over the (key, value) pairs whose keys start with the prefix, in the keys order
+// or in the reversed keys order, calling the provided handler
+func (l *lruDB) RangeKeysWithPrefix(prefix []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	l.RangeKeysFrom(prefix, storage.PrefixUpperBound(prefix), reverse, handler)
+}
+
+// RangeKeysFrom will iterate over the (key, value) pairs whose keys are in the [start, end) interval, in the keys
+// order or in the reversed keys order, calling the provided handler. The iteration does not change the keys recency
+func (l *lruDB) RangeKeysFrom(start []byte, end []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	pairs := make([]keyValuePair, 0)
+	for _, k := range l.cacher.Keys() {
+		if !storage.IsKeyInRange(k, start, end) {
+			continue
+		}
+
+		v, ok := l.cacher.Peek(k)
+		if !ok {
+			continue
+		}
+		vBuff, ok := v.([]byte)
+		if !ok {
+			continue
+		}
+
+		pairs = append(pairs, keyValuePair{key: k, val: vBuff})
+	}
+
+	rangeSortedPairs(pairs, reverse, handler)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (l *lruDB) IsInterfaceNil() bool {
 	return l == nil
@@ -163,3 +163,17 @@ func TestLruDB_RangeKeys(t *testing.T) {
 
 	assert.Equal(t, keysVals, recovered)
 }
+
+func TestLruDB_RangeKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	lru, _ := memorydb.NewlruDB(100)
+	testRangeKeysWithPrefix(t, lru)
+}
+
+func TestLruDB_RangeKeysFrom(t *testing.T) {
+	t.Parallel()
+
+	lru, _ := memorydb.NewlruDB(100)
+	testRangeKeysFrom(t, lru)
+}
@@ -44,7 +44,7 @@ func (s *DB) Get(key []byte) ([]byte, error) {
 	val, ok := s.db[string(key)]
 
 	if !ok {
-		return nil, fmt.Errorf("key: %s not found", base64.StdEncoding.EncodeToString(key))
+		return nil, fmt.Errorf("%w: %s", storage.ErrKeyNotFound, base64.StdEncoding.EncodeToString(key))
 	}
 
 	return val, nil
@@ -112,6 +112,32 @@ func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
 	}
 }
 
+// RangeKeysWithPrefix will iterate over the (key, value) pairs whose keys start with the prefix, in the keys order
+// or in the reversed keys order, calling the provided handler
+func (s *DB) RangeKeysWithPrefix(prefix []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	s.RangeKeysFrom(prefix, storage.PrefixUpperBound(prefix), reverse, handler)
+}
+
+// RangeKeysFrom will iterate over the (key, value) pairs whose keys are in the [start, end) interval, in the keys
+// order or in the reversed keys order, calling the provided handler
+func (s *DB) RangeKeysFrom(start []byte, end []byte, reverse bool, handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutx.RLock()
+	pairs := make([]keyValuePair, 0)
+	for k, v := range s.db {
+		key := []byte(k)
+		if storage.IsKeyInRange(key, start, end) {
+			pairs = append(pairs, keyValuePair{key: key, val: v})
+		}
+	}
+	s.mutx.RUnlock()
+
+	rangeSortedPairs(pairs, reverse, handler)
+}
+
 // DestroyClosed removes the storage medium stored data
 func (s *DB) DestroyClosed() error {
 	return s.Destroy()
@@ -1,10 +1,13 @@
 package memorydb_test
 
 import (
+	"encoding/binary"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInitNoError(t *testing.T) {
@@ -127,3 +130,94 @@ func Test_RangeKeys(t *testing.T) {
 
 	assert.Equal(t, keysVals, recovered)
 }
+
+func TestDB_RangeKeysWithPrefix(t *testing.T) {
+	t.Parallel()
+
+	testRangeKeysWithPrefix(t, memorydb.New())
+}
+
+func TestDB_RangeKeysFrom(t *testing.T) {
+	t.Parallel()
+
+	testRangeKeysFrom(t, memorydb.New())
+}
+
+func putRangeTestData(t *testing.T, persister storage.Persister) {
+	keys := [][]byte{[]byte("a1"), []byte("a2"), []byte("a3"), []byte("b1"), []byte("b2")}
+	for nonce := uint64(1); nonce <= 10; nonce++ {
+		keys = append(keys, nonceKey(nonce))
+	}
+
+	for _, key := range keys {
+		require.Nil(t, persister.Put(key, append([]byte("val-"), key...)))
+	}
+}
+
+func nonceKey(nonce uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, nonce)
+
+	return key
+}
+
+func collectRangedKeys(t *testing.T, rangeFunc func(handler func(key []byte, val []byte) bool), maxKeys int) [][]byte {
+	keys := make([][]byte, 0)
+	rangeFunc(func(key []byte, val []byte) bool {
+		assert.Equal(t, append([]byte("val-"), key...), val)
+		keys = append(keys, key)
+		return len(keys) < maxKeys
+	})
+
+	return keys
+}
+
+func testRangeKeysWithPrefix(t *testing.T, persister storage.Persister) {
+	putRangeTestData(t, persister)
+
+	keys := collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysWithPrefix([]byte("a"), false, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{[]byte("a1"), []byte("a2"), []byte("a3")}, keys)
+
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysWithPrefix([]byte("b"), true, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{[]byte("b2"), []byte("b1")}, keys)
+
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysWithPrefix([]byte("c"), false, handler)
+	}, 100)
+	assert.Empty(t, keys)
+}
+
+func testRangeKeysFrom(t *testing.T, persister storage.Persister) {
+	putRangeTestData(t, persister)
+
+	keys := collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysFrom(nonceKey(3), nonceKey(7), false, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{nonceKey(3), nonceKey(4), nonceKey(5), nonceKey(6)}, keys)
+
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysFrom(nonceKey(3), nonceKey(7), true, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{nonceKey(6), nonceKey(5), nonceKey(4), nonceKey(3)}, keys)
+
+	// unbounded end, stopped by the handler
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysFrom(nonceKey(9), nil, false, handler)
+	}, 3)
+	assert.Equal(t, [][]byte{nonceKey(9), nonceKey(10), []byte("a1")}, keys)
+
+	// unbounded start, in reverse order
+	keys = collectRangedKeys(t, func(handler func(key []byte, val []byte) bool) {
+		persister.RangeKeysFrom(nil, nonceKey(3), true, handler)
+	}, 100)
+	assert.Equal(t, [][]byte{nonceKey(2), nonceKey(1)}, keys)
+
+	assert.NotPanics(t, func() {
+		persister.RangeKeysFrom(nil, nil, false, nil)
+		persister.RangeKeysWithPrefix(nil, false, nil)
+	})
+}
@@ -0,0 +1,31 @@
+package memorydb
+
+import (
+	"bytes"
+	"sort"
+)
+
+type keyValuePair struct {
+	key []byte
+	val []byte
+}
+
+// rangeSortedPairs calls the handler for each provided (key, value) pair, in the keys order or in the reversed
+// keys order, until the handler returns false
+func rangeSortedPairs(pairs []keyValuePair, reverse bool, handler func(key []byte, value []byte) bool) {
+	sort.Slice(pairs, func(i, j int) bool {
+		comparison := bytes.Compare(pairs[i].key, pairs[j].key)
+		if reverse {
+			return comparison > 0
+		}
+
+		return comparison < 0
+	})
+
+	for _, pair := range pairs {
+		shouldContinue := handler(pair.key, pair.val)
+		if !shouldContinue {
+			return
+		}
+	}
+}
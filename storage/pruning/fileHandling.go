@@ -7,6 +7,9 @@ import (
 	"strings"
 )
 
+// numArchivedPathElements is the number of trailing persister path elements kept inside the archive directory
+const numArchivedPathElements = 4
+
 // removeDirectoryIfEmpty will clean the directories after all persisters for one epoch were destroyed
 // the structure is this way :
 // workspace/db/Epoch_X/Shard_Y/DbName
@@ -52,3 +55,85 @@ func isDirectoryEmpty(name string) bool {
 	_, err = f.Readdirnames(1) // Or f.Readdir(1)
 	return err == io.EOF
 }
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// archivePathFor returns the path of a persister inside the archive directory, keeping the last 4 elements
+// of the persister path: ChainID/Epoch_X/Shard_Y/DbName, so that the archives of different chains do not collide
+func archivePathFor(archiveDbPath string, path string) string {
+	elementsSplitBySeparator := strings.Split(filepath.Clean(path), string(os.PathSeparator))
+	if len(elementsSplitBySeparator) > numArchivedPathElements {
+		elementsSplitBySeparator = elementsSplitBySeparator[len(elementsSplitBySeparator)-numArchivedPathElements:]
+	}
+
+	return filepath.Join(append([]string{archiveDbPath}, elementsSplitBySeparator...)...)
+}
+
+// moveDirectory moves the source directory to the destination. If a simple rename is not possible, as it happens
+// when the destination is located on another volume, the files are copied and the source directory is removed
+func moveDirectory(source string, destination string) error {
+	err := os.MkdirAll(filepath.Dir(destination), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(source, destination)
+	if err == nil {
+		return nil
+	}
+
+	log.Debug("rename directory failed, copying the files", "source", source, "error", err.Error())
+	err = copyDirectory(source, destination)
+	if err != nil {
+		_ = os.RemoveAll(destination)
+		return err
+	}
+
+	return os.RemoveAll(source)
+}
+
+func copyDirectory(source string, destination string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		destinationPath := filepath.Join(destination, relativePath)
+
+		if info.IsDir() {
+			return os.MkdirAll(destinationPath, info.Mode())
+		}
+
+		return copyFile(path, destinationPath, info.Mode())
+	})
+}
+
+func copyFile(source string, destination string, mode os.FileMode) error {
+	sourceFile, err := os.Open(filepath.Clean(source))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sourceFile.Close()
+	}()
+
+	destinationFile, err := os.OpenFile(destination, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destinationFile, sourceFile)
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	return destinationFile.Close()
+}
@@ -1,12 +1,15 @@
 package pruning
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
-	"runtime/debug"
+	"os"
+	"sort"
 	"sync"
+	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -22,6 +25,9 @@ var _ storage.Storer = (*PruningStorer)(nil)
 
 var log = logger.GetOrCreate("storage/pruning")
 
+// rangeKeysBatchSize is the maximum number of (key, value) pairs read from a persister while its range lock is held
+const rangeKeysBatchSize = 1000
+
 // maxNumEpochsToKeepIfAShardIsStuck represents the maximum number of epochs to be kept active if a shard remains stuck
 // and requires data from older epochs
 const maxNumEpochsToKeepIfAShardIsStuck = 5
@@ -36,7 +42,18 @@ type persisterData struct {
 	path        string
 	epoch       uint32
 	isClosed    bool
+	isShallow   bool
+	isArchived  bool
 	mutIsClosed sync.RWMutex
+	mutPath     sync.RWMutex
+	// mutRange is held for reading while a batch of the persister is iterated or while it is temporarily opened, so
+	// that it will not be closed, destroyed or archived in the middle of an operation
+	mutRange sync.RWMutex
+}
+
+type keyValuePair struct {
+	key []byte
+	val []byte
 }
 
 func (pd *persisterData) getIsClosed() bool {
@@ -52,25 +69,49 @@ func (pd *persisterData) setIsClosed(closed bool) {
 	pd.mutIsClosed.Unlock()
 }
 
+func (pd *persisterData) getPath() string {
+	pd.mutPath.RLock()
+	defer pd.mutPath.RUnlock()
+
+	return pd.path
+}
+
+func (pd *persisterData) getIsArchived() bool {
+	pd.mutPath.RLock()
+	defer pd.mutPath.RUnlock()
+
+	return pd.isArchived
+}
+
+func (pd *persisterData) setArchivedPath(path string) {
+	pd.mutPath.Lock()
+	pd.path = path
+	pd.isArchived = true
+	pd.mutPath.Unlock()
+}
+
 // PruningStorer represents a storer which creates a new persister for each epoch and removes older activePersisters
 type PruningStorer struct {
-	lock                  sync.RWMutex
-	shardCoordinator      storage.ShardCoordinator
-	activePersisters      []*persisterData
-	persistersMapByEpoch  map[uint32]*persisterData
-	cacher                storage.Cacher
-	bloomFilter           storage.BloomFilter
-	pathManager           storage.PathManagerHandler
-	dbPath                string
-	persisterFactory      DbFactoryHandler
-	mutEpochPrepareHdr    sync.RWMutex
-	epochPrepareHdr       *block.MetaBlock
-	identifier            string
-	numOfEpochsToKeep     uint32
-	numOfActivePersisters uint32
-	epochForPutOperation  uint32
-	cleanOldEpochsData    bool
-	pruningEnabled        bool
+	lock                     sync.RWMutex
+	shardCoordinator         storage.ShardCoordinator
+	activePersisters         []*persisterData
+	persistersMapByEpoch     map[uint32]*persisterData
+	cacher                   storage.Cacher
+	bloomFilter              storage.BloomFilter
+	pathManager              storage.PathManagerHandler
+	dbPath                   string
+	persisterFactory         DbFactoryHandler
+	mutEpochPrepareHdr       sync.RWMutex
+	epochPrepareHdr          *block.MetaBlock
+	identifier               string
+	numOfEpochsToKeep        uint32
+	numOfActivePersisters    uint32
+	epochForPutOperation     uint32
+	cleanOldEpochsData       bool
+	pruningEnabled           bool
+	metrics                  *storage.StorerMetrics
+	archiveDbPath            string
+	numOfEpochsBeforeArchive uint32
 }
 
 // NewPruningStorer will return a new instance of PruningStorer without sharded directories' naming scheme
@@ -138,21 +179,24 @@ func initPruningStorer(
 	}
 
 	pdb := &PruningStorer{
-		pruningEnabled:        args.PruningEnabled,
-		identifier:            identifier,
-		cleanOldEpochsData:    args.CleanOldEpochsData,
-		activePersisters:      persisters,
-		persisterFactory:      args.PersisterFactory,
-		shardCoordinator:      args.ShardCoordinator,
-		persistersMapByEpoch:  persistersMapByEpoch,
-		cacher:                cache,
-		epochPrepareHdr:       &block.MetaBlock{Epoch: epochForDefaultEpochPrepareHdr},
-		bloomFilter:           nil,
-		epochForPutOperation:  args.StartingEpoch,
-		pathManager:           args.PathManager,
-		dbPath:                args.DbPath,
-		numOfEpochsToKeep:     args.NumOfEpochsToKeep,
-		numOfActivePersisters: args.NumOfActivePersisters,
+		pruningEnabled:           args.PruningEnabled,
+		identifier:               identifier,
+		cleanOldEpochsData:       args.CleanOldEpochsData,
+		activePersisters:         persisters,
+		persisterFactory:         args.PersisterFactory,
+		shardCoordinator:         args.ShardCoordinator,
+		persistersMapByEpoch:     persistersMapByEpoch,
+		cacher:                   cache,
+		epochPrepareHdr:          &block.MetaBlock{Epoch: epochForDefaultEpochPrepareHdr},
+		bloomFilter:              nil,
+		epochForPutOperation:     args.StartingEpoch,
+		pathManager:              args.PathManager,
+		dbPath:                   args.DbPath,
+		numOfEpochsToKeep:        args.NumOfEpochsToKeep,
+		numOfActivePersisters:    args.NumOfActivePersisters,
+		metrics:                  storage.GetOrCreateStorerMetrics(metricsName(args, shardIDStr)),
+		archiveDbPath:            args.ArchiveDbPath,
+		numOfEpochsBeforeArchive: args.NumOfEpochsBeforeArchive,
 	}
 
 	if args.BloomFilterConf.Size != 0 { // if size is 0, that means an empty config was used so bloom filter will be nil
@@ -169,6 +213,17 @@ func initPruningStorer(
 	return pdb, nil
 }
 
+// metricsName returns the name under which the storer metrics are published. The cache name is used, as the storage
+// units created from config do, with the shard suffix for the sharded storers
+func metricsName(args *StorerArgs, shardIDStr string) string {
+	name := args.CacheConf.Name
+	if len(name) == 0 {
+		name = args.Identifier
+	}
+
+	return name + shardIDStr
+}
+
 func initPersistersInEpoch(
 	args *StorerArgs,
 	shardIDStr string,
@@ -241,6 +296,11 @@ func initPersistersInEpoch(
 
 // Put adds data to both cache and persistence medium and updates the bloom filter
 func (ps *PruningStorer) Put(key, data []byte) error {
+	start := time.Now()
+	defer func() {
+		ps.metrics.AddPut(time.Since(start))
+	}()
+
 	ps.cacher.Put(key, data, len(data))
 
 	ps.lock.RLock()
@@ -275,6 +335,11 @@ func (ps *PruningStorer) doPutInPersister(key, data []byte, persister storage.Pe
 
 // PutInEpoch adds data to specified epoch
 func (ps *PruningStorer) PutInEpoch(key, data []byte, epoch uint32) error {
+	start := time.Now()
+	defer func() {
+		ps.metrics.AddPut(time.Since(start))
+	}()
+
 	ps.cacher.Put(key, data, len(data))
 
 	ps.lock.RLock()
@@ -300,11 +365,23 @@ func (ps *PruningStorer) createAndInitPersisterIfClosed(pd *persisterData) (stor
 		return pd.persister, noopClose, nil
 	}
 
-	return ps.createAndInitPersister(pd)
+	pd.mutRange.RLock()
+	persister, closePersister, err := ps.createAndInitPersister(pd)
+	if err != nil {
+		pd.mutRange.RUnlock()
+		return nil, nil, err
+	}
+
+	closeAndUnlock := func() {
+		closePersister()
+		pd.mutRange.RUnlock()
+	}
+
+	return persister, closeAndUnlock, nil
 }
 
 func (ps *PruningStorer) createAndInitPersister(pd *persisterData) (storage.Persister, func(), error) {
-	persister, err := ps.persisterFactory.Create(pd.path)
+	persister, err := ps.persisterFactory.Create(pd.getPath())
 	if err != nil {
 		log.Warn("createAndInitPersister()", "error", err.Error())
 		return nil, nil, err
@@ -330,35 +407,47 @@ func (ps *PruningStorer) createAndInitPersister(pd *persisterData) (storage.Pers
 // Get searches the key in the cache. In case it is not found, it verifies with the bloom filter
 // if the key may be in the db. If bloom filter confirms then it further searches in the databases.
 func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
+	start := time.Now()
+	defer func() {
+		ps.metrics.AddGet(time.Since(start))
+	}()
+
 	v, ok := ps.cacher.Get(key)
 	var err error
 
-	if !ok {
+	if ok {
+		ps.metrics.IncrementCacheHits()
+	} else {
 		// not found in cache
 		// search it in active persisters
 		found := false
+		mayContain := ps.bloomFilter == nil || ps.bloomFilter.MayContain(key)
 		ps.lock.RLock()
-		for idx := uint32(0); (idx < ps.numOfActivePersisters) && (idx < uint32(len(ps.activePersisters))); idx++ {
-			if ps.bloomFilter == nil || ps.bloomFilter.MayContain(key) {
-				v, err = ps.activePersisters[idx].persister.Get(key)
-				if err != nil {
-					continue
-				}
-
-				buff, isByteSlice := v.([]byte)
-				if !isByteSlice {
-					continue
-				}
+		for idx := uint32(0); mayContain && (idx < ps.numOfActivePersisters) && (idx < uint32(len(ps.activePersisters))); idx++ {
+			v, err = ps.activePersisters[idx].persister.Get(key)
+			if err != nil {
+				continue
+			}
 
-				found = true
-				// if found in persistence unit, add it to cache
-				ps.cacher.Put(key, v, len(buff))
-				break
+			buff, isByteSlice := v.([]byte)
+			if !isByteSlice {
+				continue
 			}
+
+			found = true
+			// if found in persistence unit, add it to cache
+			ps.cacher.Put(key, v, len(buff))
+			break
 		}
 		ps.lock.RUnlock()
 
 		if !found {
+			if mayContain {
+				ps.metrics.IncrementPersisterMisses()
+			} else {
+				ps.metrics.IncrementBloomNegatives()
+			}
+
 			return nil, fmt.Errorf("key %s not found in %s",
 				hex.EncodeToString(key), ps.identifier)
 		}
@@ -371,7 +460,9 @@ func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 func (ps *PruningStorer) Close() error {
 	closedSuccessfully := true
 	for _, persister := range ps.activePersisters {
+		persister.mutRange.Lock()
 		err := persister.persister.Close()
+		persister.mutRange.Unlock()
 
 		if err != nil {
 			log.Error("cannot close persister", "error", err)
@@ -390,8 +481,14 @@ func (ps *PruningStorer) Close() error {
 // GetFromEpoch will search a key only in the persister for the given epoch
 func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
 	// TODO: this will be used when requesting from resolvers
+	start := time.Now()
+	defer func() {
+		ps.metrics.AddGet(time.Since(start))
+	}()
+
 	v, ok := ps.cacher.Get(key)
 	if ok {
+		ps.metrics.IncrementCacheHits()
 		return v.([]byte), nil
 	}
 
@@ -399,6 +496,7 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 	pd, exists := ps.persistersMapByEpoch[epoch]
 	ps.lock.RUnlock()
 	if !exists {
+		ps.metrics.IncrementPersisterMisses()
 		return nil, fmt.Errorf("key %s not found in %s",
 			hex.EncodeToString(key), ps.identifier)
 	}
@@ -414,6 +512,7 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 		return res, nil
 	}
 
+	ps.metrics.IncrementPersisterMisses()
 	log.Warn("get from closed persister",
 		"id", ps.identifier,
 		"epoch", epoch,
@@ -445,14 +544,19 @@ func (ps *PruningStorer) GetBulkFromEpoch(keys [][]byte, epoch uint32) (map[stri
 
 	returnMap := make(map[string][]byte)
 	for _, key := range keys {
+		start := time.Now()
 		v, ok := ps.cacher.Get(key)
 		if ok {
+			ps.metrics.IncrementCacheHits()
+			ps.metrics.AddGet(time.Since(start))
 			returnMap[string(key)] = v.([]byte)
 			continue
 		}
 
 		res, errGet := persisterToRead.Get(key)
+		ps.metrics.AddGet(time.Since(start))
 		if errGet != nil {
+			ps.metrics.IncrementPersisterMisses()
 			log.Warn("cannot get from persister",
 				"hash", hex.EncodeToString(key),
 				"error", errGet.Error(),
@@ -468,8 +572,14 @@ func (ps *PruningStorer) GetBulkFromEpoch(keys [][]byte, epoch uint32) (map[stri
 
 // SearchFirst will search a given key in all the active persisters, from the newest to the oldest
 func (ps *PruningStorer) SearchFirst(key []byte) ([]byte, error) {
+	start := time.Now()
+	defer func() {
+		ps.metrics.AddGet(time.Since(start))
+	}()
+
 	v, ok := ps.cacher.Get(key)
 	if ok {
+		ps.metrics.IncrementCacheHits()
 		return v.([]byte), nil
 	}
 
@@ -485,6 +595,7 @@ func (ps *PruningStorer) SearchFirst(key []byte) ([]byte, error) {
 		}
 	}
 
+	ps.metrics.IncrementPersisterMisses()
 	return nil, fmt.Errorf("%w - SearchFirst, unit = %s, key = %s, num active persisters = %d",
 		storage.ErrKeyNotFound,
 		ps.identifier,
@@ -591,7 +702,9 @@ func (ps *PruningStorer) DestroyUnit() error {
 	numOfPersistersRemoved := 0
 	totalNumOfPersisters := len(ps.persistersMapByEpoch)
 	for _, pd := range ps.persistersMapByEpoch {
-		if pd.getIsClosed() {
+		if pd.getIsArchived() {
+			err = os.RemoveAll(pd.getPath())
+		} else if pd.getIsClosed() {
 			err = pd.persister.DestroyClosed()
 		} else {
 			err = pd.persister.Destroy()
@@ -785,7 +898,7 @@ func (ps *PruningStorer) changeEpochWithExisting(epoch uint32) error {
 
 	for _, p := range persisters {
 		if p.getIsClosed() {
-			_, err = ps.persisterFactory.Create(p.path)
+			err = ps.reopenPersister(p)
 			if err != nil {
 				return err
 			}
@@ -817,12 +930,11 @@ func (ps *PruningStorer) extendActivePersisters(from uint32, to uint32) error {
 	reOpenedPersisters := make([]*persisterData, 0)
 	for _, p := range persisters {
 		if p.getIsClosed() {
-			_, err := ps.persisterFactory.Create(p.path)
+			err := ps.reopenPersister(p)
 			if err != nil {
 				return err
 			}
 			reOpenedPersisters = append(reOpenedPersisters, p)
-			p.setIsClosed(false)
 		}
 	}
 
@@ -833,6 +945,22 @@ func (ps *PruningStorer) extendActivePersisters(from uint32, to uint32) error {
 	return nil
 }
 
+// reopenPersister opens again a closed persister, from its current location, so it can be used as an active persister
+func (ps *PruningStorer) reopenPersister(p *persisterData) error {
+	p.mutRange.Lock()
+	defer p.mutRange.Unlock()
+
+	db, err := ps.persisterFactory.Create(p.getPath())
+	if err != nil {
+		return err
+	}
+
+	p.persister = db
+	p.setIsClosed(false)
+
+	return nil
+}
+
 func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	// activePersisters outside the numOfActivePersisters border have to he closed for both scenarios: full archive or not
 	persistersToClose := make([]*persisterData, 0)
@@ -867,7 +995,9 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	ps.lock.Unlock()
 
 	for _, p := range persistersToClose {
+		p.mutRange.Lock()
 		err := p.persister.Close()
+		p.mutRange.Unlock()
 		if err != nil {
 			log.Error("error closing persister", "error", err.Error(), "id", ps.identifier)
 			return err
@@ -876,21 +1006,296 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	}
 
 	for _, p := range persistersToDestroy {
+		p.mutRange.Lock()
 		err := p.persister.DestroyClosed()
+		p.mutRange.Unlock()
 		if err != nil {
 			return err
 		}
-		removeDirectoryIfEmpty(p.path)
+		removeDirectoryIfEmpty(p.getPath())
+	}
+
+	if ps.isArchivingEnabled() {
+		ps.archiveOldPersisters(epoch)
 	}
 
 	return nil
 }
 
-// RangeKeys does nothing as it is unable to iterate over multiple persisters
-// RangeKeys -
-func (ps *PruningStorer) RangeKeys(_ func(key []byte, val []byte) bool) {
-	log.Error("improper use of PruningStorer.RangeKeys()")
-	debug.PrintStack()
+// isArchivingEnabled returns true if the closed persisters of the old epochs should be moved in the archive
+// directory. The archive is used only if the old epochs data is kept
+func (ps *PruningStorer) isArchivingEnabled() bool {
+	return len(ps.archiveDbPath) > 0 && ps.pruningEnabled && !ps.cleanOldEpochsData
+}
+
+// archiveOldPersisters moves the closed persisters which are older than the configured number of epochs in the
+// archive directory. The archived persisters are still reachable through the persisters map and will be
+// temporarily opened from the archive when needed
+func (ps *PruningStorer) archiveOldPersisters(epoch uint32) {
+	persistersToArchive := make([]*persisterData, 0)
+
+	ps.lock.RLock()
+	for _, pd := range ps.persistersMapByEpoch {
+		isOldEnough := uint64(pd.epoch)+uint64(ps.numOfEpochsBeforeArchive) <= uint64(epoch)
+		if isOldEnough && pd.getIsClosed() && !pd.getIsArchived() {
+			persistersToArchive = append(persistersToArchive, pd)
+		}
+	}
+	ps.lock.RUnlock()
+
+	for _, pd := range persistersToArchive {
+		err := ps.archivePersister(pd)
+		if err != nil {
+			log.Warn("cannot archive persister",
+				"identifier", ps.identifier,
+				"epoch", pd.epoch,
+				"error", err.Error())
+		}
+	}
+}
+
+func (ps *PruningStorer) archivePersister(pd *persisterData) error {
+	pd.mutRange.Lock()
+	defer pd.mutRange.Unlock()
+
+	source := pd.getPath()
+	if !pd.getIsClosed() || !pathExists(source) {
+		return nil
+	}
+
+	destination := archivePathFor(ps.archiveDbPath, source)
+	err := moveDirectory(source, destination)
+	if err != nil {
+		return err
+	}
+
+	pd.setArchivedPath(destination)
+	removeDirectoryIfEmpty(source)
+
+	log.Debug("PruningStorer - persister archived",
+		"identifier", ps.identifier,
+		"epoch", pd.epoch,
+		"path", destination)
+
+	return nil
+}
+
+// RangeKeys iterates over the (key, value) pairs of all the active persisters, starting with the newest epoch.
+// A key present in more than one epoch is provided only once, with the value from the newest epoch. The iteration
+// stops when the handler returns false
+func (ps *PruningStorer) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	ps.lock.RLock()
+	persisters := make([]*persisterData, len(ps.activePersisters))
+	copy(persisters, ps.activePersisters)
+	ps.lock.RUnlock()
+
+	ps.rangeKeysInPersisters(persisters, handler)
+}
+
+// RangeKeysIncludingClosedPersisters does the same as RangeKeys, but it also iterates over the persisters of the
+// older epochs which are closed but still present on disk. Each closed persister is opened once for the whole
+// iteration and closed when the iteration ends
+func (ps *PruningStorer) RangeKeysIncludingClosedPersisters(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	ps.lock.RLock()
+	persisters := make([]*persisterData, 0, len(ps.persistersMapByEpoch)+len(ps.activePersisters))
+	for _, pd := range ps.persistersMapByEpoch {
+		persisters = append(persisters, pd)
+	}
+	for _, pd := range ps.activePersisters {
+		if ps.persistersMapByEpoch[pd.epoch] != pd {
+			persisters = append(persisters, pd)
+		}
+	}
+	ps.lock.RUnlock()
+
+	ps.rangeKeysInPersisters(persisters, handler)
+}
+
+func (ps *PruningStorer) rangeKeysInPersisters(persisters []*persisterData, handler func(key []byte, val []byte) bool) {
+	sort.SliceStable(persisters, func(i, j int) bool {
+		return persisters[i].epoch > persisters[j].epoch
+	})
+
+	opened := ps.newOpenedClosedPersisters()
+	defer opened.closeAll()
+
+	for idx, pd := range persisters {
+		shouldContinue := ps.rangeKeysInPersister(pd, persisters[:idx], opened, handler)
+		if !shouldContinue {
+			return
+		}
+	}
+}
+
+// rangeKeysInPersister iterates over the provided persister in batches of at most rangeKeysBatchSize pairs and
+// returns false if the iteration was stopped by the handler. The keys also found in the newer persisters are skipped.
+// The range lock of the persister is held only while a batch is read, so that an epoch change will not wait for
+// the whole iteration
+func (ps *PruningStorer) rangeKeysInPersister(
+	pd *persisterData,
+	newerPersisters []*persisterData,
+	opened *openedClosedPersisters,
+	handler func(key []byte, val []byte) bool,
+) bool {
+	var startAfterKey []byte
+	for {
+		pairs := ps.readPairsBatch(pd, startAfterKey, opened)
+		if len(pairs) == 0 {
+			return true
+		}
+		startAfterKey = pairs[len(pairs)-1].key
+
+		for _, pair := range ps.removePairsFoundInPersisters(pairs, newerPersisters, opened) {
+			shouldContinue := handler(pair.key, pair.val)
+			if !shouldContinue {
+				return false
+			}
+		}
+
+		if len(pairs) < rangeKeysBatchSize {
+			return true
+		}
+	}
+}
+
+// readPairsBatch reads, in the keys order, at most rangeKeysBatchSize pairs whose keys are greater than the
+// provided key. A nil key means that the batch starts with the first key of the persister
+func (ps *PruningStorer) readPairsBatch(pd *persisterData, startAfterKey []byte, opened *openedClosedPersisters) []keyValuePair {
+	pairs := make([]keyValuePair, 0, rangeKeysBatchSize)
+	opened.use(pd, func(persister storage.Persister) {
+		persister.RangeKeysFrom(startAfterKey, nil, false, func(key []byte, val []byte) bool {
+			if startAfterKey != nil && bytes.Equal(key, startAfterKey) {
+				return true
+			}
+
+			pairs = append(pairs, keyValuePair{key: key, val: val})
+			return len(pairs) < rangeKeysBatchSize
+		})
+	})
+
+	return pairs
+}
+
+// removePairsFoundInPersisters returns the pairs whose keys are not present in any of the provided persisters
+func (ps *PruningStorer) removePairsFoundInPersisters(
+	pairs []keyValuePair,
+	persisters []*persisterData,
+	opened *openedClosedPersisters,
+) []keyValuePair {
+	for _, pd := range persisters {
+		opened.use(pd, func(persister storage.Persister) {
+			remainingPairs := pairs[:0]
+			for _, pair := range pairs {
+				if persister.Has(pair.key) != nil {
+					remainingPairs = append(remainingPairs, pair)
+				}
+			}
+			pairs = remainingPairs
+		})
+	}
+
+	return pairs
+}
+
+// openedClosedPersister is a closed persister temporarily opened from the provided path
+type openedClosedPersister struct {
+	persister storage.Persister
+	path      string
+}
+
+// openedClosedPersisters keeps the closed persisters opened during a RangeKeys call, so that each of them is opened
+// only once, no matter how many batches are read from it or how many older persisters are checked against it
+type openedClosedPersisters struct {
+	ps         *PruningStorer
+	persisters map[*persisterData]*openedClosedPersister
+}
+
+func (ps *PruningStorer) newOpenedClosedPersisters() *openedClosedPersisters {
+	return &openedClosedPersisters{
+		ps:         ps,
+		persisters: make(map[*persisterData]*openedClosedPersister),
+	}
+}
+
+// use calls the provided function with the persister while holding its range lock. A closed persister is opened on
+// its first use if its files are still present on disk, otherwise the function is not called. A persister archived
+// or destroyed after it was opened is opened again from its new path, or no longer used
+func (o *openedClosedPersisters) use(pd *persisterData, handler func(persister storage.Persister)) {
+	pd.mutRange.RLock()
+	defer pd.mutRange.RUnlock()
+
+	if !pd.getIsClosed() {
+		handler(pd.persister)
+		return
+	}
+
+	persister, ok := o.get(pd)
+	if !ok {
+		return
+	}
+
+	handler(persister)
+}
+
+// get returns the opened instance of the closed persister. It should be called while holding the range lock
+func (o *openedClosedPersisters) get(pd *persisterData) (storage.Persister, bool) {
+	path := pd.getPath()
+	pathIsPresent := pathExists(path)
+
+	opened, found := o.persisters[pd]
+	if found && opened.path == path && pathIsPresent {
+		return opened.persister, true
+	}
+	if found {
+		o.close(pd, opened)
+	}
+	if !pathIsPresent {
+		return nil, false
+	}
+
+	persister, err := o.ps.persisterFactory.Create(path)
+	if err == nil {
+		err = persister.Init()
+	}
+	if err != nil {
+		log.Debug("PruningStorer.RangeKeys: cannot open persister",
+			"identifier", o.ps.identifier,
+			"epoch", pd.epoch,
+			"error", err.Error())
+		return nil, false
+	}
+
+	o.persisters[pd] = &openedClosedPersister{
+		persister: persister,
+		path:      path,
+	}
+
+	return persister, true
+}
+
+func (o *openedClosedPersisters) close(pd *persisterData, opened *openedClosedPersister) {
+	err := opened.persister.Close()
+	if err != nil {
+		log.Debug("PruningStorer.RangeKeys: cannot close persister",
+			"identifier", o.ps.identifier,
+			"epoch", pd.epoch,
+			"error", err.Error())
+	}
+	delete(o.persisters, pd)
+}
+
+func (o *openedClosedPersisters) closeAll() {
+	for pd, opened := range o.persisters {
+		o.close(pd, opened)
+	}
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -899,29 +1304,43 @@ func (ps *PruningStorer) IsInterfaceNil() bool {
 }
 
 func createShallowPersisterDataForEpoch(args *StorerArgs, epoch uint32, shard string) *persisterData {
-	filePath := createPersisterPathForEpoch(args, epoch, shard)
+	filePath, isArchived := createPersisterPathForEpoch(args, epoch, shard)
 
 	return &persisterData{
-		persister: args.PersisterFactory.CreateDisabled(),
-		epoch:     epoch,
-		path:      filePath,
-		isClosed:  true,
+		persister:  args.PersisterFactory.CreateDisabled(),
+		epoch:      epoch,
+		path:       filePath,
+		isClosed:   true,
+		isShallow:  true,
+		isArchived: isArchived,
 	}
 }
 
-func createPersisterPathForEpoch(args *StorerArgs, epoch uint32, shard string) string {
+// createPersisterPathForEpoch returns the path of the persister for the given epoch and true if the persister
+// was previously moved in the archive directory
+func createPersisterPathForEpoch(args *StorerArgs, epoch uint32, shard string) (string, bool) {
 	filePath := args.PathManager.PathForEpoch(core.GetShardIDString(args.ShardCoordinator.SelfId()), epoch, args.Identifier)
 	if len(shard) > 0 {
 		filePath += shard
 	}
 
-	return filePath
+	isArchivingEnabled := len(args.ArchiveDbPath) > 0 && args.PruningEnabled && !args.CleanOldEpochsData
+	if !isArchivingEnabled || pathExists(filePath) {
+		return filePath, false
+	}
+
+	archivedFilePath := archivePathFor(args.ArchiveDbPath, filePath)
+	if pathExists(archivedFilePath) {
+		return archivedFilePath, true
+	}
+
+	return filePath, false
 }
 
 func createPersisterDataForEpoch(args *StorerArgs, epoch uint32, shard string) (*persisterData, error) {
 	// TODO: if booting from storage in an epoch > 0, shardId needs to be taken from somewhere else
 	// e.g. determined from directories in persister path or taken from boot storer
-	filePath := createPersisterPathForEpoch(args, epoch, shard)
+	filePath, isArchived := createPersisterPathForEpoch(args, epoch, shard)
 
 	db, err := args.PersisterFactory.Create(filePath)
 	if err != nil {
@@ -930,10 +1349,11 @@ func createPersisterDataForEpoch(args *StorerArgs, epoch uint32, shard string) (
 	}
 
 	p := &persisterData{
-		persister: db,
-		epoch:     epoch,
-		path:      filePath,
-		isClosed:  false,
+		persister:  db,
+		epoch:      epoch,
+		path:       filePath,
+		isClosed:   false,
+		isArchived: isArchived,
 	}
 
 	err = p.persister.Init()
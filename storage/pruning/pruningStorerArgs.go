@@ -18,6 +18,8 @@ type StorerArgs struct {
 	MaxBatchSize              int
 	NumOfEpochsToKeep         uint32
 	NumOfActivePersisters     uint32
+	ArchiveDbPath             string
+	NumOfEpochsBeforeArchive  uint32
 	StartingEpoch             uint32
 	PruningEnabled            bool
 	CleanOldEpochsData        bool
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -673,6 +676,36 @@ func TestPruningStorer_SearchFirst(t *testing.T) {
 	assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
 }
 
+func TestPruningStorer_AccessesShouldBeCountedInMetrics(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.CacheConf.Name = "TestPruningStorer_AccessesShouldBeCountedInMetrics"
+	ps, _ := pruning.NewShardedPruningStorer(args, 1)
+
+	testKey := []byte("key")
+	_ = ps.Put(testKey, []byte("value"))
+	_, _ = ps.Get(testKey)
+	ps.ClearCache()
+	_, _ = ps.Get(testKey)
+	_, _ = ps.Get([]byte("missing key"))
+	_, _ = ps.SearchFirst([]byte("missing key"))
+
+	var snapshot *storage.StorerMetricsSnapshot
+	for _, metricsSnapshot := range storage.GetStorersMetricsSnapshots() {
+		if metricsSnapshot.Name == args.CacheConf.Name+"1" {
+			snapshot = metricsSnapshot
+		}
+	}
+	require.NotNil(t, snapshot)
+	assert.Equal(t, uint64(1), snapshot.Puts)
+	assert.Equal(t, uint64(4), snapshot.Gets)
+	assert.Equal(t, uint64(1), snapshot.CacheHits)
+	assert.Equal(t, uint64(0), snapshot.BloomNegatives)
+	assert.Equal(t, uint64(2), snapshot.PersisterMisses)
+	assert.Equal(t, uint64(4), snapshot.GetLatency.Count)
+}
+
 func TestPruningStorer_ChangeEpochWithKeepingFromOldestEpochInMetaBlock(t *testing.T) {
 	t.Parallel()
 
@@ -887,3 +920,375 @@ func TestDirectories(t *testing.T) {
 
 	_ = os.RemoveAll("user-directory")
 }
+
+type closeTrackingPersister struct {
+	storage.Persister
+	mutClosed sync.RWMutex
+	closed    bool
+}
+
+func (ctp *closeTrackingPersister) Close() error {
+	ctp.mutClosed.Lock()
+	ctp.closed = true
+	ctp.mutClosed.Unlock()
+
+	return ctp.Persister.Close()
+}
+
+func (ctp *closeTrackingPersister) isClosed() bool {
+	ctp.mutClosed.RLock()
+	defer ctp.mutClosed.RUnlock()
+
+	return ctp.closed
+}
+
+// createReopenablePersisterArgs returns arguments whose persisters are kept in memory while their paths are created
+// inside the provided directory, so that a closed persister can be reopened from its path
+func createReopenablePersisterArgs(dbDir string) (*pruning.StorerArgs, func(path string) *closeTrackingPersister) {
+	mutPersisters := sync.Mutex{}
+	persistersByPath := make(map[string]*closeTrackingPersister)
+	getPersister := func(path string) *closeTrackingPersister {
+		mutPersisters.Lock()
+		defer mutPersisters.Unlock()
+
+		persister, ok := persistersByPath[path]
+		if !ok {
+			persister = &closeTrackingPersister{Persister: memorydb.New()}
+			persistersByPath[path] = persister
+		}
+
+		return persister
+	}
+
+	args := getDefaultArgs()
+	args.PathManager = &mock.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+		return filepath.Join(dbDir, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+	}}
+	args.PersisterFactory = &mock.PersisterFactoryStub{
+		// simulate an opening of an existing database from the file path
+		CreateCalled: func(path string) (storage.Persister, error) {
+			err := os.MkdirAll(path, os.ModePerm)
+			if err != nil {
+				return nil, err
+			}
+
+			return getPersister(path), nil
+		},
+	}
+
+	return args, getPersister
+}
+
+func rangeKeysToMap(rangeFunc func(handler func(key []byte, val []byte) bool)) map[string]string {
+	result := make(map[string]string)
+	rangeFunc(func(key []byte, val []byte) bool {
+		result[string(key)] = string(val)
+		return true
+	})
+
+	return result
+}
+
+func TestPruningStorer_RangeKeysShouldIterateOverAllEpochs(t *testing.T) {
+	t.Parallel()
+
+	dbDir, _ := ioutil.TempDir("", "pruningStorerRange")
+	defer func() {
+		_ = os.RemoveAll(dbDir)
+	}()
+
+	args, _ := createReopenablePersisterArgs(dbDir)
+	args.NumOfActivePersisters = 2
+	args.NumOfEpochsToKeep = 3
+	ps, _ := pruning.NewPruningStorer(args)
+
+	_ = ps.Put([]byte("key1"), []byte("value1-epoch0"))
+	_ = ps.Put([]byte("key2"), []byte("value2-epoch0"))
+
+	_ = ps.ChangeEpochSimple(1)
+	ps.SetEpochForPutOperation(1)
+	_ = ps.Put([]byte("key1"), []byte("value1-epoch1"))
+	_ = ps.Put([]byte("key3"), []byte("value3-epoch1"))
+
+	// the persister for epoch 0 will be closed
+	_ = ps.ChangeEpochSimple(2)
+	ps.SetEpochForPutOperation(2)
+	_ = ps.Put([]byte("key4"), []byte("value4-epoch2"))
+
+	expectedActive := map[string]string{
+		"key1": "value1-epoch1",
+		"key3": "value3-epoch1",
+		"key4": "value4-epoch2",
+	}
+	assert.Equal(t, expectedActive, rangeKeysToMap(ps.RangeKeys))
+
+	expectedAll := map[string]string{
+		"key1": "value1-epoch1",
+		"key2": "value2-epoch0",
+		"key3": "value3-epoch1",
+		"key4": "value4-epoch2",
+	}
+	assert.Equal(t, expectedAll, rangeKeysToMap(ps.RangeKeysIncludingClosedPersisters))
+}
+
+func TestPruningStorer_RangeKeysShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	t.Parallel()
+
+	dbDir, _ := ioutil.TempDir("", "pruningStorerRange")
+	defer func() {
+		_ = os.RemoveAll(dbDir)
+	}()
+
+	args, _ := createReopenablePersisterArgs(dbDir)
+	ps, _ := pruning.NewPruningStorer(args)
+
+	_ = ps.Put([]byte("key1"), []byte("value1"))
+	_ = ps.ChangeEpochSimple(1)
+	ps.SetEpochForPutOperation(1)
+	_ = ps.Put([]byte("key2"), []byte("value2"))
+	_ = ps.Put([]byte("key3"), []byte("value3"))
+
+	numCalls := 0
+	ps.RangeKeys(func(key []byte, val []byte) bool {
+		numCalls++
+		return false
+	})
+	assert.Equal(t, 1, numCalls)
+
+	assert.NotPanics(t, func() {
+		ps.RangeKeys(nil)
+	})
+}
+
+func TestPruningStorer_RangeKeysShouldNotBlockTheEpochChange(t *testing.T) {
+	t.Parallel()
+
+	dbDir, _ := ioutil.TempDir("", "pruningStorerRange")
+	defer func() {
+		_ = os.RemoveAll(dbDir)
+	}()
+
+	args, getPersister := createReopenablePersisterArgs(dbDir)
+	args.NumOfActivePersisters = 1
+	args.NumOfEpochsToKeep = 2
+	ps, _ := pruning.NewPruningStorer(args)
+
+	// more keys than the ones read in a single batch, so that the iteration resumes after the persister was closed
+	numKeys := 2500
+	for i := 0; i < numKeys; i++ {
+		_ = ps.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+	persisterEpoch0 := getPersister(args.PathManager.PathForEpoch("0", 0, args.Identifier))
+
+	chStarted := make(chan struct{})
+	chRelease := make(chan struct{})
+	chRangeDone := make(chan struct{})
+	rangedKeys := make(map[string]struct{})
+	go func() {
+		isFirst := true
+		ps.RangeKeys(func(key []byte, val []byte) bool {
+			if isFirst {
+				isFirst = false
+				close(chStarted)
+				<-chRelease
+			}
+			rangedKeys[string(key)] = struct{}{}
+			return true
+		})
+		close(chRangeDone)
+	}()
+
+	<-chStarted
+	chEpochChanged := make(chan struct{})
+	go func() {
+		_ = ps.ChangeEpochSimple(1)
+		close(chEpochChanged)
+	}()
+
+	select {
+	case <-chEpochChanged:
+	case <-time.After(time.Second * 5):
+		assert.Fail(t, "the epoch change should not have waited for the iteration")
+	}
+	assert.True(t, persisterEpoch0.isClosed())
+
+	close(chRelease)
+	<-chRangeDone
+
+	assert.Equal(t, numKeys, len(rangedKeys))
+}
+
+func TestPruningStorer_RangeKeysShouldSkipDestroyedPersisters(t *testing.T) {
+	t.Parallel()
+
+	dbDir, _ := ioutil.TempDir("", "pruningStorerRange")
+	defer func() {
+		_ = os.RemoveAll(dbDir)
+	}()
+
+	args, _ := createReopenablePersisterArgs(dbDir)
+	args.NumOfActivePersisters = 1
+	args.NumOfEpochsToKeep = 3
+	ps, _ := pruning.NewPruningStorer(args)
+
+	_ = ps.Put([]byte("key0"), []byte("value0"))
+	_ = ps.ChangeEpochSimple(1)
+	ps.SetEpochForPutOperation(1)
+	_ = ps.Put([]byte("key1"), []byte("value1"))
+
+	_ = os.RemoveAll(args.PathManager.PathForEpoch("0", 0, args.Identifier))
+
+	expected := map[string]string{
+		"key1": "value1",
+	}
+	assert.Equal(t, expected, rangeKeysToMap(ps.RangeKeysIncludingClosedPersisters))
+	assert.False(t, pathExists(args.PathManager.PathForEpoch("0", 0, args.Identifier)))
+}
+
+func TestPruningStorer_RangeKeysIncludingClosedPersistersShouldOpenEachClosedPersisterOnce(t *testing.T) {
+	t.Parallel()
+
+	dbDir, _ := ioutil.TempDir("", "pruningStorerRange")
+	defer func() {
+		_ = os.RemoveAll(dbDir)
+	}()
+
+	args, _ := createReopenablePersisterArgs(dbDir)
+	args.NumOfActivePersisters = 1
+	args.NumOfEpochsToKeep = 4
+	ps, _ := pruning.NewPruningStorer(args)
+
+	// more keys than the ones read in a single batch, in each of the closed persisters
+	numKeysPerEpoch := 2500
+	for epoch := uint32(0); epoch < 3; epoch++ {
+		if epoch > 0 {
+			_ = ps.ChangeEpochSimple(epoch)
+			ps.SetEpochForPutOperation(epoch)
+		}
+		for i := 0; i < numKeysPerEpoch; i++ {
+			_ = ps.Put([]byte(fmt.Sprintf("key%d-%d", epoch, i)), []byte("value"))
+		}
+	}
+
+	mutCreated := sync.Mutex{}
+	numCreatedByPath := make(map[string]int)
+	persisterFactory := args.PersisterFactory.(*mock.PersisterFactoryStub)
+	createPersister := persisterFactory.CreateCalled
+	persisterFactory.CreateCalled = func(path string) (storage.Persister, error) {
+		mutCreated.Lock()
+		numCreatedByPath[path]++
+		mutCreated.Unlock()
+
+		return createPersister(path)
+	}
+
+	numKeys := 0
+	ps.RangeKeysIncludingClosedPersisters(func(key []byte, val []byte) bool {
+		numKeys++
+		return true
+	})
+	assert.Equal(t, 3*numKeysPerEpoch, numKeys)
+
+	expectedCreated := map[string]int{
+		args.PathManager.PathForEpoch("0", 0, args.Identifier): 1,
+		args.PathManager.PathForEpoch("0", 1, args.Identifier): 1,
+	}
+	assert.Equal(t, expectedCreated, numCreatedByPath)
+}
+
+const testChainID = "chainID"
+
+func getArchivingArgs(dbDir string, archiveDir string) *pruning.StorerArgs {
+	args := getDefaultArgsSerialDB()
+	args.PathManager = &mock.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+		return filepath.Join(dbDir, testChainID, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+	}}
+	args.NumOfEpochsToKeep = 2
+	args.NumOfActivePersisters = 2
+	args.ArchiveDbPath = archiveDir
+	args.NumOfEpochsBeforeArchive = 2
+
+	return args
+}
+
+func TestPruningStorer_OldPersistersShouldBeArchivedAndReopenedFromArchive(t *testing.T) {
+	t.Parallel()
+
+	dbDir, _ := ioutil.TempDir("", "pruningStorerDb")
+	archiveDir, _ := ioutil.TempDir("", "pruningStorerArchive")
+	defer func() {
+		_ = os.RemoveAll(dbDir)
+		_ = os.RemoveAll(archiveDir)
+	}()
+
+	args := getArchivingArgs(dbDir, archiveDir)
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	testKey, testVal := []byte("key"), []byte("value")
+	require.Nil(t, ps.Put(testKey, testVal))
+
+	require.Nil(t, ps.ChangeEpochSimple(1))
+	epoch0Path := filepath.Join(dbDir, testChainID, "Epoch_0", "Shard_0", "id")
+	assert.True(t, pathExists(epoch0Path))
+
+	// the persister of epoch 0 is closed when changing to epoch 2 and then moved in the archive
+	require.Nil(t, ps.ChangeEpochSimple(2))
+	assert.False(t, pathExists(filepath.Join(dbDir, testChainID, "Epoch_0")))
+	assert.True(t, pathExists(filepath.Join(archiveDir, testChainID, "Epoch_0", "Shard_0", "id")))
+	assert.True(t, pathExists(filepath.Join(dbDir, testChainID, "Epoch_1", "Shard_0", "id")))
+
+	ps.ClearCache()
+	res, err := ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+	assert.Nil(t, ps.HasInEpoch(testKey, 0))
+
+	require.Nil(t, ps.Close())
+
+	// after a restart, the archived persister is found in the archive directory
+	args = getArchivingArgs(dbDir, archiveDir)
+	args.StartingEpoch = 2
+	args.EnabledDbLookupExtensions = true
+	ps, err = pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	res, err = ps.GetFromEpoch(testKey, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+	assert.False(t, pathExists(epoch0Path))
+
+	require.Nil(t, ps.DestroyUnit())
+	assert.False(t, pathExists(filepath.Join(archiveDir, testChainID, "Epoch_0", "Shard_0", "id")))
+}
+
+func TestPruningStorer_PersistersShouldNotBeArchivedWhenOldDataIsCleaned(t *testing.T) {
+	t.Parallel()
+
+	dbDir, _ := ioutil.TempDir("", "pruningStorerDb")
+	archiveDir, _ := ioutil.TempDir("", "pruningStorerArchive")
+	defer func() {
+		_ = os.RemoveAll(dbDir)
+		_ = os.RemoveAll(archiveDir)
+	}()
+
+	args := getArchivingArgs(dbDir, archiveDir)
+	args.CleanOldEpochsData = true
+	args.NumOfEpochsToKeep = 3
+	args.NumOfEpochsBeforeArchive = 2
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	require.Nil(t, ps.ChangeEpochSimple(1))
+	require.Nil(t, ps.ChangeEpochSimple(2))
+
+	assert.True(t, pathExists(filepath.Join(dbDir, testChainID, "Epoch_0", "Shard_0", "id")))
+	assert.False(t, pathExists(filepath.Join(archiveDir, testChainID, "Epoch_0")))
+
+	_ = ps.Close()
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
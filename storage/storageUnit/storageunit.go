@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"reflect"
 	"sync"
 	"time"
@@ -16,7 +18,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/fnv"
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -43,14 +47,38 @@ const (
 
 var log = logger.GetOrCreate("storage/storageUnit")
 
-// LvlDB currently the only supported DBs
+// LvlDB, LvlDBSerial, MemoryDB and BadgerDB are the currently supported DBs
 // More to be added
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
 	MemoryDB    DBType = "MemoryDB"
+	BadgerDB    DBType = "BadgerDB"
 )
 
+// CompressionType represents the compression applied to the values of a DB
+type CompressionType string
+
+// NoCompression, SnappyCompression and ZstdCompression are the currently supported compression types
+const (
+	NoCompression     CompressionType = ""
+	SnappyCompression CompressionType = "Snappy"
+	ZstdCompression   CompressionType = "Zstd"
+)
+
+// BloomType represents the type of the bloom filter used by a storage unit
+type BloomType string
+
+// StandardBloom and CountingBloom are the currently supported bloom filter types. The counting bloom filter
+// supports removals and is saved on disk when the storage unit is closed
+const (
+	StandardBloom BloomType = ""
+	CountingBloom BloomType = "Counting"
+)
+
+// bloomFilterFileExtension is appended to the database path to obtain the file of a saved counting bloom filter
+const bloomFilterFileExtension = ".bloom"
+
 const (
 	// Keccak is the string representation of the keccak hashing function
 	Keccak HasherType = "Keccak"
@@ -97,12 +125,14 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       CompressionType
 }
 
 // BloomConfig holds the configurable elements of a bloom filter
 type BloomConfig struct {
 	Size     uint
 	HashFunc []HasherType
+	Type     BloomType
 }
 
 // Unit represents a storer's data bank
@@ -112,12 +142,19 @@ type Unit struct {
 	persister   storage.Persister
 	cacher      storage.Cacher
 	bloomFilter storage.BloomFilter
+	metrics     *storage.StorerMetrics
+	// bloomFilterPath is the file where a counting bloom filter is saved on close, empty if it should not be saved
+	bloomFilterPath string
 }
 
 // Put adds data to both cache and persistence medium and updates the bloom filter
 func (u *Unit) Put(key, data []byte) error {
+	start := time.Now()
 	u.lock.Lock()
-	defer u.lock.Unlock()
+	defer func() {
+		u.lock.Unlock()
+		u.metrics.AddPut(time.Since(start))
+	}()
 
 	u.cacher.Put(key, data, len(data))
 
@@ -141,6 +178,10 @@ func (u *Unit) PutInEpoch(key, data []byte, _ uint32) error {
 
 // Close will close unit
 func (u *Unit) Close() error {
+	u.lock.Lock()
+	u.saveBloomFilter()
+	u.lock.Unlock()
+
 	err := u.persister.Close()
 	if err != nil {
 		log.Error("cannot close storage unit persister", "error", err)
@@ -160,18 +201,25 @@ func (u *Unit) RangeKeys(handler func(key []byte, value []byte) bool) {
 // it further searches it in the associated database.
 // In case it is found in the database, the cache is updated with the value as well.
 func (u *Unit) Get(key []byte) ([]byte, error) {
+	start := time.Now()
 	u.lock.Lock()
-	defer u.lock.Unlock()
+	defer func() {
+		u.lock.Unlock()
+		u.metrics.AddGet(time.Since(start))
+	}()
 
 	v, ok := u.cacher.Get(key)
 	var err error
 
-	if !ok {
+	if ok {
+		u.metrics.IncrementCacheHits()
+	} else {
 		// not found in cache
 		// search it in second persistence medium
 		if u.bloomFilter == nil || u.bloomFilter.MayContain(key) {
 			v, err = u.persister.Get(key)
 			if err != nil {
+				u.metrics.IncrementPersisterMisses()
 				return nil, err
 			}
 
@@ -183,6 +231,7 @@ func (u *Unit) Get(key []byte) ([]byte, error) {
 			// if found in persistence unit, add it in cache
 			u.cacher.Put(key, v, len(buff))
 		} else {
+			u.metrics.IncrementBloomNegatives()
 			return nil, fmt.Errorf("key: %s not found", base64.StdEncoding.EncodeToString(key))
 		}
 	}
@@ -247,7 +296,19 @@ func (u *Unit) Remove(key []byte) error {
 	defer u.lock.Unlock()
 
 	u.cacher.Remove(key)
+
+	countingBloomFilter, isCounting := u.bloomFilter.(storage.CountingBloomFilter)
+	if !isCounting {
+		return u.persister.Remove(key)
+	}
+
+	// the counting bloom filter should be updated only for the keys which are present, otherwise the counters
+	// of other keys would be decremented
+	wasPresent := u.persister.Has(key) == nil
 	err := u.persister.Remove(key)
+	if err == nil && wasPresent {
+		countingBloomFilter.Remove(key)
+	}
 
 	return err
 }
@@ -265,11 +326,60 @@ func (u *Unit) DestroyUnit() error {
 	if u.bloomFilter != nil {
 		u.bloomFilter.Clear()
 	}
+	if len(u.bloomFilterPath) > 0 {
+		_ = os.Remove(u.bloomFilterPath)
+		u.bloomFilterPath = ""
+	}
 
 	u.cacher.Clear()
 	return u.persister.Destroy()
 }
 
+// loadBloomFilter loads the counting bloom filter saved when the unit was closed. The saved file is removed after
+// loading, so that a filter which was not saved again, due to an unclean shutdown, will not be used. If the file
+// can not be used, the filter is rebuilt from the keys found in the persister
+func (u *Unit) loadBloomFilter(countingBloomFilter storage.CountingBloomFilter) {
+	data, err := ioutil.ReadFile(u.bloomFilterPath)
+	if err == nil {
+		err = countingBloomFilter.UnmarshalBinary(data)
+		errRemove := os.Remove(u.bloomFilterPath)
+		log.LogIfError(errRemove, "path", u.bloomFilterPath)
+		if err == nil {
+			return
+		}
+	}
+
+	log.Debug("rebuilding the bloom filter from the persisted keys", "path", u.bloomFilterPath, "reason", err.Error())
+	countingBloomFilter.Clear()
+	u.persister.RangeKeys(func(key []byte, _ []byte) bool {
+		countingBloomFilter.Add(key)
+		return true
+	})
+}
+
+func (u *Unit) saveBloomFilter() {
+	countingBloomFilter, isCounting := u.bloomFilter.(storage.CountingBloomFilter)
+	if !isCounting || len(u.bloomFilterPath) == 0 {
+		return
+	}
+
+	data, err := countingBloomFilter.MarshalBinary()
+	if err != nil {
+		log.Warn("cannot serialize the bloom filter", "path", u.bloomFilterPath, "error", err.Error())
+		return
+	}
+
+	// the file is written under a temporary name first, so a partially written filter will never be loaded
+	tempPath := u.bloomFilterPath + ".tmp"
+	err = ioutil.WriteFile(tempPath, data, 0644)
+	if err == nil {
+		err = os.Rename(tempPath, u.bloomFilterPath)
+	}
+	if err != nil {
+		log.Warn("cannot save the bloom filter", "path", u.bloomFilterPath, "error", err.Error())
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (u *Unit) IsInterfaceNil() bool {
 	return u == nil
@@ -289,6 +399,7 @@ func NewStorageUnit(c storage.Cacher, p storage.Persister) (*Unit, error) {
 		persister:   p,
 		cacher:      c,
 		bloomFilter: nil,
+		metrics:     storage.GetOrCreateStorerMetrics(""),
 	}
 
 	err := sUnit.persister.Init()
@@ -316,6 +427,7 @@ func NewStorageUnitWithBloomFilter(c storage.Cacher, p storage.Persister, b stor
 		persister:   p,
 		cacher:      c,
 		bloomFilter: b,
+		metrics:     storage.GetOrCreateStorerMetrics(""),
 	}
 
 	err := sUnit.persister.Init()
@@ -354,22 +466,38 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig, bloomFilterC
 		BatchDelaySeconds: dbConf.BatchDelaySeconds,
 		MaxBatchSize:      dbConf.MaxBatchSize,
 		MaxOpenFiles:      dbConf.MaxOpenFiles,
+		Compression:       dbConf.Compression,
 	}
 	db, err = NewDB(argDB)
 	if err != nil {
 		return nil, err
 	}
 
+	var unit *Unit
 	if reflect.DeepEqual(bloomFilterConf, BloomConfig{}) {
-		return NewStorageUnit(cache, db)
-	}
+		unit, err = NewStorageUnit(cache, db)
+	} else {
+		bf, err = NewBloomFilter(bloomFilterConf)
+		if err != nil {
+			return nil, err
+		}
 
-	bf, err = NewBloomFilter(bloomFilterConf)
+		unit, err = NewStorageUnitWithBloomFilter(cache, db, bf)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return NewStorageUnitWithBloomFilter(cache, db, bf)
+	// the units are identified in metrics by their cache name, as it is unique for each configured storer
+	unit.metrics = storage.GetOrCreateStorerMetrics(cacheConf.Name)
+
+	countingBloomFilter, isCounting := bf.(storage.CountingBloomFilter)
+	if isCounting && dbConf.Type != MemoryDB && len(dbConf.FilePath) > 0 {
+		unit.bloomFilterPath = dbConf.FilePath + bloomFilterFileExtension
+		unit.loadBloomFilter(countingBloomFilter)
+	}
+
+	return unit, nil
 }
 
 // NewCache creates a new cache from a cache config
@@ -425,6 +553,7 @@ type ArgDB struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       CompressionType
 }
 
 // NewDB creates a new database from database config
@@ -440,12 +569,14 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case MemoryDB:
 			db = memorydb.New()
+		case BadgerDB:
+			db, err = badgerdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize)
 		default:
 			return nil, storage.ErrNotSupportedDBType
 		}
 
 		if err == nil {
-			return db, nil
+			return NewCompressedDB(db, argDB.Compression)
 		}
 
 		//TODO: extract this in a parameter and inject it
@@ -458,6 +589,31 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 	return db, nil
 }
 
+// NewCompressedDB wraps the provided persister in a compression layer if a compression type is set.
+// The values written before enabling the compression remain readable
+func NewCompressedDB(db storage.Persister, compressionType CompressionType) (storage.Persister, error) {
+	var algorithm compression.Algorithm
+	switch compressionType {
+	case NoCompression:
+		return db, nil
+	case SnappyCompression:
+		algorithm = compression.Snappy
+	case ZstdCompression:
+		algorithm = compression.Zstd
+	default:
+		_ = db.Close()
+		return nil, storage.ErrNotSupportedCompressionType
+	}
+
+	compressedDB, err := compression.NewDB(db, algorithm)
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	return compressedDB, nil
+}
+
 // NewBloomFilter creates a new bloom filter from bloom filter config
 func NewBloomFilter(conf BloomConfig) (storage.BloomFilter, error) {
 	var bf storage.BloomFilter
@@ -474,7 +630,14 @@ func NewBloomFilter(conf BloomConfig) (storage.BloomFilter, error) {
 		}
 	}
 
-	bf, err = bloom.NewFilter(conf.Size, hashers)
+	switch conf.Type {
+	case StandardBloom:
+		bf, err = bloom.NewFilter(conf.Size, hashers)
+	case CountingBloom:
+		bf, err = bloom.NewCountingFilter(conf.Size, hashers)
+	default:
+		return nil, storage.ErrNotSupportedBloomFilterType
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -15,11 +15,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/hashing/keccak"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/bloom"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func logError(err error) {
@@ -506,6 +508,31 @@ func TestCreateDBFromConfLvlDBOk(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestCreateDBFromConfWrongCompression(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:      storageUnit.MemoryDB,
+		Compression: "NotSnappy",
+	}
+	persister, err := storageUnit.NewDB(arg)
+
+	assert.Equal(t, storage.ErrNotSupportedCompressionType, err)
+	assert.Nil(t, persister, "persister expected to be nil, but got %s", persister)
+}
+
+func TestCreateDBFromConfWithCompressionOk(t *testing.T) {
+	for _, compressionType := range []storageUnit.CompressionType{storageUnit.SnappyCompression, storageUnit.ZstdCompression} {
+		arg := storageUnit.ArgDB{
+			DBType:      storageUnit.MemoryDB,
+			Compression: compressionType,
+		}
+		persister, err := storageUnit.NewDB(arg)
+		assert.Nil(t, err, "no error expected")
+
+		_, isCompressed := persister.(*compression.DB)
+		assert.True(t, isCompressed)
+	}
+}
+
 func TestCreateBloomFilterFromConfWrongSize(t *testing.T) {
 	bfConfig := storageUnit.BloomConfig{
 		Size:     2,
@@ -638,6 +665,48 @@ func TestNewStorageUnit_WithBlankBloomFilterShouldWorkLvlDB(t *testing.T) {
 	assert.Nil(t, err, "no error expected destroying the persister")
 }
 
+func TestNewStorageUnit_FromConfShouldPublishMetricsUnderTheCacheName(t *testing.T) {
+	cacheName := "TestNewStorageUnit_FromConfShouldPublishMetricsUnderTheCacheName"
+	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
+		Name:     cacheName,
+		Capacity: 1,
+		Type:     storageUnit.LRUCache,
+	}, storageUnit.DBConfig{
+		Type:              storageUnit.MemoryDB,
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}, storageUnit.BloomConfig{
+		Size:     2048,
+		HashFunc: []storageUnit.HasherType{storageUnit.Keccak, storageUnit.Blake2b, storageUnit.Fnv},
+	})
+	assert.Nil(t, err)
+
+	_ = storer.Put([]byte("key1"), []byte("value1"))
+	_ = storer.Put([]byte("key2"), []byte("value2"))
+	_, _ = storer.Get([]byte("key2"))
+	_, _ = storer.Get([]byte("key1"))
+	_, _ = storer.Get([]byte("missing key"))
+	_ = storer.Remove([]byte("key2"))
+	_, _ = storer.Get([]byte("key2"))
+
+	var snapshot *storage.StorerMetricsSnapshot
+	for _, metricsSnapshot := range storage.GetStorersMetricsSnapshots() {
+		if metricsSnapshot.Name == cacheName {
+			snapshot = metricsSnapshot
+		}
+	}
+	require.NotNil(t, snapshot)
+	assert.Equal(t, uint64(2), snapshot.Puts)
+	assert.Equal(t, uint64(4), snapshot.Gets)
+	assert.Equal(t, uint64(1), snapshot.CacheHits)
+	assert.Equal(t, uint64(1), snapshot.BloomNegatives)
+	assert.Equal(t, uint64(1), snapshot.PersisterMisses)
+	assert.Equal(t, uint64(4), snapshot.GetLatency.Count)
+	assert.Equal(t, snapshot.GetLatency.Count, snapshot.GetLatency.Buckets[len(snapshot.GetLatency.Buckets)-1])
+	assert.Equal(t, uint64(2), snapshot.PutLatency.Count)
+}
+
 func TestNewStorageUnit_WithConfigBloomFilterShouldCreateBloomFilterLvlDB(t *testing.T) {
 	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
 		Capacity: 10,
@@ -869,3 +938,87 @@ func BenchmarkStorageUnit_GetNotPresentWithBloomFilter(b *testing.B) {
 		logError(err)
 	}
 }
+
+func TestCreateBloomFilterFromConfWrongType(t *testing.T) {
+	bfConfig := storageUnit.BloomConfig{
+		Size:     2048,
+		HashFunc: []storageUnit.HasherType{storageUnit.Keccak, storageUnit.Blake2b, storageUnit.Fnv},
+		Type:     "unknown",
+	}
+
+	bf, err := storageUnit.NewBloomFilter(bfConfig)
+
+	assert.Equal(t, storage.ErrNotSupportedBloomFilterType, err)
+	assert.Nil(t, bf)
+}
+
+func createUnitWithCountingBloomFilter(t *testing.T, dbPath string) *storageUnit.Unit {
+	unit, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
+		Capacity: 10,
+		Type:     storageUnit.LRUCache,
+	}, storageUnit.DBConfig{
+		FilePath:          dbPath,
+		Type:              storageUnit.LvlDBSerial,
+		MaxBatchSize:      1,
+		BatchDelaySeconds: 1,
+		MaxOpenFiles:      10,
+	}, storageUnit.BloomConfig{
+		Size:     2048,
+		HashFunc: []storageUnit.HasherType{storageUnit.Keccak, storageUnit.Blake2b, storageUnit.Fnv},
+		Type:     storageUnit.CountingBloom,
+	})
+	require.Nil(t, err)
+
+	return unit
+}
+
+func TestNewStorageUnit_CountingBloomFilterShouldBeSavedOnCloseAndLoaded(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "countingBloom")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	dbPath := filepath.Join(dir, "Blocks")
+
+	unit := createUnitWithCountingBloomFilter(t, dbPath)
+	_ = unit.Put([]byte("key1"), []byte("value1"))
+	_ = unit.Put([]byte("key2"), []byte("value2"))
+	require.Nil(t, unit.Remove([]byte("key1")))
+	require.Nil(t, unit.Close())
+	assert.FileExists(t, dbPath+".bloom")
+
+	unit = createUnitWithCountingBloomFilter(t, dbPath)
+	defer func() {
+		_ = unit.DestroyUnit()
+	}()
+	_, err := os.Stat(dbPath + ".bloom")
+	assert.True(t, os.IsNotExist(err), "the saved bloom filter should be removed after loading")
+
+	value, err := unit.Get([]byte("key2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value2"), value)
+	assert.Equal(t, storage.ErrKeyNotFound, unit.Has([]byte("key1")))
+}
+
+func TestNewStorageUnit_CountingBloomFilterShouldBeRebuiltWithoutSavedFile(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "countingBloom")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	dbPath := filepath.Join(dir, "Blocks")
+
+	unit := createUnitWithCountingBloomFilter(t, dbPath)
+	_ = unit.Put([]byte("key1"), []byte("value1"))
+	require.Nil(t, unit.Close())
+	// simulate an unclean shutdown, where the bloom filter was not saved
+	require.Nil(t, os.Remove(dbPath+".bloom"))
+
+	unit = createUnitWithCountingBloomFilter(t, dbPath)
+	defer func() {
+		_ = unit.DestroyUnit()
+	}()
+
+	value, err := unit.Get([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	assert.Nil(t, unit.Has([]byte("key1")))
+}
@@ -0,0 +1,168 @@
+package storage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+)
+
+// LatencyBucketsMicroseconds holds the upper bounds of the storers' latency histograms buckets
+var LatencyBucketsMicroseconds = []uint64{50, 100, 250, 500, 1000, 2500, 5000, 10000, 25000, 50000, 100000, 250000, 1000000}
+
+var mutStorersMetrics sync.RWMutex
+var storersMetrics = make(map[string]*StorerMetrics)
+
+// StorerMetrics holds the access counters and the latency histograms of a storer
+type StorerMetrics struct {
+	gets            atomic.Counter
+	puts            atomic.Counter
+	cacheHits       atomic.Counter
+	bloomNegatives  atomic.Counter
+	persisterMisses atomic.Counter
+	getLatency      *latencyHistogram
+	putLatency      *latencyHistogram
+}
+
+// StorerMetricsSnapshot holds the values of the storer metrics at a moment in time
+type StorerMetricsSnapshot struct {
+	Name            string
+	Gets            uint64
+	Puts            uint64
+	CacheHits       uint64
+	BloomNegatives  uint64
+	PersisterMisses uint64
+	GetLatency      *LatencyHistogramSnapshot
+	PutLatency      *LatencyHistogramSnapshot
+}
+
+// LatencyHistogramSnapshot holds the values of a latency histogram at a moment in time. The buckets are cumulative,
+// each one counting the operations that lasted at most the matching value from LatencyBucketsMicroseconds
+type LatencyHistogramSnapshot struct {
+	Buckets         []uint64
+	Count           uint64
+	SumMicroseconds uint64
+}
+
+// GetOrCreateStorerMetrics returns the metrics of the storer with the provided name, creating them if needed.
+// The storers with the same name share the metrics. The metrics of a storer without name are not published
+func GetOrCreateStorerMetrics(name string) *StorerMetrics {
+	if len(name) == 0 {
+		return newStorerMetrics()
+	}
+
+	mutStorersMetrics.Lock()
+	defer mutStorersMetrics.Unlock()
+
+	metrics, ok := storersMetrics[name]
+	if !ok {
+		metrics = newStorerMetrics()
+		storersMetrics[name] = metrics
+	}
+
+	return metrics
+}
+
+// GetStorersMetricsSnapshots returns the snapshots of all the named storers' metrics, sorted by name
+func GetStorersMetricsSnapshots() []*StorerMetricsSnapshot {
+	mutStorersMetrics.RLock()
+	snapshots := make([]*StorerMetricsSnapshot, 0, len(storersMetrics))
+	for name, metrics := range storersMetrics {
+		snapshots = append(snapshots, metrics.snapshot(name))
+	}
+	mutStorersMetrics.RUnlock()
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Name < snapshots[j].Name
+	})
+
+	return snapshots
+}
+
+func newStorerMetrics() *StorerMetrics {
+	return &StorerMetrics{
+		getLatency: newLatencyHistogram(),
+		putLatency: newLatencyHistogram(),
+	}
+}
+
+// AddGet counts a get operation that lasted the provided duration
+func (sm *StorerMetrics) AddGet(duration time.Duration) {
+	sm.gets.Increment()
+	sm.getLatency.observe(duration)
+}
+
+// AddPut counts a put operation that lasted the provided duration
+func (sm *StorerMetrics) AddPut(duration time.Duration) {
+	sm.puts.Increment()
+	sm.putLatency.observe(duration)
+}
+
+// IncrementCacheHits counts a get operation served from the cache
+func (sm *StorerMetrics) IncrementCacheHits() {
+	sm.cacheHits.Increment()
+}
+
+// IncrementBloomNegatives counts a get operation for which the bloom filter avoided the persister access
+func (sm *StorerMetrics) IncrementBloomNegatives() {
+	sm.bloomNegatives.Increment()
+}
+
+// IncrementPersisterMisses counts a get operation for which the key was not found in the persister
+func (sm *StorerMetrics) IncrementPersisterMisses() {
+	sm.persisterMisses.Increment()
+}
+
+func (sm *StorerMetrics) snapshot(name string) *StorerMetricsSnapshot {
+	return &StorerMetricsSnapshot{
+		Name:            name,
+		Gets:            sm.gets.GetUint64(),
+		Puts:            sm.puts.GetUint64(),
+		CacheHits:       sm.cacheHits.GetUint64(),
+		BloomNegatives:  sm.bloomNegatives.GetUint64(),
+		PersisterMisses: sm.persisterMisses.GetUint64(),
+		GetLatency:      sm.getLatency.snapshot(),
+		PutLatency:      sm.putLatency.snapshot(),
+	}
+}
+
+type latencyHistogram struct {
+	buckets         []atomic.Counter
+	count           atomic.Counter
+	sumMicroseconds atomic.Counter
+}
+
+func newLatencyHistogram() *latencyHistogram {
+	return &latencyHistogram{
+		buckets: make([]atomic.Counter, len(LatencyBucketsMicroseconds)),
+	}
+}
+
+func (lh *latencyHistogram) observe(duration time.Duration) {
+	microseconds := duration.Microseconds()
+	for i, upperBound := range LatencyBucketsMicroseconds {
+		if uint64(microseconds) <= upperBound {
+			lh.buckets[i].Increment()
+			break
+		}
+	}
+
+	lh.count.Increment()
+	lh.sumMicroseconds.Add(microseconds)
+}
+
+func (lh *latencyHistogram) snapshot() *LatencyHistogramSnapshot {
+	cumulatedBuckets := make([]uint64, len(lh.buckets))
+	cumulated := uint64(0)
+	for i := range lh.buckets {
+		cumulated += lh.buckets[i].GetUint64()
+		cumulatedBuckets[i] = cumulated
+	}
+
+	return &LatencyHistogramSnapshot{
+		Buckets:         cumulatedBuckets,
+		Count:           lh.count.GetUint64(),
+		SumMicroseconds: lh.sumMicroseconds.GetUint64(),
+	}
+}
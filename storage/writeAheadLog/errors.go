@@ -0,0 +1,12 @@
+package writeAheadLog
+
+import "errors"
+
+// ErrInvalidNumberOfGroupsToKeep signals that an invalid number of groups to keep has been provided
+var ErrInvalidNumberOfGroupsToKeep = errors.New("invalid number of groups to keep")
+
+// ErrEmptyPath signals that an empty path has been provided
+var ErrEmptyPath = errors.New("empty path")
+
+// ErrCorruptedGroup signals that a group read from the log does not match its checksum
+var ErrCorruptedGroup = errors.New("corrupted group")
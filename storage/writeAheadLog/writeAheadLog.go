@@ -0,0 +1,326 @@
+package writeAheadLog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("storage/writeAheadLog")
+
+const (
+	groupFileExtension = ".wal"
+	tempFileExtension  = ".tmp"
+	checksumLength     = 4
+	// read + write + execute for owner only
+	rwxOwner = 0700
+	// read + write for owner only
+	rwOwner = 0600
+)
+
+// Entry is a single write of a group, addressed to the storage unit identified by Unit
+type Entry struct {
+	Unit  uint8
+	Key   []byte
+	Value []byte
+}
+
+// Group holds the writes which should reach the storage units together, alongside the epoch they were done in
+type Group struct {
+	Epoch   uint32
+	Entries []Entry
+}
+
+// writeAheadLog keeps the last groups of writes, each one in a synced file, so that the writes still buffered by
+// the storage units when the node crashed can be replayed on the next start
+type writeAheadLog struct {
+	mutLog          sync.Mutex
+	dirPath         string
+	numGroupsToKeep uint64
+	nextSequence    uint64
+}
+
+// NewWriteAheadLog creates a write ahead log in the provided directory, continuing the sequence of the groups
+// already found there
+func NewWriteAheadLog(dirPath string, numGroupsToKeep uint64) (*writeAheadLog, error) {
+	if len(dirPath) == 0 {
+		return nil, ErrEmptyPath
+	}
+	if numGroupsToKeep == 0 {
+		return nil, ErrInvalidNumberOfGroupsToKeep
+	}
+
+	err := os.MkdirAll(dirPath, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	wal := &writeAheadLog{
+		dirPath:         dirPath,
+		numGroupsToKeep: numGroupsToKeep,
+	}
+
+	sequences, err := wal.readSequences()
+	if err != nil {
+		return nil, err
+	}
+	if len(sequences) > 0 {
+		wal.nextSequence = sequences[len(sequences)-1] + 1
+	}
+
+	return wal, nil
+}
+
+// Append writes the group in the log and returns only after the data reached the disk. The oldest groups are
+// removed, so that only the configured number of groups is kept
+func (wal *writeAheadLog) Append(group Group) error {
+	wal.mutLog.Lock()
+	defer wal.mutLog.Unlock()
+
+	sequence := wal.nextSequence
+	err := wal.writeGroupFile(sequence, encodeGroup(group))
+	if err != nil {
+		return err
+	}
+	wal.nextSequence++
+
+	wal.removeOldGroups(sequence)
+
+	return nil
+}
+
+// Groups returns the groups found in the log, from the oldest to the newest. Corrupted groups are skipped
+func (wal *writeAheadLog) Groups() ([]Group, error) {
+	wal.mutLog.Lock()
+	defer wal.mutLog.Unlock()
+
+	sequences, err := wal.readSequences()
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]Group, 0, len(sequences))
+	for _, sequence := range sequences {
+		data, errRead := ioutil.ReadFile(wal.groupFilePath(sequence))
+		if errRead != nil {
+			return nil, errRead
+		}
+
+		group, errDecode := decodeGroup(data)
+		if errDecode != nil {
+			log.Warn("skipped write ahead log group", "sequence", sequence, "error", errDecode.Error())
+			continue
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wal *writeAheadLog) IsInterfaceNil() bool {
+	return wal == nil
+}
+
+func (wal *writeAheadLog) writeGroupFile(sequence uint64, data []byte) error {
+	filePath := wal.groupFilePath(sequence)
+	tempFilePath := filePath + tempFileExtension
+
+	file, err := os.OpenFile(tempFilePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, rwOwner)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if err == nil {
+		err = file.Sync()
+	}
+	errClose := file.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err != nil {
+		_ = os.Remove(tempFilePath)
+		return err
+	}
+
+	err = os.Rename(tempFilePath, filePath)
+	if err != nil {
+		return err
+	}
+
+	return syncDirectory(wal.dirPath)
+}
+
+// syncDirectory makes the rename of the group file durable
+func syncDirectory(dirPath string) error {
+	dir, err := os.Open(dirPath)
+	if err != nil {
+		return err
+	}
+
+	err = dir.Sync()
+	errClose := dir.Close()
+	if err != nil {
+		return err
+	}
+
+	return errClose
+}
+
+func (wal *writeAheadLog) removeOldGroups(lastSequence uint64) {
+	if lastSequence < wal.numGroupsToKeep {
+		return
+	}
+
+	sequences, err := wal.readSequences()
+	if err != nil {
+		log.Warn("cannot read the write ahead log groups", "error", err.Error())
+		return
+	}
+
+	oldestSequenceToKeep := lastSequence - wal.numGroupsToKeep + 1
+	for _, sequence := range sequences {
+		if sequence >= oldestSequenceToKeep {
+			return
+		}
+
+		err = os.Remove(wal.groupFilePath(sequence))
+		log.LogIfError(err, "sequence", sequence)
+	}
+}
+
+// readSequences returns the sorted sequences of the group files and removes the temporary files left by a
+// crash during an append
+func (wal *writeAheadLog) readSequences() ([]uint64, error) {
+	files, err := ioutil.ReadDir(wal.dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	sequences := make([]uint64, 0, len(files))
+	for _, file := range files {
+		name := file.Name()
+		if strings.HasSuffix(name, tempFileExtension) {
+			_ = os.Remove(filepath.Join(wal.dirPath, name))
+			continue
+		}
+		if !strings.HasSuffix(name, groupFileExtension) {
+			continue
+		}
+
+		sequence, errParse := strconv.ParseUint(strings.TrimSuffix(name, groupFileExtension), 10, 64)
+		if errParse != nil {
+			continue
+		}
+
+		sequences = append(sequences, sequence)
+	}
+
+	sort.Slice(sequences, func(i, j int) bool {
+		return sequences[i] < sequences[j]
+	})
+
+	return sequences, nil
+}
+
+func (wal *writeAheadLog) groupFilePath(sequence uint64) string {
+	return filepath.Join(wal.dirPath, fmt.Sprintf("%020d%s", sequence, groupFileExtension))
+}
+
+// encodeGroup serializes the group as the checksum of the payload followed by the payload, which holds the epoch,
+// the number of entries and then each entry as the unit, the length prefixed key and the length prefixed value
+func encodeGroup(group Group) []byte {
+	payload := bytes.NewBuffer(nil)
+	_ = binary.Write(payload, binary.BigEndian, group.Epoch)
+	_ = binary.Write(payload, binary.BigEndian, uint32(len(group.Entries)))
+	for _, entry := range group.Entries {
+		payload.WriteByte(entry.Unit)
+		writeLengthPrefixed(payload, entry.Key)
+		writeLengthPrefixed(payload, entry.Value)
+	}
+
+	data := make([]byte, checksumLength, checksumLength+payload.Len())
+	binary.BigEndian.PutUint32(data, crc32.ChecksumIEEE(payload.Bytes()))
+
+	return append(data, payload.Bytes()...)
+}
+
+func decodeGroup(data []byte) (Group, error) {
+	if len(data) < checksumLength {
+		return Group{}, ErrCorruptedGroup
+	}
+
+	payload := data[checksumLength:]
+	if binary.BigEndian.Uint32(data[:checksumLength]) != crc32.ChecksumIEEE(payload) {
+		return Group{}, ErrCorruptedGroup
+	}
+
+	reader := bytes.NewReader(payload)
+	group := Group{}
+	numEntries := uint32(0)
+	err := binary.Read(reader, binary.BigEndian, &group.Epoch)
+	if err != nil {
+		return Group{}, ErrCorruptedGroup
+	}
+	err = binary.Read(reader, binary.BigEndian, &numEntries)
+	if err != nil {
+		return Group{}, ErrCorruptedGroup
+	}
+
+	group.Entries = make([]Entry, 0, numEntries)
+	for i := uint32(0); i < numEntries; i++ {
+		entry := Entry{}
+		entry.Unit, err = reader.ReadByte()
+		if err != nil {
+			return Group{}, ErrCorruptedGroup
+		}
+		entry.Key, err = readLengthPrefixed(reader)
+		if err != nil {
+			return Group{}, ErrCorruptedGroup
+		}
+		entry.Value, err = readLengthPrefixed(reader)
+		if err != nil {
+			return Group{}, ErrCorruptedGroup
+		}
+
+		group.Entries = append(group.Entries, entry)
+	}
+
+	return group, nil
+}
+
+func writeLengthPrefixed(buff *bytes.Buffer, data []byte) {
+	_ = binary.Write(buff, binary.BigEndian, uint32(len(data)))
+	buff.Write(data)
+}
+
+func readLengthPrefixed(reader *bytes.Reader) ([]byte, error) {
+	length := uint32(0)
+	err := binary.Read(reader, binary.BigEndian, &length)
+	if err != nil {
+		return nil, err
+	}
+	if int64(length) > int64(reader.Len()) {
+		return nil, ErrCorruptedGroup
+	}
+
+	data := make([]byte, length)
+	_, err = reader.Read(data)
+	if err != nil && length > 0 {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,108 @@
+package writeAheadLog_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage/writeAheadLog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGroup(epoch uint32, key string) writeAheadLog.Group {
+	return writeAheadLog.Group{
+		Epoch: epoch,
+		Entries: []writeAheadLog.Entry{
+			{Unit: 1, Key: []byte(key), Value: []byte("header")},
+			{Unit: 2, Key: []byte(key), Value: []byte{}},
+		},
+	}
+}
+
+func TestNewWriteAheadLog_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wal, err := writeAheadLog.NewWriteAheadLog("", 1)
+	assert.True(t, check.IfNil(wal))
+	assert.Equal(t, writeAheadLog.ErrEmptyPath, err)
+
+	wal, err = writeAheadLog.NewWriteAheadLog("path", 0)
+	assert.True(t, check.IfNil(wal))
+	assert.Equal(t, writeAheadLog.ErrInvalidNumberOfGroupsToKeep, err)
+}
+
+func TestWriteAheadLog_AppendedGroupsShouldBeReadBackInOrder(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "wal")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	wal, err := writeAheadLog.NewWriteAheadLog(dir, 10)
+	require.Nil(t, err)
+
+	for i, key := range []string{"a", "b", "c"} {
+		err = wal.Append(createGroup(uint32(i), key))
+		assert.Nil(t, err)
+	}
+
+	reopened, _ := writeAheadLog.NewWriteAheadLog(dir, 10)
+	_ = reopened.Append(createGroup(3, "d"))
+
+	groups, err := reopened.Groups()
+	assert.Nil(t, err)
+	assert.Equal(t, []writeAheadLog.Group{createGroup(0, "a"), createGroup(1, "b"), createGroup(2, "c"), createGroup(3, "d")}, groups)
+}
+
+func TestWriteAheadLog_AppendShouldKeepOnlyTheLastGroups(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "wal")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	wal, _ := writeAheadLog.NewWriteAheadLog(dir, 2)
+	for i, key := range []string{"a", "b", "c", "d"} {
+		_ = wal.Append(createGroup(uint32(i), key))
+	}
+
+	groups, _ := wal.Groups()
+	assert.Equal(t, []writeAheadLog.Group{createGroup(2, "c"), createGroup(3, "d")}, groups)
+
+	files, _ := ioutil.ReadDir(dir)
+	assert.Equal(t, 2, len(files))
+}
+
+func TestWriteAheadLog_CorruptedGroupsAndTemporaryFilesShouldBeSkipped(t *testing.T) {
+	t.Parallel()
+
+	dir, _ := ioutil.TempDir("", "wal")
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	wal, _ := writeAheadLog.NewWriteAheadLog(dir, 10)
+	_ = wal.Append(createGroup(0, "a"))
+	_ = wal.Append(createGroup(1, "b"))
+
+	files, _ := ioutil.ReadDir(dir)
+	require.Equal(t, 2, len(files))
+	corruptedFile := filepath.Join(dir, files[0].Name())
+	data, _ := ioutil.ReadFile(corruptedFile)
+	data[len(data)-1] ^= 0xFF
+	_ = ioutil.WriteFile(corruptedFile, data, 0600)
+	_ = ioutil.WriteFile(filepath.Join(dir, files[1].Name()+".tmp"), []byte("partial"), 0600)
+
+	reopened, _ := writeAheadLog.NewWriteAheadLog(dir, 10)
+	groups, err := reopened.Groups()
+	assert.Nil(t, err)
+	assert.Equal(t, []writeAheadLog.Group{createGroup(1, "b")}, groups)
+
+	files, _ = ioutil.ReadDir(dir)
+	assert.Equal(t, 2, len(files))
+}
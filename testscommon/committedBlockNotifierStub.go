@@ -0,0 +1,36 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/data"
+
+// CommittedBlockNotifierStub -
+type CommittedBlockNotifierStub struct {
+	HasSubscribersCalled       func() bool
+	NotifyCommittedBlockCalled func(headerHash []byte, header data.HeaderHandler, body data.BodyHandler, transactions map[string]data.TransactionHandler, highestFinalBlockNonce uint64)
+}
+
+// HasSubscribers -
+func (cbns *CommittedBlockNotifierStub) HasSubscribers() bool {
+	if cbns.HasSubscribersCalled != nil {
+		return cbns.HasSubscribersCalled()
+	}
+
+	return false
+}
+
+// NotifyCommittedBlock -
+func (cbns *CommittedBlockNotifierStub) NotifyCommittedBlock(
+	headerHash []byte,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+	transactions map[string]data.TransactionHandler,
+	highestFinalBlockNonce uint64,
+) {
+	if cbns.NotifyCommittedBlockCalled != nil {
+		cbns.NotifyCommittedBlockCalled(headerHash, header, body, transactions, highestFinalBlockNonce)
+	}
+}
+
+// IsInterfaceNil -
+func (cbns *CommittedBlockNotifierStub) IsInterfaceNil() bool {
+	return cbns == nil
+}
@@ -9,11 +9,13 @@ import (
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler) error
+	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, txs map[string]data.TransactionHandler) error
+	RevertBlockCalled                  func(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetTransactionsByAddressCalled     func(address []byte, offset uint64, limit uint64) ([]*dblookupext.TransactionByAddress, uint64, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -24,9 +26,18 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockBody data.BodyHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
+	txs map[string]data.TransactionHandler,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, txs)
+	}
+	return nil
+}
+
+// RevertBlock -
+func (hp *HistoryRepositoryStub) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
+	if hp.RevertBlockCalled != nil {
+		return hp.RevertBlockCalled(blockHeader, blockBody)
 	}
 	return nil
 }
@@ -48,7 +59,10 @@ func (hp *HistoryRepositoryStub) GetMiniblockMetadataByTxHash(hash []byte) (*dbl
 
 // GetEpochByHash -
 func (hp *HistoryRepositoryStub) GetEpochByHash(hash []byte) (uint32, error) {
-	return hp.GetEpochByHashCalled(hash)
+	if hp.GetEpochByHashCalled != nil {
+		return hp.GetEpochByHashCalled(hash)
+	}
+	return 0, fmt.Errorf("epoch not found")
 }
 
 // IsEnabled -
@@ -67,6 +81,14 @@ func (hp *HistoryRepositoryStub) GetResultsHashesByTxHash(hash []byte, epoch uin
 	return nil, nil
 }
 
+// GetTransactionsByAddress -
+func (hp *HistoryRepositoryStub) GetTransactionsByAddress(address []byte, offset uint64, limit uint64) ([]*dblookupext.TransactionByAddress, uint64, error) {
+	if hp.GetTransactionsByAddressCalled != nil {
+		return hp.GetTransactionsByAddressCalled(address, offset, limit)
+	}
+	return nil, 0, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
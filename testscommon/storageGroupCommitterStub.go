@@ -0,0 +1,40 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/dataRetriever"
+
+// StorageGroupCommitterStub -
+type StorageGroupCommitterStub struct {
+	PutCalled     func(unitType dataRetriever.UnitType, key []byte, value []byte) error
+	CommitCalled  func(epoch uint32) error
+	DiscardCalled func()
+}
+
+// Put -
+func (sgcs *StorageGroupCommitterStub) Put(unitType dataRetriever.UnitType, key []byte, value []byte) error {
+	if sgcs.PutCalled != nil {
+		return sgcs.PutCalled(unitType, key, value)
+	}
+
+	return nil
+}
+
+// Commit -
+func (sgcs *StorageGroupCommitterStub) Commit(epoch uint32) error {
+	if sgcs.CommitCalled != nil {
+		return sgcs.CommitCalled(epoch)
+	}
+
+	return nil
+}
+
+// Discard -
+func (sgcs *StorageGroupCommitterStub) Discard() {
+	if sgcs.DiscardCalled != nil {
+		sgcs.DiscardCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (sgcs *StorageGroupCommitterStub) IsInterfaceNil() bool {
+	return sgcs == nil
+}
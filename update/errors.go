@@ -271,3 +271,6 @@ var ErrInvalidMaxHardCapForMissingNodes = errors.New("invalid max hardcap for mi
 
 // ErrInvalidNumConcurrentTrieSyncers signals that the number of concurrent trie syncers is invalid
 var ErrInvalidNumConcurrentTrieSyncers = errors.New("invalid num concurrent trie syncers")
+
+// ErrNilSyncProgressStorer signals that a nil storer for the trie sync progress was provided
+var ErrNilSyncProgressStorer = errors.New("nil sync progress storer")
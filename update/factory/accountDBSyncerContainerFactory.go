@@ -18,6 +18,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/update/genesis"
 )
 
+const stateChunksTrieSyncerVersion = 3
+const doubleListTrieSyncerVersion = 2
+
 // ArgsNewAccountsDBSyncersContainerFactory defines the arguments needed to create accounts DB syncers container
 type ArgsNewAccountsDBSyncersContainerFactory struct {
 	TrieCacher                storage.Cacher
@@ -31,6 +34,7 @@ type ArgsNewAccountsDBSyncersContainerFactory struct {
 	NumConcurrentTrieSyncers  int
 	MaxHardCapForMissingNodes int
 	TrieSyncerVersion         int
+	SyncProgressStorer        trie.SyncProgressStorer
 }
 
 type accountDBSyncersContainerFactory struct {
@@ -46,6 +50,7 @@ type accountDBSyncersContainerFactory struct {
 	numConcurrentTrieSyncers  int
 	maxHardCapForMissingNodes int
 	trieSyncerVersion         int
+	syncProgressStorer        trie.SyncProgressStorer
 }
 
 // NewAccountsDBSContainerFactory creates a factory for trie syncers container
@@ -74,11 +79,21 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 	if args.MaxHardCapForMissingNodes < 1 {
 		return nil, update.ErrInvalidMaxHardCapForMissingNodes
 	}
+	if check.IfNil(args.SyncProgressStorer) {
+		return nil, update.ErrNilSyncProgressStorer
+	}
 	err := trie.CheckTrieSyncerVersion(args.TrieSyncerVersion)
 	if err != nil {
 		return nil, err
 	}
 
+	trieSyncerVersion := args.TrieSyncerVersion
+	if trieSyncerVersion == stateChunksTrieSyncerVersion {
+		// the state chunks are served only from the trie snapshots and the hardfork resolvers are not registered on
+		// the state chunks topics, so the tries are synced node by node
+		trieSyncerVersion = doubleListTrieSyncerVersion
+	}
+
 	t := &accountDBSyncersContainerFactory{
 		shardCoordinator:          args.ShardCoordinator,
 		trieCacher:                args.TrieCacher,
@@ -90,7 +105,8 @@ func NewAccountsDBSContainerFactory(args ArgsNewAccountsDBSyncersContainerFactor
 		maxTrieLevelinMemory:      args.MaxTrieLevelInMemory,
 		numConcurrentTrieSyncers:  args.NumConcurrentTrieSyncers,
 		maxHardCapForMissingNodes: args.MaxHardCapForMissingNodes,
-		trieSyncerVersion:         args.TrieSyncerVersion,
+		trieSyncerVersion:         trieSyncerVersion,
+		syncProgressStorer:        args.SyncProgressStorer,
 	}
 
 	return t, nil
@@ -137,6 +153,7 @@ func (a *accountDBSyncersContainerFactory) createUserAccountsSyncer(shardId uint
 			MaxTrieLevelInMemory:      a.maxTrieLevelinMemory,
 			MaxHardCapForMissingNodes: a.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         a.trieSyncerVersion,
+			SyncProgressStorer:        a.syncProgressStorer,
 		},
 		ShardId:   shardId,
 		Throttler: thr,
@@ -162,6 +179,7 @@ func (a *accountDBSyncersContainerFactory) createValidatorAccountsSyncer(shardId
 			MaxTrieLevelInMemory:      a.maxTrieLevelinMemory,
 			MaxHardCapForMissingNodes: a.maxHardCapForMissingNodes,
 			TrieSyncerVersion:         a.trieSyncerVersion,
+			SyncProgressStorer:        a.syncProgressStorer,
 		},
 	}
 	accountSyncer, err := syncer.NewValidatorAccountsSyncer(args)
@@ -394,6 +394,7 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		MaxHardCapForMissingNodes: e.maxHardCapForMissingNodes,
 		NumConcurrentTrieSyncers:  e.numConcurrentTrieSyncers,
 		TrieSyncerVersion:         e.trieSyncerVersion,
+		SyncProgressStorer:        e.storageService.GetStorer(dataRetriever.BootstrapUnit),
 	}
 	accountsDBSyncerFactory, err := NewAccountsDBSContainerFactory(argsAccountsSyncers)
 	if err != nil {
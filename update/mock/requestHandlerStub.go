@@ -13,6 +13,7 @@ type RequestHandlerStub struct {
 	RequestRewardTxHandlerCalled       func(destShardID uint32, txHashes [][]byte)
 	RequestMiniBlockHandlerCalled      func(destShardID uint32, miniblockHash []byte)
 	RequestTrieNodesCalled             func(shardId uint32, hash []byte)
+	RequestStateChunkCalled            func(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string)
 	RequestStartOfEpochMetaBlockCalled func(epoch uint32)
 	SetNumPeersToQueryCalled           func(key string, intra int, cross int) error
 	GetNumPeersToQueryCalled           func(key string) (int, int, error)
@@ -131,3 +132,10 @@ func (rhs *RequestHandlerStub) RequestStartOfEpochMetaBlock(epoch uint32) {
 func (rhs *RequestHandlerStub) IsInterfaceNil() bool {
 	return rhs == nil
 }
+
+// RequestStateChunk -
+func (rhs *RequestHandlerStub) RequestStateChunk(destShardID uint32, rootHash []byte, startAfterKey []byte, topic string) {
+	if rhs.RequestStateChunkCalled != nil {
+		rhs.RequestStateChunkCalled(destShardID, rootHash, startAfterKey, topic)
+	}
+}
@@ -140,6 +140,9 @@ var ErrVotedForAnExpiredProposal = errors.New("voted for an expired proposal")
 // ErrVotedForAProposalThatNotBeginsYet signals that voting was done for a proposal that not begins yet
 var ErrVotedForAProposalThatNotBeginsYet = errors.New("voted for a proposal that not begins yet")
 
+// ErrVotePowerAlreadyUsed signals that the whole vote power of a validator was already used for a proposal
+var ErrVotePowerAlreadyUsed = errors.New("vote power already used for this proposal")
+
 // ErrNilPublicKey signals that nil public key has been provided
 var ErrNilPublicKey = errors.New("nil public key")
 
@@ -28,6 +28,8 @@ type AccountsStub struct {
 	IsPruningEnabledCalled   func() bool
 	GetAllLeavesCalled       func(rootHash []byte) (chan core.KeyValueHolder, error)
 	RecreateAllTriesCalled   func(rootHash []byte) (map[string]data.Trie, error)
+	GetTrieCalled            func(rootHash []byte) (data.Trie, error)
+	GetStateDiffCalled       func(fromRootHash []byte, toRootHash []byte, maxLeaves int, handler func(state.AccountDiff) bool) (bool, error)
 	GetNumCheckpointsCalled  func() uint32
 	IsLowRatingCalled        func(blsKey []byte) bool
 	GetCodeCalled            func([]byte) []byte
@@ -57,6 +59,22 @@ func (as *AccountsStub) RecreateAllTries(rootHash []byte, _ context.Context) (ma
 	return nil, nil
 }
 
+// GetTrie -
+func (as *AccountsStub) GetTrie(rootHash []byte) (data.Trie, error) {
+	if as.GetTrieCalled != nil {
+		return as.GetTrieCalled(rootHash)
+	}
+	return nil, nil
+}
+
+// GetStateDiff -
+func (as *AccountsStub) GetStateDiff(fromRootHash []byte, toRootHash []byte, maxLeaves int, handler func(state.AccountDiff) bool) (bool, error) {
+	if as.GetStateDiffCalled != nil {
+		return as.GetStateDiffCalled(fromRootHash, toRootHash, maxLeaves, handler)
+	}
+	return false, nil
+}
+
 // LoadAccount -
 func (as *AccountsStub) LoadAccount(address []byte) (state.AccountHandler, error) {
 	if as.LoadAccountCalled != nil {
@@ -22,6 +22,10 @@ const hardForkPrefix = "hardFork"
 const proposalPrefix = "proposal"
 const whiteListPrefix = "whiteList"
 const validatorPrefix = "validator"
+
+// validatorVotesPrefix prefixes the keys of the votes cast with the vote power of a validator, so that they will not
+// collide with the votes saved, under the proposal and voter key, before the delegated voting was enabled
+const validatorVotesPrefix = "validatorVotes"
 const hardForkEpochGracePeriod = 2
 const githubCommitLength = 40
 
@@ -40,19 +44,21 @@ type ArgsNewGovernanceContract struct {
 }
 
 type governanceContract struct {
-	eei                 vm.SystemEI
-	gasCost             vm.GasCost
-	baseProposalCost    *big.Int
-	ownerAddress        []byte
-	governanceSCAddress []byte
-	stakingSCAddress    []byte
-	validatorSCAddress  []byte
-	marshalizer         marshal.Marshalizer
-	hasher              hashing.Hasher
-	governanceConfig    config.GovernanceSystemSCConfig
-	enabledEpoch        uint32
-	flagEnabled         atomic.Flag
-	mutExecution        sync.RWMutex
+	eei                        vm.SystemEI
+	gasCost                    vm.GasCost
+	baseProposalCost           *big.Int
+	ownerAddress               []byte
+	governanceSCAddress        []byte
+	stakingSCAddress           []byte
+	validatorSCAddress         []byte
+	marshalizer                marshal.Marshalizer
+	hasher                     hashing.Hasher
+	governanceConfig           config.GovernanceSystemSCConfig
+	enabledEpoch               uint32
+	flagEnabled                atomic.Flag
+	delegatedVotingEnableEpoch uint32
+	flagDelegatedVoting        atomic.Flag
+	mutExecution               sync.RWMutex
 }
 
 // NewGovernanceContract creates a new governance smart contract
@@ -76,17 +82,18 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 	}
 
 	g := &governanceContract{
-		eei:                 args.Eei,
-		gasCost:             args.GasCost,
-		baseProposalCost:    baseProposalCost,
-		ownerAddress:        nil,
-		governanceSCAddress: args.GovernanceSCAddress,
-		stakingSCAddress:    args.StakingSCAddress,
-		validatorSCAddress:  args.ValidatorSCAddress,
-		marshalizer:         args.Marshalizer,
-		hasher:              args.Hasher,
-		governanceConfig:    args.GovernanceConfig,
-		enabledEpoch:        args.GovernanceConfig.EnabledEpoch,
+		eei:                        args.Eei,
+		gasCost:                    args.GasCost,
+		baseProposalCost:           baseProposalCost,
+		ownerAddress:               nil,
+		governanceSCAddress:        args.GovernanceSCAddress,
+		stakingSCAddress:           args.StakingSCAddress,
+		validatorSCAddress:         args.ValidatorSCAddress,
+		marshalizer:                args.Marshalizer,
+		hasher:                     args.Hasher,
+		governanceConfig:           args.GovernanceConfig,
+		enabledEpoch:               args.GovernanceConfig.EnabledEpoch,
+		delegatedVotingEnableEpoch: args.GovernanceConfig.DelegatedVotingEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(g)
 
@@ -616,7 +623,6 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
-	numNodesToVote := int32(0)
 	validatorData, err := g.getOrCreateValidatorData(validatorAddress, int32(numStakedNodes))
 	if err != nil {
 		log.Warn("getOrCreateValidatorData", "err", err)
@@ -624,11 +630,38 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
+	if !g.flagDelegatedVoting.IsSet() {
+		return g.voteWithoutDelegatedVoting(proposalToVote, voteString, validatorData, voterAddress)
+	}
+
+	numNodesToVote := votePowerOf(validatorData, voterAddress)
+	if numNodesToVote <= 0 {
+		g.eei.AddReturnMessage("address has 0 voting power")
+		return vmcommon.UserError
+	}
+
+	err = g.voteForProposal(proposalToVote, voteString, validatorData, validatorAddress, voterAddress, numNodesToVote)
+	if err != nil {
+		g.eei.AddReturnMessage("voteForProposal " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// voteWithoutDelegatedVoting records the vote as it was recorded before the delegated voting was enabled
+func (g *governanceContract) voteWithoutDelegatedVoting(
+	proposal []byte,
+	vote string,
+	validatorData *ValidatorData,
+	voter []byte,
+) vmcommon.ReturnCode {
+	numNodesToVote := int32(0)
 	found := false
-	for _, voter := range validatorData.Delegators {
-		if bytes.Equal(voter.Address, voterAddress) {
+	for _, voterData := range validatorData.Delegators {
+		if bytes.Equal(voterData.Address, voter) {
 			found = true
-			numNodesToVote = voter.NumNodes
+			numNodesToVote = voterData.NumNodes
 			break
 		}
 	}
@@ -637,7 +670,7 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
-	err = g.voteForProposal(proposalToVote, voteString, voterAddress, numNodesToVote)
+	err := g.voteForProposalWithVoteData(proposal, vote, voter, numNodesToVote)
 	if err != nil {
 		g.eei.AddReturnMessage("voteForProposal " + err.Error())
 		return vmcommon.UserError
@@ -646,21 +679,7 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
-func (g *governanceContract) isValidVoteString(vote string) bool {
-	switch vote {
-	case "yes":
-		return true
-	case "no":
-		return true
-	case "veto":
-		return true
-	case "dontCare":
-		return true
-	}
-	return false
-}
-
-func (g *governanceContract) voteForProposal(
+func (g *governanceContract) voteForProposalWithVoteData(
 	proposal []byte,
 	vote string,
 	voter []byte,
@@ -712,6 +731,157 @@ func (g *governanceContract) voteForProposal(
 	return nil
 }
 
+func (g *governanceContract) saveVoteValue(proposal []byte, voter []byte, voteData *VoteData) error {
+	key := append(proposal, voter...)
+	marshaledData, err := g.marshalizer.Marshal(voteData)
+	if err != nil {
+		return err
+	}
+
+	g.eei.SetStorage(key, marshaledData)
+	return nil
+}
+
+func (g *governanceContract) getOrCreateVoteData(proposal []byte, voter []byte) (*VoteData, error) {
+	voteData := &VoteData{}
+	key := append(proposal, voter...)
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) == 0 {
+		return voteData, nil
+	}
+
+	err := g.marshalizer.Unmarshal(voteData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return voteData, nil
+}
+
+// removeVoteData removes from the proposal the vote saved by the voter before the delegated voting was enabled, so
+// that it will be replaced by the vote cast with the vote power of a validator
+func (g *governanceContract) removeVoteData(generalProposal *GeneralProposal, proposal []byte, voter []byte) error {
+	voteData, err := g.getOrCreateVoteData(proposal, voter)
+	if err != nil {
+		return err
+	}
+	if voteData.NumVotes == 0 {
+		return nil
+	}
+
+	g.addVotedDataToProposal(generalProposal, voteData.VoteValue, -voteData.NumVotes)
+	g.eei.SetStorage(append(proposal, voter...), nil)
+
+	return nil
+}
+
+func (g *governanceContract) isValidVoteString(vote string) bool {
+	switch vote {
+	case "yes":
+		return true
+	case "no":
+		return true
+	case "veto":
+		return true
+	case "dontCare":
+		return true
+	}
+	return false
+}
+
+// voteForProposal records the vote of the voter using the vote power of the given validator. The vote power of
+// a validator can be split between the validator and its delegates, but the sum of the votes cast with it on a
+// proposal never exceeds the number of staked nodes of the validator. The vote cast by the validator before the
+// delegated voting was enabled uses its vote power until the validator votes again
+func (g *governanceContract) voteForProposal(
+	proposal []byte,
+	vote string,
+	validatorData *ValidatorData,
+	validator []byte,
+	voter []byte,
+	numVotes int32,
+) error {
+	generalProposal, err := g.getGeneralProposal(proposal)
+	if err != nil {
+		return err
+	}
+	currentNonce := g.eei.BlockChainHook().CurrentNonce()
+	if currentNonce < generalProposal.StartVoteNonce {
+		return vm.ErrVotedForAProposalThatNotBeginsYet
+	}
+	if currentNonce > generalProposal.EndVoteNonce {
+		return vm.ErrVotedForAnExpiredProposal
+	}
+
+	validatorVoteData, err := g.getOrCreateValidatorVoteData(proposal, validator)
+	if err != nil {
+		log.Warn("getOrCreateValidatorVoteData", "err", err)
+		return err
+	}
+	isFirstVote := len(validatorVoteData.Votes) == 0
+
+	var voteData *DelegatedVoteData
+	usedByOthers := int32(0)
+	for _, delegatedVote := range validatorVoteData.Votes {
+		if bytes.Equal(delegatedVote.Address, voter) {
+			voteData = delegatedVote
+			continue
+		}
+		usedByOthers += delegatedVote.NumVotes
+	}
+	if !bytes.Equal(voter, validator) {
+		legacyVoteData, errGet := g.getOrCreateVoteData(proposal, validator)
+		if errGet != nil {
+			return errGet
+		}
+		usedByOthers += legacyVoteData.NumVotes
+	}
+
+	available := validatorData.NumNodes - usedByOthers
+	if numVotes > available {
+		numVotes = available
+	}
+	if numVotes <= 0 {
+		return vm.ErrVotePowerAlreadyUsed
+	}
+
+	if voteData == nil {
+		err = g.removeVoteData(generalProposal, proposal, voter)
+		if err != nil {
+			log.Warn("removeVoteData", "err", err)
+			return err
+		}
+
+		voteData = &DelegatedVoteData{Address: voter}
+		validatorVoteData.Votes = append(validatorVoteData.Votes, voteData)
+	}
+	if voteData.NumVotes == numVotes && voteData.VoteValue == vote {
+		return nil
+	}
+
+	g.addVotedDataToProposal(generalProposal, voteData.VoteValue, -voteData.NumVotes)
+	g.addVotedDataToProposal(generalProposal, vote, numVotes)
+	voteData.NumVotes = numVotes
+	voteData.VoteValue = vote
+
+	err = g.saveValidatorVoteData(proposal, validator, validatorVoteData)
+	if err != nil {
+		log.Warn("saveValidatorVoteData", "err", err)
+		return err
+	}
+
+	if isFirstVote {
+		generalProposal.Voters = append(generalProposal.Voters, validator)
+	}
+	err = g.saveGeneralProposal(proposal, generalProposal)
+	if err != nil {
+		log.Warn("saveGeneralProposal", "err", err)
+		return err
+	}
+
+	return nil
+}
+
 func (g *governanceContract) addVotedDataToProposal(generalProposal *GeneralProposal, voteValue string, numVotes int32) {
 	switch voteValue {
 	case "yes":
@@ -725,9 +895,14 @@ func (g *governanceContract) addVotedDataToProposal(generalProposal *GeneralProp
 	}
 }
 
-func (g *governanceContract) saveVoteValue(proposal []byte, voter []byte, voteData *VoteData) error {
-	key := append(proposal, voter...)
-	marshaledData, err := g.marshalizer.Marshal(voteData)
+func validatorVotesKey(proposal []byte, validator []byte) []byte {
+	key := append([]byte(validatorVotesPrefix), proposal...)
+	return append(key, validator...)
+}
+
+func (g *governanceContract) saveValidatorVoteData(proposal []byte, validator []byte, validatorVoteData *ValidatorVoteData) error {
+	key := validatorVotesKey(proposal, validator)
+	marshaledData, err := g.marshalizer.Marshal(validatorVoteData)
 	if err != nil {
 		return err
 	}
@@ -736,20 +911,22 @@ func (g *governanceContract) saveVoteValue(proposal []byte, voter []byte, voteDa
 	return nil
 }
 
-func (g *governanceContract) getOrCreateVoteData(proposal []byte, voter []byte) (*VoteData, error) {
-	voteData := &VoteData{}
-	key := append(proposal, voter...)
+func (g *governanceContract) getOrCreateValidatorVoteData(proposal []byte, validator []byte) (*ValidatorVoteData, error) {
+	validatorVoteData := &ValidatorVoteData{
+		Votes: make([]*DelegatedVoteData, 0),
+	}
+	key := validatorVotesKey(proposal, validator)
 	marshaledData := g.eei.GetStorage(key)
 	if len(marshaledData) == 0 {
-		return voteData, nil
+		return validatorVoteData, nil
 	}
 
-	err := g.marshalizer.Unmarshal(voteData, marshaledData)
+	err := g.marshalizer.Unmarshal(validatorVoteData, marshaledData)
 	if err != nil {
 		return nil, err
 	}
 
-	return voteData, nil
+	return validatorVoteData, nil
 }
 
 func (g *governanceContract) getOrCreateValidatorData(address []byte, numNodes int32) (*ValidatorData, error) {
@@ -775,23 +952,234 @@ func (g *governanceContract) getOrCreateValidatorData(address []byte, numNodes i
 
 	oldNumNodes := validatorData.NumNodes
 	validatorData.NumNodes = numNodes
-	if len(validatorData.Delegators) == 1 {
+	if oldNumNodes == numNodes || !g.flagDelegatedVoting.IsSet() {
 		return validatorData, nil
 	}
 
 	log.Trace("difference in old num nodes and new num nodes with delegated voting", oldNumNodes, numNodes)
+	rebalanceVotePower(validatorData, address)
 
 	return validatorData, nil
 }
 
-func (g *governanceContract) delegateVotePower(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	g.eei.AddReturnMessage("delegateVotePower not yet implemented")
-	return vmcommon.UserError
+func (g *governanceContract) saveValidatorData(address []byte, validatorData *ValidatorData) error {
+	marshaledData, err := g.marshalizer.Marshal(validatorData)
+	if err != nil {
+		return err
+	}
+
+	key := append([]byte(validatorPrefix), address...)
+	g.eei.SetStorage(key, marshaledData)
+
+	return nil
+}
+
+// rebalanceVotePower adjusts the vote power of the validator and of its delegates after the number of staked
+// nodes changed. New nodes are added to the validator's own vote power, while removed nodes are taken first from
+// the validator and afterwards from the delegates, starting with the most recent one
+func rebalanceVotePower(validatorData *ValidatorData, validator []byte) {
+	ownVoterData := getOrAddVoterData(validatorData, validator)
+	ownVoterData.NumNodes += validatorData.NumNodes - sumOfVotePower(validatorData)
+	if ownVoterData.NumNodes >= 0 {
+		return
+	}
+
+	missing := -ownVoterData.NumNodes
+	ownVoterData.NumNodes = 0
+	for i := len(validatorData.Delegators) - 1; i >= 0 && missing > 0; i-- {
+		voterData := validatorData.Delegators[i]
+		removed := voterData.NumNodes
+		if removed > missing {
+			removed = missing
+		}
+		voterData.NumNodes -= removed
+		missing -= removed
+	}
+	removeEmptyDelegates(validatorData, validator)
+}
+
+func sumOfVotePower(validatorData *ValidatorData) int32 {
+	sum := int32(0)
+	for _, voterData := range validatorData.Delegators {
+		sum += voterData.NumNodes
+	}
+
+	return sum
+}
+
+func getOrAddVoterData(validatorData *ValidatorData, address []byte) *VoterData {
+	for _, voterData := range validatorData.Delegators {
+		if bytes.Equal(voterData.Address, address) {
+			return voterData
+		}
+	}
+
+	voterData := &VoterData{
+		Address:  address,
+		NumNodes: 0,
+	}
+	validatorData.Delegators = append(validatorData.Delegators, voterData)
+
+	return voterData
+}
+
+func removeEmptyDelegates(validatorData *ValidatorData, validator []byte) {
+	delegators := make([]*VoterData, 0, len(validatorData.Delegators))
+	for _, voterData := range validatorData.Delegators {
+		if voterData.NumNodes <= 0 && !bytes.Equal(voterData.Address, validator) {
+			continue
+		}
+		delegators = append(delegators, voterData)
+	}
+	validatorData.Delegators = delegators
 }
 
-func (g *governanceContract) revokeVotePower(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	g.eei.AddReturnMessage("revokeVotePower not yet implemented")
-	return vmcommon.UserError
+// votePowerOf returns the number of nodes the given address can vote with from the validator's vote power
+func votePowerOf(validatorData *ValidatorData, address []byte) int32 {
+	for _, voterData := range validatorData.Delegators {
+		if bytes.Equal(voterData.Address, address) {
+			if voterData.NumNodes > validatorData.NumNodes {
+				return validatorData.NumNodes
+			}
+			return voterData.NumNodes
+		}
+	}
+
+	return 0
+}
+
+// delegateVotePower moves a number of nodes from the caller's own vote power to the given address, which can then
+// vote with them by providing the caller's address as the third argument of vote
+func (g *governanceContract) delegateVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagDelegatedVoting.IsSet() {
+		g.eei.AddReturnMessage("delegateVotePower not yet enabled")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("delegateVotePower callValue expected to be 0")
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.DelegateVote)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 2 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	delegateAddress := args.Arguments[0]
+	if len(delegateAddress) != len(args.CallerAddr) {
+		g.eei.AddReturnMessage("first argument should be a valid address")
+		return vmcommon.FunctionWrongSignature
+	}
+	if bytes.Equal(delegateAddress, args.CallerAddr) {
+		g.eei.AddReturnMessage("cannot delegate vote power to self")
+		return vmcommon.UserError
+	}
+	numNodes, okConvert := big.NewInt(0).SetString(string(args.Arguments[1]), conversionBase)
+	if !okConvert || numNodes.Cmp(zero) <= 0 || !numNodes.IsInt64() {
+		g.eei.AddReturnMessage("second argument should be a positive number of nodes")
+		return vmcommon.UserError
+	}
+
+	numStakedNodes, err := g.numOfStakedNodes(args.CallerAddr)
+	if err != nil || numStakedNodes == 0 {
+		g.eei.AddReturnMessage("address has 0 voting power")
+		return vmcommon.UserError
+	}
+	validatorData, err := g.getOrCreateValidatorData(args.CallerAddr, int32(numStakedNodes))
+	if err != nil {
+		g.eei.AddReturnMessage("getOrCreateValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	ownVoterData := getOrAddVoterData(validatorData, args.CallerAddr)
+	if numNodes.Int64() > int64(ownVoterData.NumNodes) {
+		g.eei.AddReturnMessage(fmt.Sprintf("not enough vote power to delegate, available %d", ownVoterData.NumNodes))
+		return vmcommon.UserError
+	}
+
+	delegatedNodes := int32(numNodes.Int64())
+	ownVoterData.NumNodes -= delegatedNodes
+	delegateVoterData := getOrAddVoterData(validatorData, delegateAddress)
+	delegateVoterData.NumNodes += delegatedNodes
+
+	err = g.saveValidatorData(args.CallerAddr, validatorData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// revokeVotePower takes back the vote power previously delegated by the caller to the given address. If the number
+// of nodes is not provided, the whole delegated vote power is revoked
+func (g *governanceContract) revokeVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagDelegatedVoting.IsSet() {
+		g.eei.AddReturnMessage("revokeVotePower not yet enabled")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("revokeVotePower callValue expected to be 0")
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.RevokeVote)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) < 1 || len(args.Arguments) > 2 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 1 or 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	delegateAddress := args.Arguments[0]
+	if bytes.Equal(delegateAddress, args.CallerAddr) {
+		g.eei.AddReturnMessage("cannot revoke vote power from self")
+		return vmcommon.UserError
+	}
+
+	numStakedNodes, err := g.numOfStakedNodes(args.CallerAddr)
+	if err != nil {
+		g.eei.AddReturnMessage("numOfStakedNodes error " + err.Error())
+		return vmcommon.UserError
+	}
+	validatorData, err := g.getOrCreateValidatorData(args.CallerAddr, int32(numStakedNodes))
+	if err != nil {
+		g.eei.AddReturnMessage("getOrCreateValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	delegatedNodes := votePowerOf(validatorData, delegateAddress)
+	if delegatedNodes <= 0 {
+		g.eei.AddReturnMessage("no vote power delegated to the given address")
+		return vmcommon.UserError
+	}
+
+	revokedNodes := delegatedNodes
+	if len(args.Arguments) == 2 {
+		numNodes, okConvert := big.NewInt(0).SetString(string(args.Arguments[1]), conversionBase)
+		if !okConvert || numNodes.Cmp(zero) <= 0 || numNodes.Cmp(big.NewInt(int64(delegatedNodes))) > 0 {
+			g.eei.AddReturnMessage(fmt.Sprintf("second argument should be a positive number of nodes, at most %d", delegatedNodes))
+			return vmcommon.UserError
+		}
+		revokedNodes = int32(numNodes.Int64())
+	}
+
+	delegateVoterData := getOrAddVoterData(validatorData, delegateAddress)
+	delegateVoterData.NumNodes -= revokedNodes
+	ownVoterData := getOrAddVoterData(validatorData, args.CallerAddr)
+	ownVoterData.NumNodes += revokedNodes
+	removeEmptyDelegates(validatorData, args.CallerAddr)
+
+	err = g.saveValidatorData(args.CallerAddr, validatorData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
 }
 
 func (g *governanceContract) numOfStakedNodes(address []byte) (uint32, error) {
@@ -864,7 +1252,7 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 	}
 
 	generalProposal.Closed = true
-	err = g.computeEndResults(generalProposal)
+	err = g.computeEndResults(proposal, generalProposal)
 	if err != nil {
 		g.eei.AddReturnMessage("computeEndResults error" + err.Error())
 		return vmcommon.UserError
@@ -879,16 +1267,26 @@ func (g *governanceContract) closeProposal(args *vmcommon.ContractCallInput) vmc
 	for _, voter := range generalProposal.Voters {
 		key := append(proposal, voter...)
 		g.eei.SetStorage(key, nil)
+		if g.flagDelegatedVoting.IsSet() {
+			g.eei.SetStorage(validatorVotesKey(proposal, voter), nil)
+		}
 	}
 
 	return vmcommon.Ok
 }
 
-func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error {
+func (g *governanceContract) computeEndResults(reference []byte, proposal *GeneralProposal) error {
 	baseConfig, err := g.getConfig()
 	if err != nil {
 		return err
 	}
+	if g.flagDelegatedVoting.IsSet() {
+		err = g.recountVotes(reference, proposal)
+		if err != nil {
+			return err
+		}
+	}
+
 	totalVotes := proposal.Yes + proposal.No + proposal.DontCare + proposal.Veto
 	if totalVotes < baseConfig.MinQuorum {
 		proposal.Voted = false
@@ -908,10 +1306,62 @@ func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error
 	return nil
 }
 
+// recountVotes computes the proposal results from the stored votes of each validator, limiting the votes cast
+// with the vote power of a validator, by itself or by its delegates, to its current number of staked nodes. The votes
+// saved before the delegated voting was enabled are counted as they were cast and use the vote power of the validator
+func (g *governanceContract) recountVotes(reference []byte, proposal *GeneralProposal) error {
+	if len(proposal.Voters) == 0 {
+		return nil
+	}
+
+	proposal.Yes, proposal.No, proposal.Veto, proposal.DontCare = 0, 0, 0, 0
+	counted := make(map[string]struct{})
+	for _, validator := range proposal.Voters {
+		_, alreadyCounted := counted[string(validator)]
+		if alreadyCounted {
+			continue
+		}
+		counted[string(validator)] = struct{}{}
+
+		voteData, err := g.getOrCreateVoteData(reference, validator)
+		if err != nil {
+			return err
+		}
+		g.addVotedDataToProposal(proposal, voteData.VoteValue, voteData.NumVotes)
+
+		numStakedNodes, err := g.numOfStakedNodes(validator)
+		if err != nil {
+			return err
+		}
+		validatorVoteData, err := g.getOrCreateValidatorVoteData(reference, validator)
+		if err != nil {
+			return err
+		}
+
+		available := int32(numStakedNodes) - voteData.NumVotes
+		if available < 0 {
+			available = 0
+		}
+		for _, voteData := range validatorVoteData.Votes {
+			numVotes := voteData.NumVotes
+			if numVotes > available {
+				numVotes = available
+			}
+			available -= numVotes
+			g.addVotedDataToProposal(proposal, voteData.VoteValue, numVotes)
+		}
+	}
+
+	return nil
+}
+
 // EpochConfirmed is called whenever a new epoch is confirmed
 func (g *governanceContract) EpochConfirmed(epoch uint32) {
 	g.flagEnabled.Toggle(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagDelegatedVoting.Toggle(epoch >= g.delegatedVotingEnableEpoch)
+	log.Debug("governance contract: delegated voting", "enabled", g.flagDelegatedVoting.IsSet())
 }
 
 // CanUseContract returns true if contract is enabled
@@ -665,3 +665,347 @@ func openProposal(t *testing.T, g *governanceContract, propType string, WLAddr,
 	retCode := g.Execute(callInput)
 	require.Equal(t, vmcommon.Ok, retCode)
 }
+
+func createGovernanceWithStakedValidator(
+	blockChainHook *mock.BlockChainHookStub,
+	validatorAddress []byte,
+	numStakedNodes int,
+) *governanceContract {
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		atArgParser,
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	validatorData := &ValidatorDataV2{
+		NumRegistered: uint32(numStakedNodes),
+	}
+	nodeData := &StakedDataV2_0{
+		Staked: true,
+	}
+	stakedDataBytes, _ := json.Marshal(nodeData)
+	for i := 0; i < numStakedNodes; i++ {
+		blsKey := []byte(fmt.Sprintf("blsKey%d", i))
+		validatorData.BlsPubKeys = append(validatorData.BlsPubKeys, blsKey)
+		eei.SetStorageForAddress(args.StakingSCAddress, blsKey, stakedDataBytes)
+	}
+	validatorDataBytes, _ := json.Marshal(validatorData)
+	eei.SetStorageForAddress(args.ValidatorSCAddress, validatorAddress, validatorDataBytes)
+
+	args.Eei = eei
+	gsc, _ := NewGovernanceContract(args)
+	gsc.EpochConfirmed(0)
+
+	return gsc
+}
+
+func openProposalForDelegationTests(t *testing.T, gsc *governanceContract, blockChainHook *mock.BlockChainHookStub, gitHubCommit []byte) {
+	recipientAddr := []byte("recipientAddress")
+	genesisWLAddr := []byte("genesisAddr")
+	initGovernanceSc(t, gsc, []byte("owner"), recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, genesisWLAddr, recipientAddr)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	openProposal(t, gsc, "proposal", genesisWLAddr, recipientAddr, gitHubCommit, 100, 1000)
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 101
+	}
+}
+
+func delegateOrRevokeVotePower(gsc *governanceContract, function string, caller []byte, arguments ...[]byte) vmcommon.ReturnCode {
+	callInput := createVMInput(big.NewInt(0), function, caller, []byte("recipientAddress"))
+	callInput.Arguments = arguments
+
+	return gsc.Execute(callInput)
+}
+
+func voteWithDelegatedPower(gsc *governanceContract, voter []byte, validator []byte, proposal []byte, vote string) vmcommon.ReturnCode {
+	callInput := createVMInput(big.NewInt(0), "vote", voter, []byte("recipientAddress"))
+	callInput.Arguments = [][]byte{proposal, []byte(vote), validator}
+
+	return gsc.Execute(callInput)
+}
+
+func getGeneralProposalForTest(gsc *governanceContract, proposal []byte) *GeneralProposal {
+	key := append([]byte(proposalPrefix), proposal...)
+	generalProposal := &GeneralProposal{}
+	_ = json.Unmarshal(gsc.eei.GetStorage(key), generalProposal)
+
+	return generalProposal
+}
+
+func TestGovernanceContract_DelegateVotePowerInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 2)
+
+	retCode := delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, []byte("dele1"))
+	require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, []byte("sho"), []byte("1"))
+	require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, validatorAddress, []byte("1"))
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, []byte("dele1"), []byte("0"))
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, []byte("dele1"), []byte("3"))
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "delegateVotePower", []byte("nostk"), []byte("dele1"), []byte("1"))
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernanceContract_DelegateVotePowerShouldSplitVotes(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	delegateAddress := []byte("dele1")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 3)
+	openProposalForDelegationTests(t, gsc, blockChainHook, gitHubCommit)
+
+	retCode := delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, delegateAddress, []byte("1"))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, []byte("recipientAddress"), "yes")
+	retCode = voteWithDelegatedPower(gsc, delegateAddress, validatorAddress, gitHubCommit, "no")
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	generalProposal := getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(2), generalProposal.Yes)
+	require.Equal(t, int32(1), generalProposal.No)
+	require.Equal(t, [][]byte{validatorAddress}, generalProposal.Voters)
+
+	// changing the vote replaces the previous one
+	retCode = voteWithDelegatedPower(gsc, delegateAddress, validatorAddress, gitHubCommit, "veto")
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	generalProposal = getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(2), generalProposal.Yes)
+	require.Equal(t, int32(0), generalProposal.No)
+	require.Equal(t, int32(1), generalProposal.Veto)
+}
+
+func TestGovernanceContract_DelegateAfterVoteShouldNotDoubleVote(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	delegateAddress := []byte("dele1")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 2)
+	openProposalForDelegationTests(t, gsc, blockChainHook, gitHubCommit)
+
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, []byte("recipientAddress"), "yes")
+
+	retCode := delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, delegateAddress, []byte("1"))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = voteWithDelegatedPower(gsc, delegateAddress, validatorAddress, gitHubCommit, "no")
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	generalProposal := getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(2), generalProposal.Yes)
+	require.Equal(t, int32(0), generalProposal.No)
+
+	// the validator re-votes with its remaining power, freeing one node for the delegate
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, []byte("recipientAddress"), "yes")
+	retCode = voteWithDelegatedPower(gsc, delegateAddress, validatorAddress, gitHubCommit, "no")
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	generalProposal = getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(1), generalProposal.Yes)
+	require.Equal(t, int32(1), generalProposal.No)
+}
+
+func TestGovernanceContract_RevokeVotePower(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	delegateAddress := []byte("dele1")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 2)
+	openProposalForDelegationTests(t, gsc, blockChainHook, gitHubCommit)
+
+	retCode := delegateOrRevokeVotePower(gsc, "revokeVotePower", validatorAddress, delegateAddress)
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, delegateAddress, []byte("2"))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "revokeVotePower", validatorAddress, delegateAddress, []byte("3"))
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "revokeVotePower", validatorAddress, delegateAddress, []byte("1"))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = voteWithDelegatedPower(gsc, delegateAddress, validatorAddress, gitHubCommit, "no")
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "revokeVotePower", validatorAddress, delegateAddress)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = voteWithDelegatedPower(gsc, delegateAddress, validatorAddress, gitHubCommit, "no")
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	// the vote already cast by the delegate still uses one node of the validator
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, []byte("recipientAddress"), "yes")
+	generalProposal := getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(1), generalProposal.Yes)
+	require.Equal(t, int32(1), generalProposal.No)
+}
+
+func TestGovernanceContract_ComputeEndResultsShouldCountDelegatedVotes(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	delegateAddress := []byte("dele1")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 3)
+	openProposalForDelegationTests(t, gsc, blockChainHook, gitHubCommit)
+
+	retCode := delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, delegateAddress, []byte("2"))
+	require.Equal(t, vmcommon.Ok, retCode)
+	retCode = voteWithDelegatedPower(gsc, delegateAddress, validatorAddress, gitHubCommit, "yes")
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1001
+	}
+	closeProposal(t, gsc, []byte("genesisAddr"), gitHubCommit, []byte("recipientAddress"))
+
+	generalProposal := getGeneralProposalForTest(gsc, gitHubCommit)
+	require.True(t, generalProposal.Closed)
+	require.True(t, generalProposal.Voted)
+	require.Equal(t, int32(2), generalProposal.Yes)
+	require.Equal(t, 0, len(gsc.eei.GetStorage(validatorVotesKey(gitHubCommit, validatorAddress))))
+}
+
+func TestGovernanceContract_DelegateVotePowerBeforeActivationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 2)
+	gsc.delegatedVotingEnableEpoch = 1
+	gsc.EpochConfirmed(0)
+
+	retCode := delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, []byte("dele1"), []byte("1"))
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = delegateOrRevokeVotePower(gsc, "revokeVotePower", validatorAddress, []byte("dele1"))
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernanceContract_VoteSavedBeforeDelegatedVotingShouldBeCounted(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 3)
+	gsc.delegatedVotingEnableEpoch = 1
+	gsc.EpochConfirmed(0)
+	openProposalForDelegationTests(t, gsc, blockChainHook, gitHubCommit)
+
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, []byte("recipientAddress"), "yes")
+	voteData, err := gsc.getOrCreateVoteData(gitHubCommit, validatorAddress)
+	require.Nil(t, err)
+	require.Equal(t, &VoteData{NumVotes: 3, VoteValue: "yes"}, voteData)
+
+	gsc.EpochConfirmed(1)
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1001
+	}
+	closeProposal(t, gsc, []byte("genesisAddr"), gitHubCommit, []byte("recipientAddress"))
+
+	generalProposal := getGeneralProposalForTest(gsc, gitHubCommit)
+	require.True(t, generalProposal.Voted)
+	require.Equal(t, int32(3), generalProposal.Yes)
+	require.Equal(t, 0, len(gsc.eei.GetStorage(append(gitHubCommit, validatorAddress...))))
+}
+
+func TestGovernanceContract_VoteSavedBeforeDelegatedVotingShouldUseTheVotePower(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	delegateAddress := []byte("dele1")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 3)
+	gsc.delegatedVotingEnableEpoch = 1
+	gsc.EpochConfirmed(0)
+	openProposalForDelegationTests(t, gsc, blockChainHook, gitHubCommit)
+
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, []byte("recipientAddress"), "yes")
+
+	gsc.EpochConfirmed(1)
+	retCode := delegateOrRevokeVotePower(gsc, "delegateVotePower", validatorAddress, delegateAddress, []byte("1"))
+	require.Equal(t, vmcommon.Ok, retCode)
+	retCode = voteWithDelegatedPower(gsc, delegateAddress, validatorAddress, gitHubCommit, "no")
+	require.Equal(t, vmcommon.UserError, retCode)
+
+	generalProposal := getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(3), generalProposal.Yes)
+	require.Equal(t, int32(0), generalProposal.No)
+
+	// a delegated vote stored next to the vote saved before activation is not counted over the vote power
+	err := gsc.saveValidatorVoteData(gitHubCommit, validatorAddress, &ValidatorVoteData{
+		Votes: []*DelegatedVoteData{{Address: delegateAddress, NumVotes: 1, VoteValue: "no"}},
+	})
+	require.Nil(t, err)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1001
+	}
+	closeProposal(t, gsc, []byte("genesisAddr"), gitHubCommit, []byte("recipientAddress"))
+
+	generalProposal = getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(3), generalProposal.Yes)
+	require.Equal(t, int32(0), generalProposal.No)
+}
+
+func TestGovernanceContract_VoteAfterActivationShouldReplaceTheVoteSavedBefore(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	validatorAddress := []byte("vala1")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc := createGovernanceWithStakedValidator(blockChainHook, validatorAddress, 3)
+	gsc.delegatedVotingEnableEpoch = 1
+	gsc.EpochConfirmed(0)
+	openProposalForDelegationTests(t, gsc, blockChainHook, gitHubCommit)
+
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, []byte("recipientAddress"), "yes")
+
+	gsc.EpochConfirmed(1)
+	voteProposal(t, gsc, validatorAddress, gitHubCommit, []byte("recipientAddress"), "no")
+
+	generalProposal := getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(0), generalProposal.Yes)
+	require.Equal(t, int32(3), generalProposal.No)
+	require.Equal(t, 0, len(gsc.eei.GetStorage(append(gitHubCommit, validatorAddress...))))
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1001
+	}
+	closeProposal(t, gsc, []byte("genesisAddr"), gitHubCommit, []byte("recipientAddress"))
+
+	generalProposal = getGeneralProposalForTest(gsc, gitHubCommit)
+	require.Equal(t, int32(0), generalProposal.Yes)
+	require.Equal(t, int32(3), generalProposal.No)
+}